@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewAttachCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "attach <card> <file>...",
+		Short: "Upload local files as card attachments",
+		Long: `Upload local files as card attachments.
+The attachments are listed with 'cat', downloaded with 'cp' and deleted with 'rm'.`,
+		Run:  runAttach,
+		Args: cobra.MinimumNArgs(2),
+		Example: `
+  # attach the file 'specs.pdf' to the card 'card'
+  tcli attach /board/list/card ./specs.pdf
+
+  # download the attachment 'specs.pdf' in the current directory
+  tcli cp /board/list/card/specs.pdf ./
+
+  # delete the attachment 'specs.pdf'
+  tcli rm /board/list/card/specs.pdf`,
+	}
+}
+
+func runAttach(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "attach", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
@@ -13,6 +13,12 @@ func NewEditCmd() *cobra.Command {
 		Run:   runEdit,
 		Args:  cobra.ExactArgs(1),
 		Example: `
+  # edit board
+  tcli edit /board
+
+  # edit list
+  tcli edit /board/list
+
   # edit card
   tcli edit /board/list/card`,
 	}
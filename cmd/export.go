@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewExportCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export <board>",
+		Short: "Write a board with its lists, cards and comments in JSON or YAML",
+		Long: `Write a board with its labels, lists, cards, checklists and comments in JSON or YAML,
+to keep a snapshot of the board or to use it as a template with the import command.`,
+		Run:  runExport,
+		Args: cobra.ExactArgs(1),
+		Example: `
+  # snapshot the board 'board' before the retrospective
+  tcli export /board > board.json
+
+  # export it in YAML
+  tcli export /board --yaml > board.yml`,
+	}
+	c.Flags().Bool("yaml", false, "export in YAML instead of JSON")
+	return c
+}
+
+func runExport(c *cobra.Command, args []string) {
+	fp := flagParser{Command: c}
+	if fp.GetBool("yaml", true) {
+		args = append(args, "--yaml")
+	}
+	e := executor.New(*container.Conf, "export", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
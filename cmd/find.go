@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewFindCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "find [query]",
+		Short: "Find cards by name, description, label, checklist or comment",
+		Long: `Find cards by name, description, label, checklist or comment using Trello search.
+The paths of the matching cards are printed, so they can be given to the other commands.`,
+		Run:  runFind,
+		Args: cobra.MinimumNArgs(1),
+		Example: `
+  # find the cards mentioning 'release'
+  tcli find release
+
+  # Trello search operators are supported
+  tcli find board:my-board label:bug
+
+  # show the first card found
+  tcli cat "$(tcli find release | head -1)"`,
+	}
+}
+
+func runFind(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "find", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
@@ -39,3 +39,19 @@ func (fp flagParser) GetDebug() bool {
 func (fp flagParser) GetNoCache() bool {
 	return fp.GetBool("no-cache", true)
 }
+
+func (fp flagParser) GetDryRun() bool {
+	return fp.GetBool("dry-run", true)
+}
+
+func (fp flagParser) GetOffline() bool {
+	return fp.GetBool("offline", true)
+}
+
+func (fp flagParser) GetFake() bool {
+	return fp.GetBool("fake", true)
+}
+
+func (fp flagParser) GetOutput() string {
+	return fp.GetString("output", true)
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewHistoryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "history",
+		Short: "Show the mutations that can be undone or redone",
+		Long:  "Show the mutations recorded in the journal, from the oldest to the most recent one.",
+		Run:   runHistory,
+		Args:  cobra.NoArgs,
+		Example: `
+  # show the mutations in JSON
+  tcli history --output json`,
+	}
+}
+
+func runHistory(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "history", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
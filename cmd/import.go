@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <file> <board>",
+		Short: "Create a new board from an exported board",
+		Long: `Create a new board from a board written in JSON or YAML by the export command,
+with its labels, lists, cards, checklists and comments. Use "-" to read the board from the standard input.`,
+		Run:  runImport,
+		Args: cobra.ExactArgs(2),
+		Example: `
+  # create the board 'new-project' from a template board
+  tcli export /template > template.json
+  tcli import template.json /new-project`,
+	}
+}
+
+func runImport(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "import", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewLabelCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "label [ls|add|edit|rm] [board] [label...]",
+		Short: "List, add, edit or remove the labels of a board",
+		Long: `List, add, edit or remove the labels of a board.
+The labels are written like in the card templates: "color", "color [name]", "name" or the label ID.`,
+		Run:  runLabel,
+		Args: cobra.MinimumNArgs(1),
+		Example: `
+  # show the labels of the board 'board'
+  tcli label ls /board
+
+  # add a red label 'bug' and a label 'feature' without color
+  tcli label add /board "red [bug]" feature
+
+  # rename the label 'bug' to 'defect'
+  tcli label edit /board bug defect
+
+  # change the color of the label 'feature' to green
+  tcli label edit /board feature green
+
+  # delete the label 'defect'
+  tcli label rm /board defect`,
+	}
+}
+
+func runLabel(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "label", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
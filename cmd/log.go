@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewLogCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "log [board|list|card]",
+		Short: "Show the activity of a board, list or card",
+		Long: `Show the activity of a board, list or card, from the most recent to the oldest:
+card creations, moves between lists, renames, label and member changes, archives and comments.`,
+		Run:  runLog,
+		Args: cobra.ExactArgs(1),
+		Example: `
+  # show the activity of the card 'card'
+  tcli log /board/list/card
+
+  # show the activity of the cards of the list 'list' since yesterday
+  tcli log /board/list --since yesterday
+
+  # show the activity of the board 'board' of the last 3 days in JSON
+  tcli log /board --since "3 days ago" -o json`,
+	}
+	c.Flags().String("since", "", "only show the activity after the given date, e.g. '2026-11-01', 'yesterday' or '3 days ago'")
+	return c
+}
+
+func runLog(c *cobra.Command, args []string) {
+	fp := flagParser{Command: c}
+	if since := fp.GetString("since", true); since != "" {
+		args = append(args, "--since", since)
+	}
+	e := executor.New(*container.Conf, "log", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
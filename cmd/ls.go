@@ -7,7 +7,7 @@ import (
 )
 
 func NewLSCmd() *cobra.Command {
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:   "ls",
 		Short: "List resource content",
 		Run:   runLS,
@@ -20,11 +20,31 @@ func NewLSCmd() *cobra.Command {
   tcli ls /my-board
 
   # show 'my-list' cards
-  tcli ls /my-board/my-list`,
+  tcli ls /my-board/my-list
+
+  # show 'my-list' cards assigned to me
+  tcli ls /my-board/my-list --mine
+
+  # show the archived lists of 'my-board', then the archived cards of 'my-list'
+  tcli ls /my-board --archived
+  tcli ls /my-board/my-list --archived
+
+  # show 'my-list' cards in JSON
+  tcli ls /my-board/my-list -o json | jq '.[].name'`,
 	}
+	c.Flags().Bool("mine", false, "only show the cards assigned to you")
+	c.Flags().Bool("archived", false, "show the archived lists of a board, or the archived cards of a list")
+	return c
 }
 
-func runLS(_ *cobra.Command, args []string) {
+func runLS(c *cobra.Command, args []string) {
+	fp := flagParser{Command: c}
+	if fp.GetBool("mine", true) {
+		args = append(args, "--mine")
+	}
+	if fp.GetBool("archived", true) {
+		args = append(args, "--archived")
+	}
 	e := executor.New(*container.Conf, "ls", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
 	e.Execute(args)
 }
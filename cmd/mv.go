@@ -17,7 +17,10 @@ func NewMVCmd() *cobra.Command {
   tcli mv /source-board/source-list/source-card /target-board/target-list
 
   # rename card 'card' to 'new-card-name
-  tcli mv /board/list/card /board/list/new-card-name`,
+  tcli mv /board/list/card /board/list/new-card-name
+
+  # move all the cards starting with 'bug-' to 'doing' (quote the path so the shell does not expand it)
+  tcli mv '/board/todo/bug-*' /board/doing`,
 	}
 }
 
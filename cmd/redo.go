@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewRedoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redo",
+		Short: "Redo the last undone mutation",
+		Long:  "Redo the last mutation undone with 'undo'.",
+		Run:   runRedo,
+		Args:  cobra.NoArgs,
+		Example: `
+  # undo the last mutation, then perform it again
+  tcli undo
+  tcli redo`,
+	}
+}
+
+func runRedo(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "redo", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewReportCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "report <board|list>",
+		Short: "Write a Markdown report of a board or list",
+		Long: `Write a Markdown report of a board or list, to paste in status emails:
+the lists are written as headings, and the cards as bullets with their labels and links.`,
+		Run:  runReport,
+		Args: cobra.ExactArgs(1),
+		Example: `
+  # report the board 'board'
+  tcli report /board > status.md
+
+  # report the list 'done' in HTML, with the most recent comments of each card
+  tcli report /board/done --html --comments > status.html
+
+  # preview the report in the terminal
+  tcli report /board --preview`,
+	}
+	c.Flags().Bool("html", false, "write the report in HTML instead of Markdown")
+	c.Flags().Bool("preview", false, "render the report for the terminal")
+	c.Flags().Bool("comments", false, "add the excerpts of the most recent comments of the cards")
+	return c
+}
+
+func runReport(c *cobra.Command, args []string) {
+	fp := flagParser{Command: c}
+	for _, flag := range []string{"html", "preview", "comments"} {
+		if fp.GetBool(flag, true) {
+			args = append(args, "--"+flag)
+		}
+	}
+	e := executor.New(*container.Conf, "report", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
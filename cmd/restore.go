@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore",
+		Short: "Restore archived lists and cards",
+		Run:   runRestore,
+		Args:  cobra.MinimumNArgs(1),
+		Example: `
+  # restore the archived list 'list'
+  tcli restore /board/list
+
+  # restore the archived card 'card'
+  tcli restore /board/list/card
+
+  # find the archived cards of the list 'list', then restore one of them
+  tcli ls /board/list --archived
+  tcli restore /board/list/card`,
+	}
+}
+
+func runRestore(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "restore", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
@@ -13,6 +13,12 @@ func NewRMCmd() *cobra.Command {
 		Run:   runRM,
 		Args:  cobra.MinimumNArgs(1),
 		Example: `
+  # close board 'board'
+  tcli rm /board
+
+  # archive list 'list'
+  tcli rm /board/list
+
   # archive card 'card'
   tcli rm /board/list/card`,
 	}
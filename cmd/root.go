@@ -38,7 +38,11 @@ func initRootCmd(c *cobra.Command, version, buildDate string) *pflag.FlagSet {
 	c.SetVersionTemplate(`{{printf "%s" .Version}}`)
 	c.PersistentFlags().String("config", "", "config file (default will look at $PWD/.tcli.yml then at $HOME/.tcli.yml)")
 	c.PersistentFlags().Bool("debug", false, "debug mode")
+	c.PersistentFlags().StringP("output", "o", "", "output format: table, json or ndjson (default will use the 'output' config, or table if not set)")
 	c.PersistentFlags().Bool("no-cache", false, "do not use cache (/!\\ can be slow as every action will make a HTTP request to Trello APIs)")
+	c.PersistentFlags().Bool("dry-run", false, "print the changes that would be sent to Trello instead of sending them")
+	c.PersistentFlags().Bool("offline", false, "read the boards from the local mirror and queue the changes until 'sync' is run online (default will use the 'offline' config)")
+	c.PersistentFlags().Bool("fake", false, "use an in-memory fake Trello server with demo boards instead of Trello, which is reset when tcli exits (use 'tcli --fake run <script>' to keep the changes across the commands of a script)")
 	return c.Flags()
 }
 
@@ -67,6 +71,10 @@ func initializeIocContainer(c *cobra.Command, _ []string) {
 		Debug:   fp.GetDebug(),
 		File:    fp.GetConfigFile(),
 		NoCache: fp.GetNoCache(),
+		Output:  fp.GetOutput(),
+		DryRun:  fp.GetDryRun(),
+		Offline: fp.GetOffline(),
+		Fake:    fp.GetFake(),
 	}
 	container = ioc.Bootstrap(inputs)
 }
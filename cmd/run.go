@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/prompt"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+)
+
+func NewRunCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "run [script]",
+		Short: "Run tcli commands from a script",
+		Long: `Run tcli commands from a script, one command per line, in a non-interactive way.
+The session is kept across the lines, so 'cd' behaves like in the interactive mode.
+Blank lines and lines starting with '#' are ignored.
+The confirmations are not prompted: the commands requiring one fail, unless --yes is given.
+The exit code is not zero if any command wrote on stderr.`,
+		Run:  runRun,
+		Args: cobra.ExactArgs(1),
+		Example: `
+  # run the commands of the file 'script.tcli'
+  tcli run script.tcli
+
+  # archive the cards without confirmation
+  tcli run --yes script.tcli
+
+  # stop at the first failing command
+  tcli run --fail-fast script.tcli
+
+  # read the commands from stdin
+  echo "ls /board/list" | tcli run -`,
+	}
+	c.Flags().Bool("fail-fast", false, "stop at the first command that fails")
+	c.Flags().BoolP("yes", "y", false, "confirm the commands that require a confirmation, like the never_prompt config")
+	return c
+}
+
+func runRun(c *cobra.Command, args []string) {
+	fp := flagParser{Command: c}
+	var in io.Reader
+	if args[0] == "-" {
+		in = os.Stdin
+	} else {
+		f, err := os.Open(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("script", args[0]).Msg("could not open script")
+		}
+		defer f.Close()
+		in = f
+	}
+
+	conf := *container.Conf
+	if fp.GetBool("yes", true) {
+		conf.NeverPrompt = true
+	}
+	s := prompt.NewScript(conf, container.TrelloRepository, container.Renderer, fp.GetBool("fail-fast", true), os.Stdout, os.Stderr)
+	if err := s.Run(in); err != nil {
+		log.Error().Err(err).Str("script", args[0]).Msg("script execution failed")
+		os.Exit(1)
+	}
+}
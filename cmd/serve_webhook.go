@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+func NewServeWebhookCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "serve-webhook [board]...",
+		Short: "Start Trello interactive CLI, kept up to date by Trello webhooks",
+		Long: `Start Trello interactive CLI along with a server receiving the Trello webhooks, so the resources changed
+outside tcli are fetched again instead of being read from the cache.
+The webhooks of the given boards are registered with the callback URL, which must be reachable by Trello,
+e.g. through a tunnel to the address of the server. The payloads are checked with the secret of the Trello
+application, set as 'app_secret' in the configuration.`,
+		Run: runServeWebhook,
+		Example: `
+  # receive the webhooks of the board 'board' on the port 8080, exposed as https://example.ngrok.io/
+  tcli serve-webhook --addr :8080 --callback-url https://example.ngrok.io/ /board
+
+  # delete the webhooks registered with the callback URL
+  tcli serve-webhook --callback-url https://example.ngrok.io/ --unregister`,
+	}
+	c.Flags().String("addr", ":8080", "address the webhook server listens on")
+	c.Flags().String("callback-url", "", "URL on which Trello reaches the webhook server, as registered in the webhooks")
+	c.Flags().Bool("unregister", false, "delete the webhooks registered with the callback URL, then exit")
+	return c
+}
+
+func runServeWebhook(c *cobra.Command, args []string) {
+	fp := flagParser{Command: c}
+	callbackURL := fp.GetString("callback-url", true)
+	if callbackURL == "" {
+		log.Fatal().Msg("missing --callback-url, the URL on which Trello reaches the webhook server")
+	}
+	tr := container.TrelloRepository
+	if fp.GetBool("unregister", true) {
+		webhooks, err := trello.UnregisterWebhooks(tr, callbackURL)
+		for _, webhook := range webhooks {
+			fmt.Fprintf(os.Stdout, "deleted webhook: %s\n", webhook.Description)
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not delete the webhooks")
+		}
+		return
+	}
+	if container.Conf.Trello.AppSecret == "" {
+		log.Fatal().Msg("missing 'app_secret' in the configuration, needed to check the signatures of the webhooks")
+	}
+
+	receiver := trello.NewWebhookReceiver(container.Conf.Trello.AppSecret, callbackURL)
+	addr := fp.GetString("addr", true)
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal().Err(err).Str("addr", addr).Msg("could not listen for the webhooks")
+	}
+	server := &http.Server{Handler: receiver, ReadTimeout: 10 * time.Second}
+	// the server must answer before registering the webhooks, as Trello checks the callback URL
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Error().Err(err).Msg("webhook server stopped")
+		}
+	}()
+
+	boards := trello.Boards{}
+	pathResolver := trello.NewPathResolver(nil)
+	for _, arg := range args {
+		p, err := pathResolver.Resolve(arg)
+		if err != nil || p.BoardName == "" {
+			log.Fatal().Str("path", arg).Msg("invalid board path")
+		}
+		board, err := tr.FindBoard(p.BoardName)
+		if err != nil {
+			log.Fatal().Err(err).Str("board", p.BoardName).Msg("could not find board")
+		}
+		boards = append(boards, *board)
+	}
+	if _, err := trello.RegisterWebhooks(tr, callbackURL, boards); err != nil {
+		log.Fatal().Err(err).Msg("could not register the webhooks")
+	}
+
+	container.Prompt.ReceiveWebhooks(receiver)
+	runRootCmd(c, args)
+}
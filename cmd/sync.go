@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewSyncCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sync",
+		Short: "Send to Trello the mutations performed offline",
+		Long: `Send to Trello the mutations performed with --offline, from the oldest to the most recent.
+A mutation is not sent if its list or card was modified in Trello since it was modified offline:
+it is reported as a conflict and kept for the next synchronization, unless --force or --discard is given.`,
+		Run:  runSync,
+		Args: cobra.NoArgs,
+		Example: `
+  # send the mutations performed offline
+  tcli sync
+
+  # send them even if their lists or cards were modified in Trello in the meantime
+  tcli sync --force
+
+  # drop the mutations in conflict
+  tcli sync --discard`,
+	}
+	c.Flags().Bool("force", false, "send the mutations even if they are in conflict")
+	c.Flags().Bool("discard", false, "drop the mutations in conflict")
+	return c
+}
+
+func runSync(c *cobra.Command, _ []string) {
+	fp := flagParser{Command: c}
+	var args []string
+	if fp.GetBool("force", true) {
+		args = append(args, "--force")
+	}
+	if fp.GetBool("discard", true) {
+		args = append(args, "--discard")
+	}
+	e := executor.New(*container.Conf, "sync", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
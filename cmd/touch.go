@@ -13,6 +13,12 @@ func NewTouchCmd() *cobra.Command {
 		Run:   runTouch,
 		Args:  cobra.MinimumNArgs(1),
 		Example: `
+  # create new board with name 'board'
+  tcli touch /board
+
+  # create new list with name 'list'
+  tcli touch /board/list
+
   # create new card with name 'card'
   tcli touch /board/list/card`,
 	}
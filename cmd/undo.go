@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewUndoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "undo",
+		Short: "Undo the last mutation",
+		Long:  "Undo the last mutation (card, list, board or comment creation, update, archive or deletion) recorded in the journal.",
+		Run:   runUndo,
+		Args:  cobra.NoArgs,
+		Example: `
+  # move a card by mistake, then move it back
+  tcli mv /board/list/card /board/another-list
+  tcli undo`,
+	}
+}
+
+func runUndo(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "undo", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewWarmCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "warm <board>...",
+		Short: "Fetch in advance the lists, cards and labels of boards",
+		Long: `Fetch in advance the lists, cards and labels of boards, so browsing them does not wait for Trello.
+The lists are fetched concurrently, and kept in the cache on disk.`,
+		Run:  runWarm,
+		Args: cobra.MinimumNArgs(1),
+		Example: `
+  # fetch the whole board 'board'
+  tcli warm /board
+
+  # fetch all the boards
+  tcli warm '/*'`,
+	}
+}
+
+func runWarm(_ *cobra.Command, args []string) {
+	e := executor.New(*container.Conf, "warm", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
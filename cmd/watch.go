@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"github.com/l-lin/tcli/executor"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+func NewWatchCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "watch [board|list]",
+		Short: "Print the activity of a board or list as it happens",
+		Long: `Print the activity of a board or list as it happens, until interrupted with Ctrl+C:
+card creations, moves in and out of the lists, renames, label and member changes, archives and comments.
+The activity is fetched from Trello at a regular interval.`,
+		Run:  runWatch,
+		Args: cobra.ExactArgs(1),
+		Example: `
+  # watch the board 'board'
+  tcli watch /board
+
+  # watch the list 'list', fetching its activity every minute
+  tcli watch /board/list --interval 1m
+
+  # watch the board 'board' in NDJSON, e.g. to pipe it to another program
+  tcli watch /board -o ndjson`,
+	}
+	c.Flags().String("interval", "", "delay between two fetches of the activity, e.g. '30s' or '1m' (default 15s)")
+	return c
+}
+
+func runWatch(c *cobra.Command, args []string) {
+	fp := flagParser{Command: c}
+	if interval := fp.GetString("interval", true); interval != "" {
+		args = append(args, "--interval", interval)
+	}
+	e := executor.New(*container.Conf, "watch", container.TrelloRepository, container.Renderer, nil, os.Stdout, os.Stderr)
+	e.Execute(args)
+}
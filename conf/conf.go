@@ -5,26 +5,56 @@ import (
 )
 
 const (
-	defaultTrelloApiBaseURL = "https://trello.com/1"
-	defaultEditor           = "editor"
-	defaultFormat           = "yaml"
-	defaultPrompt           = false
+	defaultTrelloApiBaseURL     = "https://trello.com/1"
+	defaultEditor               = "editor"
+	defaultFormat               = "yaml"
+	defaultOutput               = "table"
+	defaultPrompt               = false
+	defaultCacheTTLBoards       = "1h"
+	defaultCacheTTLLabels       = "1h"
+	defaultCacheTTLMembers      = "1h"
+	defaultCacheTTLLists        = "10m"
+	defaultCacheTTLCards        = "1m"
+	defaultCacheTTLChecklists   = "1m"
+	defaultCacheTTLAttachments  = "1m"
+	defaultCacheTTLComments     = "1m"
+	defaultCacheTTLCustomFields = "1h"
+	DefaultJournalSize          = 100
 )
 
 var allFormats = []string{"yaml", "toml"}
+var allOutputs = []string{"table", "json", "ndjson"}
+
+// IsSupportedOutput checks if the given output is supported by the renderers
+func IsSupportedOutput(output string) bool {
+	for _, o := range allOutputs {
+		if o == output {
+			return true
+		}
+	}
+	return false
+}
 
 // Conf of the application
 type Conf struct {
 	Trello      `yaml:"trello"`
 	Editor      string `yaml:"editor"`
 	Format      string `yaml:"format"`
+	Output      string `yaml:"output"`
 	NeverPrompt bool   `yaml:"never_prompt"`
+	Cache       `yaml:"cache"`
+	Journal     `yaml:"journal"`
+	// Offline reads the boards from the local mirror and queues the mutations until they are synchronized
+	Offline bool `yaml:"offline"`
+	// NonInteractive refuses the confirmations instead of prompting them, e.g. when running a script
+	NonInteractive bool `yaml:"-"`
 }
 
 type Trello struct {
 	AppName             string `yaml:"-"`
 	ApiKey              string `yaml:"api_key"`
 	AccessToken         string `yaml:"access_token"`
+	AppSecret           string `yaml:"app_secret"`
 	BaseURL             string `yaml:"base_url"`
 	TrelloDefaultConfig `yaml:"default_config"`
 }
@@ -45,6 +75,48 @@ type TrelloDefaultList struct {
 	Name string `yaml:"name"`
 }
 
+// Cache configures the on-disk cache of the Trello resources
+type Cache struct {
+	// Dir where the cache is stored, defaults to "$XDG_CACHE_HOME/tcli"
+	Dir string   `yaml:"dir"`
+	TTL CacheTTL `yaml:"ttl"`
+}
+
+// CacheTTL is the time to live of each Trello resource type in the on-disk cache,
+// as a duration (e.g. "30s", "10m", "1h"); "0" disables the cache for the resource type
+type CacheTTL struct {
+	Boards       string `yaml:"boards"`
+	Labels       string `yaml:"labels"`
+	Members      string `yaml:"members"`
+	Lists        string `yaml:"lists"`
+	Cards        string `yaml:"cards"`
+	Checklists   string `yaml:"checklists"`
+	Attachments  string `yaml:"attachments"`
+	Comments     string `yaml:"comments"`
+	CustomFields string `yaml:"customFields"`
+}
+
+// Journal configures the journal of the mutations, used to undo and redo them
+type Journal struct {
+	// Size is the number of mutations kept in the journal, 0 to use the default size and -1 to disable the journal
+	Size int `yaml:"size"`
+}
+
+// NewCacheTTL creates a CacheTTL with the default durations
+func NewCacheTTL() CacheTTL {
+	return CacheTTL{
+		Boards:       defaultCacheTTLBoards,
+		Labels:       defaultCacheTTLLabels,
+		Members:      defaultCacheTTLMembers,
+		Lists:        defaultCacheTTLLists,
+		Cards:        defaultCacheTTLCards,
+		Checklists:   defaultCacheTTLChecklists,
+		Attachments:  defaultCacheTTLAttachments,
+		Comments:     defaultCacheTTLComments,
+		CustomFields: defaultCacheTTLCustomFields,
+	}
+}
+
 func NewConf() *Conf {
 	return &Conf{
 		Trello: Trello{
@@ -52,7 +124,14 @@ func NewConf() *Conf {
 		},
 		Editor:      defaultEditor,
 		Format:      defaultFormat,
+		Output:      defaultOutput,
 		NeverPrompt: defaultPrompt,
+		Cache: Cache{
+			TTL: NewCacheTTL(),
+		},
+		Journal: Journal{
+			Size: DefaultJournalSize,
+		},
 	}
 }
 
@@ -0,0 +1,58 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"os"
+	"path/filepath"
+)
+
+type attach struct {
+	executor
+}
+
+// Execute uploads the local files given after the card path as attachments of the card
+func (a attach) Execute(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintf(a.stderr, "missing card operand\n")
+		return
+	}
+	if len(args) == 1 {
+		fmt.Fprintf(a.stderr, "missing file operand\n")
+		return
+	}
+
+	exec := start(a.tr).
+		resolvePath(a.session, args[0]).
+		then().
+		findBoard().
+		then().
+		findList().
+		then().
+		findCard().
+		doOnCard(func(card *trello.Card) {
+			for _, file := range args[1:] {
+				if err := a.upload(*card, file); err != nil {
+					fmt.Fprintf(a.stderr, "could not attach file '%s' to card '%s': %v\n", file, card.Name, err)
+				}
+			}
+		})
+	if exec.err != nil {
+		fmt.Fprintf(a.stderr, "%s\n", exec.err)
+	}
+}
+
+func (a attach) upload(card trello.Card, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	createAttachment := trello.CreateAttachment{
+		IDCard: card.ID,
+		Name:   filepath.Base(file),
+		File:   f,
+	}
+	_, err = a.tr.CreateAttachment(createAttachment)
+	return err
+}
@@ -0,0 +1,148 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAttach_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file.pdf")
+	if err := os.WriteFile(file, []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	board := trello.Board{ID: "board 1", Name: "board"}
+	list := trello.List{ID: "list 1", Name: "list"}
+	card := trello.Card{ID: "card 1", Name: "card", IDList: list.ID}
+	buildTrelloRepositoryFindingCard := func() *trello.MockRepository {
+		tr := trello.NewMockRepository(ctrl)
+		tr.EXPECT().
+			FindBoard(board.Name).
+			Return(&board, nil)
+		tr.EXPECT().
+			FindList(board.ID, list.Name).
+			Return(&list, nil)
+		tr.EXPECT().
+			FindCard(list.ID, card.Name).
+			Return(&card, nil)
+		return tr
+	}
+
+	type given struct {
+		args                  []string
+		buildTrelloRepository func() trello.Repository
+	}
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"attach /board/list/card file.pdf": {
+			given: given{
+				args: []string{"/board/list/card", file},
+				buildTrelloRepository: func() trello.Repository {
+					tr := buildTrelloRepositoryFindingCard()
+					tr.EXPECT().
+						CreateAttachment(gomock.Any()).
+						DoAndReturn(func(createAttachment trello.CreateAttachment) (*trello.Attachment, error) {
+							content, _ := io.ReadAll(createAttachment.File)
+							if createAttachment.IDCard != card.ID || createAttachment.Name != "file.pdf" || string(content) != "content" {
+								t.Errorf("unexpected attachment to create %v with content %s", createAttachment, content)
+							}
+							return &trello.Attachment{ID: "attachment 1", Name: "file.pdf"}, nil
+						})
+					return tr
+				},
+			},
+			expected: expected{},
+		},
+		"attach /board/list/card unknown.pdf file.pdf": {
+			given: given{
+				args: []string{"/board/list/card", filepath.Join(dir, "unknown.pdf"), file},
+				buildTrelloRepository: func() trello.Repository {
+					tr := buildTrelloRepositoryFindingCard()
+					tr.EXPECT().
+						CreateAttachment(gomock.Any()).
+						Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+			},
+			expected: expected{
+				stderr: "could not attach file '" + filepath.Join(dir, "unknown.pdf") + "' to card 'card': open " + filepath.Join(dir, "unknown.pdf") + ": no such file or directory\n" +
+					"could not attach file '" + file + "' to card 'card': unexpected error\n",
+			},
+		},
+		"attach /board/list": {
+			given: given{
+				args: []string{"/board/list", file},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				stderr: "invalid path\n",
+			},
+		},
+		"attach": {
+			given: given{
+				args:                  []string{},
+				buildTrelloRepository: func() trello.Repository { return nil },
+			},
+			expected: expected{
+				stderr: "missing card operand\n",
+			},
+		},
+		"attach /board/list/card": {
+			given: given{
+				args:                  []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository { return nil },
+			},
+			expected: expected{
+				stderr: "missing file operand\n",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			a := attach{
+				executor: executor{
+					tr:      tt.given.buildTrelloRepository(),
+					session: &trello.Session{},
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+			}
+			a.Execute(tt.given.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
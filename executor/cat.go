@@ -12,7 +12,9 @@ type cat struct {
 func (c cat) Execute(args []string) {
 	if len(args) != 0 {
 		for _, arg := range args {
-			c.execute(arg)
+			for _, p := range c.expandPath(arg) {
+				c.execute(p)
+			}
 		}
 	}
 }
@@ -37,7 +39,19 @@ func (c cat) execute(arg string) {
 		then().
 		findCard().
 		doOnCard(func(card *trello.Card) {
-			fmt.Fprintf(c.stdout, "%s\n", c.r.RenderCard(*card))
+			cardToRender := *card
+			if checklists, err := c.tr.FindChecklists(card.ID); err != nil {
+				fmt.Fprintf(c.stderr, "could not fetch checklists of card '%s': %v\n", card.Name, err)
+			} else {
+				cardToRender.Checklists = checklists
+			}
+			if attachments, err := c.tr.FindAttachments(card.ID); err != nil {
+				fmt.Fprintf(c.stderr, "could not fetch attachments of card '%s': %v\n", card.Name, err)
+			} else {
+				cardToRender.Attachments = attachments
+			}
+			cardToRender = c.withCustomFields(card.IDBoard, trello.Cards{cardToRender})[0]
+			fmt.Fprintf(c.stdout, "%s\n", c.r.RenderCard(cardToRender))
 		}).
 		then().
 		findComment().
@@ -26,6 +26,9 @@ func TestCat_Execute(t *testing.T) {
 	list := trello.List{ID: "list 1", Name: "list"}
 	card1 := trello.Card{ID: "card 1", Name: "card"}
 	card2 := trello.Card{ID: "card 2", Name: "another-card"}
+	checklists := trello.Checklists{{ID: "checklist 1", Name: "checklist", IDCard: card1.ID}}
+	attachments := trello.Attachments{{ID: "attachment 1", Name: "file.pdf", IsUpload: true}}
+	card1WithChecklists := trello.Card{ID: card1.ID, Name: card1.Name, Checklists: checklists, Attachments: attachments}
 	comment := trello.Comment{ID: "comment"}
 
 	var tests = map[string]struct {
@@ -105,18 +108,65 @@ func TestCat_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCard(list.ID, card1.Name).
 						Return(&card1, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(checklists, nil)
+					tr.EXPECT().
+						FindAttachments(card1.ID).
+						Return(attachments, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
 					return tr
 				},
 				buildRenderer: func() renderer.Renderer {
 					r := renderer.NewMockRenderer(ctrl)
 					r.EXPECT().
-						RenderCard(card1).
+						RenderCard(card1WithChecklists).
 						Return("card content")
 					return r
 				},
 			},
 			expected: expected{stdout: "card content\n"},
 		},
+		"show card info (error when fetching checklists and attachments)": {
+			given: given{
+				args: []string{"board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil)
+					tr.EXPECT().
+						FindCard(list.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, errors.New("unexpected error"))
+					tr.EXPECT().
+						FindAttachments(card1.ID).
+						Return(nil, errors.New("unexpected error"))
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().
+						RenderCard(card1).
+						Return("card content")
+					return r
+				},
+			},
+			expected: expected{
+				stdout: "card content\n",
+				stderr: "could not fetch checklists of card 'card': unexpected error\ncould not fetch attachments of card 'card': unexpected error\n",
+			},
+		},
 		"show comment info": {
 			given: given{
 				args: []string{"board/list/card/comment"},
@@ -165,6 +215,74 @@ func TestCat_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCard(list.ID, card2.Name).
 						Return(&card2, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindAttachments(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindChecklists(card2.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindAttachments(card2.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil).
+						Times(2)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().
+						RenderCard(card1).
+						Return("card content")
+					r.EXPECT().
+						RenderCard(card2).
+						Return("card 2 content")
+					return r
+				},
+			},
+			expected: expected{stdout: "card content\ncard 2 content\n"},
+		},
+		"cards matching a glob": {
+			given: given{
+				args: []string{"/board/*/*card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil).
+						AnyTimes()
+					tr.EXPECT().
+						FindLists(board.ID).
+						Return(trello.Lists{list}, nil)
+					tr.EXPECT().
+						FindCards(list.ID).
+						Return(trello.Cards{card1, card2, {ID: "card 3", Name: "card 3"}}, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.TCliID()).
+						Return(&list, nil).
+						Times(2)
+					tr.EXPECT().
+						FindCard(list.ID, card1.TCliID()).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindCard(list.ID, card2.TCliID()).
+						Return(&card2, nil)
+					tr.EXPECT().
+						FindChecklists(gomock.Any()).
+						Return(nil, nil).
+						Times(2)
+					tr.EXPECT().
+						FindAttachments(gomock.Any()).
+						Return(nil, nil).
+						Times(2)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil).
+						Times(2)
 					return tr
 				},
 				buildRenderer: func() renderer.Renderer {
@@ -51,6 +51,12 @@ func (c *cd) Execute(args []string) {
 }
 
 func (c *cd) registerSession(session *trello.Session) {
+	if session.Board != nil && (c.session.Board == nil || c.session.Board.ID != session.Board.ID) {
+		// fetch the whole board now, so the next commands and completions on it do not wait for Trello
+		if err := c.tr.Prefetch(session.Board.ID); err != nil {
+			log.Debug().Err(err).Str("idBoard", session.Board.ID).Msg("could not prefetch board")
+		}
+	}
 	c.session.Board = session.Board
 	c.session.List = session.List
 	c.session.Card = session.Card
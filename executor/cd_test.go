@@ -42,6 +42,9 @@ func TestCd_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindBoard(board1.Name).
 						Return(&board1, nil)
+					tr.EXPECT().
+						Prefetch(board1.ID).
+						Return(nil)
 					return tr
 				},
 				session: &trello.Session{},
@@ -58,6 +61,9 @@ func TestCd_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindBoard(board1.Name).
 						Return(&board1, nil)
+					tr.EXPECT().
+						Prefetch(board1.ID).
+						Return(nil)
 					tr.EXPECT().
 						FindList(board1.ID, list1.Name).
 						Return(&list1, nil)
@@ -168,6 +174,9 @@ func TestCd_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindBoard(board1.Name).
 						Return(&board1, nil)
+					tr.EXPECT().
+						Prefetch(board1.ID).
+						Return(nil)
 					tr.EXPECT().
 						FindList(board1.ID, list1.Name).
 						Return(&list1, nil)
@@ -3,6 +3,8 @@ package executor
 import (
 	"fmt"
 	"github.com/l-lin/tcli/trello"
+	"os"
+	"path/filepath"
 )
 
 type cp struct {
@@ -23,8 +25,19 @@ func (c cp) Execute(args []string) {
 		return
 	}
 
+	sources := c.expandPath(args[0])
+	if len(sources) > 1 && !isListPath(c.session, args[1]) && !isCommentPath(c.session, sources[0]) {
+		fmt.Fprintf(c.stderr, "cannot copy several cards to '%s', give a list as destination\n", args[1])
+		return
+	}
+	for _, source := range sources {
+		c.execute(source, args[1])
+	}
+}
+
+func (c cp) execute(source, dest string) {
 	execSource := start(c.tr).
-		resolvePath(c.session, args[0]).
+		resolvePath(c.session, source).
 		then().
 		findBoard().
 		then().
@@ -38,11 +51,21 @@ func (c cp) Execute(args []string) {
 	sourceCard := execSource.session.Card
 	var sourceComment *trello.Comment
 	if execSource.p.CommentID != "" {
+		execAttachment := execSource.then().findAttachment()
+		if execAttachment.err != nil {
+			fmt.Fprintf(c.stderr, "%s\n", execAttachment.err)
+			return
+		}
+		if execAttachment.attachment != nil {
+			// attachments can only be copied to the local file system
+			c.downloadAttachment(*execAttachment.attachment, dest)
+			return
+		}
 		sourceComment = execSource.then().findComment().comment
 	}
 
 	execDest := start(c.tr).
-		resolvePath(c.session, args[1]).
+		resolvePath(c.session, dest).
 		then().
 		findBoard().
 		then().
@@ -88,3 +111,23 @@ func (c cp) createComment(destCard *trello.Card, comment *trello.Comment) {
 		fmt.Fprintf(c.stderr, "could not copy comment '%s': %v\n", comment.ID, err)
 	}
 }
+
+// downloadAttachment downloads the attachment in the given local file, or in the given local directory
+// using the attachment name
+func (c cp) downloadAttachment(attachment trello.Attachment, dest string) {
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		// the attachment name comes from Trello, so do not let it write outside the directory
+		dest = filepath.Join(dest, filepath.Base(attachment.Name))
+	}
+	f, err := os.Create(dest)
+	if err != nil {
+		fmt.Fprintf(c.stderr, "could not create file '%s': %v\n", dest, err)
+		return
+	}
+	err = c.tr.DownloadAttachment(attachment, f)
+	f.Close()
+	if err != nil {
+		fmt.Fprintf(c.stderr, "could not download attachment '%s': %v\n", attachment.Name, err)
+		os.Remove(dest)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"github.com/golang/mock/gomock"
 	"github.com/l-lin/tcli/trello"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -113,6 +116,9 @@ func TestCp_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCard(list1.ID, card1.Name).
 						Return(&card1, nil)
+					tr.EXPECT().
+						FindAttachments(card1.ID).
+						Return(nil, nil)
 					tr.EXPECT().
 						FindComment(card1.ID, comment.ID).
 						Return(&comment, nil)
@@ -383,6 +389,9 @@ func TestCp_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCard(list1.ID, card1.Name).
 						Return(&card1, nil)
+					tr.EXPECT().
+						FindAttachments(card1.ID).
+						Return(nil, nil)
 					tr.EXPECT().
 						FindComment(card1.ID, comment.ID).
 						Return(&comment, nil)
@@ -420,3 +429,84 @@ func TestCp_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestCp_Execute_DownloadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := trello.Board{ID: "board 1", Name: "board"}
+	list := trello.List{ID: "list 1", Name: "list"}
+	card := trello.Card{ID: "card 1", Name: "card", IDList: list.ID}
+	attachment := trello.Attachment{ID: "attachment 1", Name: "file.pdf", IsUpload: true}
+
+	var tests = map[string]struct {
+		dest           func(dir string) string
+		expectedFile   string
+		downloadErr    error
+		expectedStderr string
+	}{
+		"download in a directory": {
+			dest:         func(dir string) string { return dir },
+			expectedFile: "file.pdf",
+		},
+		"download in a file": {
+			dest:         func(dir string) string { return filepath.Join(dir, "renamed.pdf") },
+			expectedFile: "renamed.pdf",
+		},
+		"error when downloading": {
+			dest:           func(dir string) string { return dir },
+			downloadErr:    errors.New("unexpected error"),
+			expectedStderr: "could not download attachment 'file.pdf': unexpected error\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			tr := trello.NewMockRepository(ctrl)
+			tr.EXPECT().
+				FindBoard(board.Name).
+				Return(&board, nil)
+			tr.EXPECT().
+				FindList(board.ID, list.Name).
+				Return(&list, nil)
+			tr.EXPECT().
+				FindCard(list.ID, card.Name).
+				Return(&card, nil)
+			tr.EXPECT().
+				FindAttachments(card.ID).
+				Return(trello.Attachments{attachment}, nil)
+			tr.EXPECT().
+				DownloadAttachment(attachment, gomock.Any()).
+				DoAndReturn(func(_ trello.Attachment, w io.Writer) error {
+					w.Write([]byte("content"))
+					return tt.downloadErr
+				})
+			stderrBuf := bytes.Buffer{}
+			c := cp{
+				executor: executor{
+					tr:      tr,
+					session: &trello.Session{},
+					stdout:  &bytes.Buffer{},
+					stderr:  &stderrBuf,
+				},
+			}
+
+			c.Execute([]string{"/board/list/card/file.pdf", tt.dest(dir)})
+
+			if actualStderr := stderrBuf.String(); actualStderr != tt.expectedStderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expectedStderr, actualStderr)
+			}
+			files, _ := os.ReadDir(dir)
+			if tt.expectedFile == "" {
+				if len(files) != 0 {
+					t.Errorf("expected no file, actual %v", files)
+				}
+				return
+			}
+			content, err := os.ReadFile(filepath.Join(dir, tt.expectedFile))
+			if err != nil || string(content) != "content" {
+				t.Errorf("expected file %s with content 'content', actual '%s' (err: %v)", tt.expectedFile, content, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+)
+
+type dryRun struct {
+	executor
+	toggle trello.DryRunToggle
+}
+
+// Execute enables the dry-run mode with "on", disables it with "off", or toggles it when no argument is given
+func (d dryRun) Execute(args []string) {
+	if d.toggle == nil {
+		fmt.Fprintf(d.stderr, "dry-run is not supported\n")
+		return
+	}
+	if len(args) > 1 {
+		fmt.Fprintf(d.stderr, "too many arguments, use 'on' or 'off'\n")
+		return
+	}
+	enabled := !d.toggle.IsDryRun()
+	if len(args) == 1 {
+		switch args[0] {
+		case "on":
+			enabled = true
+		case "off":
+			enabled = false
+		default:
+			fmt.Fprintf(d.stderr, "invalid argument '%s', use 'on' or 'off'\n", args[0])
+			return
+		}
+	}
+	d.toggle.SetDryRun(enabled)
+	if enabled {
+		fmt.Fprintf(d.stdout, "dry-run: on\n")
+	} else {
+		fmt.Fprintf(d.stdout, "dry-run: off\n")
+	}
+}
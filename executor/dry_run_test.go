@@ -0,0 +1,88 @@
+package executor
+
+import (
+	"bytes"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestDryRun_Execute(t *testing.T) {
+	type expected struct {
+		stdout  string
+		stderr  string
+		enabled bool
+	}
+	var tests = map[string]struct {
+		given    []string
+		enabled  bool
+		expected expected
+	}{
+		"toggle on": {
+			given:   []string{},
+			enabled: false,
+			expected: expected{
+				stdout:  "dry-run: on\n",
+				enabled: true,
+			},
+		},
+		"toggle off": {
+			given:   []string{},
+			enabled: true,
+			expected: expected{
+				stdout:  "dry-run: off\n",
+				enabled: false,
+			},
+		},
+		"on": {
+			given:   []string{"on"},
+			enabled: true,
+			expected: expected{
+				stdout:  "dry-run: on\n",
+				enabled: true,
+			},
+		},
+		"off": {
+			given:   []string{"off"},
+			enabled: false,
+			expected: expected{
+				stdout:  "dry-run: off\n",
+				enabled: false,
+			},
+		},
+		"invalid argument": {
+			given:   []string{"maybe"},
+			enabled: true,
+			expected: expected{
+				stderr:  "invalid argument 'maybe', use 'on' or 'off'\n",
+				enabled: true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			toggle := trello.NewDryRun(nil, tt.enabled, &stdoutBuf).(trello.DryRunToggle)
+			d := dryRun{
+				executor: executor{
+					stdout: &stdoutBuf,
+					stderr: &stderrBuf,
+				},
+				toggle: toggle,
+			}
+			d.Execute(tt.given)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+			if toggle.IsDryRun() != tt.expected.enabled {
+				t.Errorf("expected dry-run %v, actual %v", tt.expected.enabled, toggle.IsDryRun())
+			}
+		})
+	}
+}
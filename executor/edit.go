@@ -4,11 +4,18 @@ import (
 	"fmt"
 	"github.com/l-lin/tcli/renderer"
 	"github.com/l-lin/tcli/trello"
-	"github.com/manifoldco/promptui"
 	"github.com/rs/zerolog/log"
 	"io"
 )
 
+// cardLabelFilter finds the labels written in the card templates
+var cardLabelFilter = trello.LabelFilterOr(
+	trello.LabelFilterByID,
+	trello.LabelFilterByTCliColor,
+	trello.LabelFilterByColor,
+	trello.LabelFilterByName,
+)
+
 type edit struct {
 	executor
 	editor        Editor
@@ -16,12 +23,16 @@ type edit struct {
 	editRenderer  renderer.Edit
 	defaultLabels []string
 	neverPrompt   bool
+	// nonInteractive refuses the confirmations instead of prompting them, e.g. in a script
+	nonInteractive bool
 }
 
 func (e edit) Execute(args []string) {
 	if len(args) != 0 {
 		for _, arg := range args {
-			e.execute(arg)
+			for _, p := range e.expandPath(arg) {
+				e.execute(p)
+			}
 		}
 	}
 }
@@ -35,12 +46,16 @@ func (e edit) execute(arg string) {
 		}).
 		findBoard().
 		doOnBoard(func(board *trello.Board) {
-			fmt.Fprintf(e.stderr, "board edition not implemented yet\n")
+			if err := e.editBoard(*board); err != nil {
+				fmt.Fprintf(e.stderr, "could not edit board '%s': %v\n", board.Name, err)
+			}
 		}).
 		then().
 		findList().
 		doOnList(func(list *trello.List) {
-			fmt.Fprintf(e.stderr, "list edition not implemented yet\n")
+			if err := e.editList(*list); err != nil {
+				fmt.Fprintf(e.stderr, "could not edit list '%s': %v\n", list.Name, err)
+			}
 		}).
 		then().
 		findCard().
@@ -81,6 +96,62 @@ func (e edit) execute(arg string) {
 	}
 }
 
+func (e edit) editBoard(board trello.Board) (err error) {
+	bte := trello.NewBoardToEdit(board)
+	var in []byte
+	if in, err = e.editRenderer.MarshalBoardToEdit(bte); err != nil {
+		return
+	}
+
+	var out []byte
+	if out, err = e.editor.Edit(in, e.editRenderer.GetFileType()); err != nil {
+		return
+	}
+
+	var editedBoard trello.BoardToEdit
+	if err = e.editRenderer.Unmarshal(out, &editedBoard); err != nil {
+		return
+	}
+	updatedBoard := trello.NewUpdateBoard(board)
+	updatedBoard.Name = editedBoard.Name
+	updatedBoard.Desc = editedBoard.Desc
+	updatedBoard.Closed = editedBoard.Closed
+
+	if !e.neverPrompt && !confirm(e.stdin, e.stderr, e.nonInteractive, fmt.Sprintf("Do you want to update the board '%s'", updatedBoard.Name)) {
+		return nil
+	}
+	_, err = e.tr.UpdateBoard(updatedBoard)
+	return
+}
+
+func (e edit) editList(list trello.List) (err error) {
+	lte := trello.NewListToEdit(list)
+	var in []byte
+	if in, err = e.editRenderer.MarshalListToEdit(lte); err != nil {
+		return
+	}
+
+	var out []byte
+	if out, err = e.editor.Edit(in, e.editRenderer.GetFileType()); err != nil {
+		return
+	}
+
+	var editedList trello.ListToEdit
+	if err = e.editRenderer.Unmarshal(out, &editedList); err != nil {
+		return
+	}
+	updatedList := trello.NewUpdateList(list)
+	updatedList.Name = editedList.Name
+	updatedList.Closed = editedList.Closed
+	updatedList.Pos = editedList.GetPos()
+
+	if !e.neverPrompt && !confirm(e.stdin, e.stderr, e.nonInteractive, fmt.Sprintf("Do you want to update the list '%s'", updatedList.Name)) {
+		return nil
+	}
+	_, err = e.tr.UpdateList(updatedList)
+	return
+}
+
 func (e edit) createCard(card trello.Card) (err error) {
 	var lists trello.Lists
 	if lists, err = e.tr.FindLists(card.IDBoard); err != nil {
@@ -92,9 +163,14 @@ func (e edit) createCard(card trello.Card) (err error) {
 		return
 	}
 
+	var members trello.Members
+	if members, err = e.tr.FindMembers(card.IDBoard); err != nil {
+		return
+	}
+
 	ctc := trello.NewCardToCreate(card, e.defaultLabels)
 	var in []byte
-	if in, err = e.editRenderer.MarshalCardToCreate(ctc, lists, labels); err != nil {
+	if in, err = e.editRenderer.MarshalCardToCreate(ctc, lists, labels, members); err != nil {
 		return
 	}
 
@@ -112,23 +188,24 @@ func (e edit) createCard(card trello.Card) (err error) {
 	createdCard.Desc = editedCard.Desc
 	createdCard.IDList = editedCard.IDList
 	createdCard.Pos = editedCard.GetPos()
-	createdCard.IDLabels = labels.FilterBy(
-		editedCard.Labels,
-		trello.LabelFilterOr(trello.LabelFilterByID, trello.LabelFilterByTCliColor, trello.LabelFilterByColor),
-	).IDLabelsInString()
-
-	if !e.neverPrompt {
-		prompt := promptui.Prompt{
-			Label:     fmt.Sprintf("Do you want to create the card '%s'", createdCard.Name),
-			IsConfirm: true,
-			Stdin:     e.stdin,
-		}
-		if _, err = prompt.Run(); err != nil {
-			fmt.Fprintf(e.stdout, "card '%s' not created\n", card.Name)
-			return nil
-		}
+	if createdCard.Due, err = editedCard.GetDue(); err != nil {
+		return
+	}
+	if createdCard.Start, err = editedCard.GetStart(); err != nil {
+		return
+	}
+	labelsToCreate := e.labelsToCreate(labels, editedCard.Labels)
+	createdCard.IDMembers = members.FilterBy(editedCard.Members).IDMembersInString()
+
+	if !e.neverPrompt && !confirm(e.stdin, e.stderr, e.nonInteractive, fmt.Sprintf("Do you want to create the card '%s'", createdCard.Name)) {
+		fmt.Fprintf(e.stdout, "card '%s' not created\n", card.Name)
+		return nil
 	}
 
+	if labels, err = e.createLabels(card.IDBoard, labels, labelsToCreate); err != nil {
+		return
+	}
+	createdCard.IDLabels = labels.FilterBy(editedCard.Labels, cardLabelFilter).IDLabelsInString()
 	_, err = e.tr.CreateCard(createdCard)
 	return
 }
@@ -142,10 +219,22 @@ func (e edit) editCard(card trello.Card) (err error) {
 	if labels, err = e.tr.FindLabels(card.IDBoard); err != nil {
 		return
 	}
+	var members trello.Members
+	if members, err = e.tr.FindMembers(card.IDBoard); err != nil {
+		return
+	}
+	if card.Checklists, err = e.tr.FindChecklists(card.ID); err != nil {
+		return
+	}
+	var customFields trello.CustomFields
+	if customFields, err = e.tr.FindCustomFields(card.IDBoard); err != nil {
+		return
+	}
 
-	cte := trello.NewCardToEdit(card)
+	cte := trello.NewCardToEdit(card, members)
+	cte.CustomFields = customFields.ToEdit(card)
 	var in []byte
-	if in, err = e.editRenderer.MarshalCardToEdit(cte, lists, labels); err != nil {
+	if in, err = e.editRenderer.MarshalCardToEdit(cte, lists, labels, members, customFields); err != nil {
 		return
 	}
 
@@ -164,22 +253,116 @@ func (e edit) editCard(card trello.Card) (err error) {
 	updatedCard.Closed = editedCard.Closed
 	updatedCard.IDList = editedCard.IDList
 	updatedCard.Pos = editedCard.GetPos()
-	updatedCard.IDLabels = labels.FilterBy(
-		editedCard.Labels,
-		trello.LabelFilterOr(trello.LabelFilterByID, trello.LabelFilterByTCliColor, trello.LabelFilterByColor),
-	).IDLabelsInString()
-
-	if !e.neverPrompt {
-		prompt := promptui.Prompt{
-			Label:     fmt.Sprintf("Do you want to update the card '%s'", updatedCard.Name),
-			IsConfirm: true,
-			Stdin:     e.stdin,
+	if updatedCard.Due, err = editedCard.GetDue(); err != nil {
+		return
+	}
+	updatedCard.DueComplete = editedCard.DueComplete
+	if updatedCard.Start, err = editedCard.GetStart(); err != nil {
+		return
+	}
+	labelsToCreate := e.labelsToCreate(labels, editedCard.Labels)
+	updatedCard.IDMembers = members.FilterBy(editedCard.Members).IDMembersInString()
+	var customFieldChanges []trello.UpdateCustomFieldItem
+	if customFieldChanges, err = customFields.Changes(card, editedCard.CustomFields); err != nil {
+		return
+	}
+
+	if !e.neverPrompt && !confirm(e.stdin, e.stderr, e.nonInteractive, fmt.Sprintf("Do you want to update the card '%s'", updatedCard.Name)) {
+		return nil
+	}
+	if labels, err = e.createLabels(card.IDBoard, labels, labelsToCreate); err != nil {
+		return
+	}
+	updatedCard.IDLabels = labels.FilterBy(editedCard.Labels, cardLabelFilter).IDLabelsInString()
+	if _, err = e.tr.UpdateCard(updatedCard); err != nil {
+		return
+	}
+	if err = e.updateChecklists(card.ID, trello.NewChecklistsChanges(card, editedCard.Checklists)); err != nil {
+		return
+	}
+	for _, updateCustomFieldItem := range customFieldChanges {
+		if _, err = e.tr.UpdateCustomFieldItem(updateCustomFieldItem); err != nil {
+			return
 		}
-		if _, err = prompt.Run(); err != nil {
-			return nil
+	}
+	return
+}
+
+// labelsToCreate offers to create the labels of the card that do not exist in the board yet
+// and returns the ones to create once the card change is confirmed
+func (e edit) labelsToCreate(labels trello.Labels, cardLabels []string) []string {
+	var toCreate []string
+	for _, missingLabel := range labels.Missing(cardLabels, cardLabelFilter) {
+		if e.neverPrompt {
+			fmt.Fprintf(e.stderr, "label '%s' does not exist, it will be created\n", missingLabel)
+		} else {
+			if !confirm(e.stdin, e.stderr, e.nonInteractive, fmt.Sprintf("Label '%s' does not exist, do you want to create it", missingLabel)) {
+				continue
+			}
+		}
+		toCreate = append(toCreate, missingLabel)
+	}
+	return toCreate
+}
+
+// createLabels creates the given labels in the board and returns the board labels along with the created ones
+func (e edit) createLabels(idBoard string, labels trello.Labels, names []string) (trello.Labels, error) {
+	allLabels := append(trello.Labels{}, labels...)
+	for _, name := range names {
+		label, err := e.tr.CreateLabel(trello.NewCreateLabel(idBoard, name))
+		if err != nil {
+			return nil, err
+		}
+		allLabels = append(allLabels, *label)
+	}
+	return allLabels, nil
+}
+
+func (e edit) updateChecklists(idCard string, changes trello.ChecklistsChanges) (err error) {
+	for _, checklist := range changes.ChecklistsToCreate {
+		var createdChecklist *trello.Checklist
+		createChecklist := trello.CreateChecklist{IDCard: idCard, Name: checklist.Name, Pos: "bottom"}
+		if createdChecklist, err = e.tr.CreateChecklist(createChecklist); err != nil {
+			return
+		}
+		for _, checkItem := range checklist.Items {
+			createCheckItem := trello.CreateCheckItem{
+				IDCard:      idCard,
+				IDChecklist: createdChecklist.ID,
+				Name:        checkItem.Name,
+				Checked:     checkItem.Checked,
+				Pos:         "bottom",
+			}
+			if _, err = e.tr.CreateCheckItem(createCheckItem); err != nil {
+				return
+			}
+		}
+	}
+	for _, updateChecklist := range changes.ChecklistsToUpdate {
+		if _, err = e.tr.UpdateChecklist(updateChecklist); err != nil {
+			return
+		}
+	}
+	for _, checklist := range changes.ChecklistsToDelete {
+		if err = e.tr.DeleteChecklist(idCard, checklist.ID); err != nil {
+			return
+		}
+	}
+	for _, createCheckItem := range changes.CheckItemsToCreate {
+		if _, err = e.tr.CreateCheckItem(createCheckItem); err != nil {
+			return
+		}
+	}
+	for _, updateCheckItem := range changes.CheckItemsToUpdate {
+		if _, err = e.tr.UpdateCheckItem(updateCheckItem); err != nil {
+			return
+		}
+	}
+	for _, checkItem := range changes.CheckItemsToDelete {
+		if err = e.tr.DeleteCheckItem(idCard, checkItem.ID); err != nil {
+			return
 		}
 	}
-	_, err = e.tr.UpdateCard(updatedCard)
 	return
 }
 
@@ -193,15 +376,8 @@ func (e edit) createComment(commentText, idCard string) (err error) {
 
 	editedText := string(out)
 
-	if !e.neverPrompt {
-		prompt := promptui.Prompt{
-			Label:     "Do you want to create the comment?",
-			IsConfirm: true,
-			Stdin:     e.stdin,
-		}
-		if _, err = prompt.Run(); err != nil {
-			return nil
-		}
+	if !e.neverPrompt && !confirm(e.stdin, e.stderr, e.nonInteractive, "Do you want to create the comment?") {
+		return nil
 	}
 
 	createComment := trello.CreateComment{
@@ -222,15 +398,8 @@ func (e edit) updateComment(comment *trello.Comment) (err error) {
 
 	editedText := string(out)
 
-	if !e.neverPrompt {
-		prompt := promptui.Prompt{
-			Label:     fmt.Sprintf("Do you want to update the comment '%s'?", comment.ID),
-			IsConfirm: true,
-			Stdin:     e.stdin,
-		}
-		if _, err = prompt.Run(); err != nil {
-			return nil
-		}
+	if !e.neverPrompt && !confirm(e.stdin, e.stderr, e.nonInteractive, fmt.Sprintf("Do you want to update the comment '%s'?", comment.ID)) {
+		return nil
 	}
 
 	updateComment := trello.UpdateComment{
@@ -10,6 +10,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 )
 
 const yamlFileType = "yaml"
@@ -26,6 +27,7 @@ func TestEdit_Execute(t *testing.T) {
 		buildTrelloRepository func() trello.Repository
 		buildEditor           func() Editor
 		stdin                 io.ReadCloser
+		neverPrompt           bool
 	}
 	type expected struct {
 		stdout string
@@ -33,19 +35,84 @@ func TestEdit_Execute(t *testing.T) {
 	}
 
 	board1 := trello.Board{ID: "board 1", Name: "board"}
-	list1 := trello.List{ID: "list 1", Name: "list"}
+	updatedBoard1 := trello.Board{ID: "board 1", Name: "updated board", Desc: "updated board description"}
+	list1 := trello.List{ID: "list 1", Name: "list", IDBoard: board1.ID, Pos: 1024}
+	updatedList1 := trello.List{ID: "list 1", Name: "updated list", IDBoard: board1.ID, Pos: 2048}
 	list2 := trello.List{ID: "list 2", Name: "list name 2"}
 	list3 := trello.List{ID: "list 3", Name: "list name 3"}
 	lists := trello.Lists{list1, list2, list3}
 	card1 := trello.Card{ID: "card 1", Name: "card", Desc: "card description", Closed: true, IDBoard: board1.ID, IDList: list1.ID, Pos: float64(123)}
 	createdCard1 := trello.Card{ID: "card 1", Name: "created card", Desc: "created card description", Closed: false, IDBoard: board1.ID, IDList: list1.ID, Pos: card1.Pos}
 	updatedCard1 := trello.Card{ID: "card 1", Name: "updated card", Desc: "updated card description", Closed: true, IDBoard: board1.ID, IDList: list1.ID, Pos: card1.Pos}
-	cte1 := trello.NewCardToEdit(card1)
+	members := trello.Members{
+		{ID: "member 1", Username: "member1", FullName: "Member 1"},
+		{ID: "member 2", Username: "member2"},
+	}
+	cte1 := trello.NewCardToEdit(card1, members)
+	cteWithDue1 := cte1
+	cteWithDue1.Due = "2026-11-01 17:00"
+	cteWithDue1.DueComplete = true
+	due1, _ := time.ParseInLocation("2006-01-02 15:04", cteWithDue1.Due, time.Local)
+	trelloDue1 := due1.UTC().Format("2006-01-02T15:04:05.000Z")
+	updateCardWithDue1 := trello.NewUpdateCard(card1)
+	updateCardWithDue1.Due = &trelloDue1
+	updateCardWithDue1.DueComplete = true
+	cteWithInvalidDue1 := cte1
+	cteWithInvalidDue1.Due = "someday"
+	customFields := trello.CustomFields{
+		{
+			ID:      "custom field 1",
+			Name:    "Priority",
+			Type:    trello.CustomFieldTypeList,
+			Pos:     1,
+			Options: trello.CustomFieldOptions{{ID: "option 1", Value: trello.CustomFieldItemValue{Text: "high"}}},
+		},
+		{ID: "custom field 2", Name: "Estimate", Type: trello.CustomFieldTypeNumber, Pos: 2},
+	}
+	cteWithCustomFields1 := cte1
+	cteWithCustomFields1.CustomFields = customFields.ToEdit(card1)
+	editedCteWithCustomFields1 := cte1
+	editedCteWithCustomFields1.CustomFields = map[string]string{"Priority": "high", "Estimate": ""}
+	idOption1 := "option 1"
+	checklists := trello.Checklists{
+		{
+			ID:     "checklist 1",
+			Name:   "checklist",
+			IDCard: card1.ID,
+			CheckItems: trello.CheckItems{
+				{ID: "item 1", Name: "item to check", IDChecklist: "checklist 1", State: "incomplete", Pos: 1},
+				{ID: "item 2", Name: "item to delete", IDChecklist: "checklist 1", State: "complete", Pos: 2},
+			},
+		},
+		{ID: "checklist 2", Name: "checklist to delete", IDCard: card1.ID},
+	}
+	cardWithChecklists1 := card1
+	cardWithChecklists1.Checklists = checklists
+	editedCardWithChecklists1 := trello.NewCardToEdit(cardWithChecklists1, members)
+	editedCardWithChecklists1.Checklists = []trello.ChecklistToEdit{
+		{
+			ID:   "checklist 1",
+			Name: "renamed checklist",
+			Items: []trello.CheckItemToEdit{
+				{ID: "item 1", Name: "item to check", Checked: true},
+				{Name: "new item", Checked: false},
+			},
+		},
+		{
+			Name:  "new checklist",
+			Items: []trello.CheckItemToEdit{{Name: "new checklist item", Checked: true}},
+		},
+	}
 	labels := trello.Labels{
 		{ID: "label 1", Name: "label name 1", Color: "red"},
 		{ID: "label 2", Name: "label name 2", Color: "sky"},
 		{ID: "label 3", Name: "", Color: "black"},
 	}
+	newLabel := trello.Label{ID: "label 4", IDBoard: board1.ID, Name: "feature", Color: "green"}
+	cteWithNewLabel1 := cte1
+	cteWithNewLabel1.Labels = []string{"label name 1", "green [feature]"}
+	updateCardWithNewLabel1 := trello.NewUpdateCard(card1)
+	updateCardWithNewLabel1.IDLabels = "label 1,label 4"
 	comment := trello.Comment{
 		ID: "comment",
 		Data: trello.CommentData{
@@ -89,6 +156,9 @@ func TestEdit_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindLabels(board1.ID).
 						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
 					tr.EXPECT().
 						CreateCard(trello.NewCreateCard(createdCard1)).
 						Return(&createdCard1, nil)
@@ -99,7 +169,7 @@ func TestEdit_Execute(t *testing.T) {
 						Name:   card1.Name,
 						IDList: card1.IDList,
 					}, []string{})
-					in, _ := editRenderer.MarshalCardToCreate(ctc1, nil, nil)
+					in, _ := editRenderer.MarshalCardToCreate(ctc1, nil, nil, nil)
 					out, _ := yaml.Marshal(trello.NewCardToCreate(createdCard1, []string{}))
 					e := NewMockEditor(ctrl)
 					e.EXPECT().
@@ -134,14 +204,296 @@ func TestEdit_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindLabels(board1.ID).
 						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
 					tr.EXPECT().
 						UpdateCard(trello.NewUpdateCard(updatedCard1)).
 						Return(&updatedCard1, nil)
 					return tr
 				},
 				buildEditor: func() Editor {
-					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil)
-					out, _ := yaml.Marshal(trello.NewCardToEdit(updatedCard1))
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
+					out, _ := yaml.Marshal(trello.NewCardToEdit(updatedCard1, members))
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{
+				stdout: "",
+				stderr: "",
+			},
+		},
+		"edit /board/list/card - card edition with due date": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
+					tr.EXPECT().
+						UpdateCard(updateCardWithDue1).
+						Return(&card1, nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
+					out, _ := yaml.Marshal(cteWithDue1)
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{
+				stdout: "",
+				stderr: "",
+			},
+		},
+		"edit /board/list/card - card edition with custom fields": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(customFields, nil)
+					tr.EXPECT().
+						UpdateCard(trello.NewUpdateCard(card1)).
+						Return(&card1, nil)
+					tr.EXPECT().
+						UpdateCustomFieldItem(trello.UpdateCustomFieldItem{
+							IDCard:        card1.ID,
+							IDCustomField: "custom field 1",
+							Name:          "Priority",
+							IDValue:       &idOption1,
+						}).
+						Return(&trello.CustomFieldItem{ID: "item 1", IDCustomField: "custom field 1", IDModel: card1.ID, IDValue: idOption1}, nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(cteWithCustomFields1, nil, nil, nil, nil)
+					out, _ := yaml.Marshal(editedCteWithCustomFields1)
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{
+				stdout: "",
+				stderr: "",
+			},
+		},
+		"edit /board/list/card - invalid custom field value": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(customFields, nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(cteWithCustomFields1, nil, nil, nil, nil)
+					invalid := cte1
+					invalid.CustomFields = map[string]string{"Estimate": "a lot"}
+					out, _ := yaml.Marshal(invalid)
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{
+				stdout: "",
+				stderr: "could not edit card 'card': invalid value 'a lot' for custom field 'Estimate', use a number\n",
+			},
+		},
+		"edit /board/list/card - invalid due date": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
+					out, _ := yaml.Marshal(cteWithInvalidDue1)
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+			},
+			expected: expected{
+				stderr: "could not edit card 'card': invalid date 'someday', use a date like '2026-11-01 17:00', '2026-11-01', 'tomorrow' or 'in 3 days'\n",
+			},
+		},
+		"edit /board/list/card - card edition with checklists": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(checklists, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
+					tr.EXPECT().
+						UpdateCard(trello.NewUpdateCard(card1)).
+						Return(&card1, nil)
+					tr.EXPECT().
+						CreateChecklist(trello.CreateChecklist{IDCard: card1.ID, Name: "new checklist", Pos: "bottom"}).
+						Return(&trello.Checklist{ID: "checklist 3", Name: "new checklist", IDCard: card1.ID}, nil)
+					tr.EXPECT().
+						CreateCheckItem(trello.CreateCheckItem{IDCard: card1.ID, IDChecklist: "checklist 3", Name: "new checklist item", Checked: true, Pos: "bottom"}).
+						Return(&trello.CheckItem{}, nil)
+					tr.EXPECT().
+						UpdateChecklist(trello.UpdateChecklist{ID: "checklist 1", IDCard: card1.ID, Name: "renamed checklist"}).
+						Return(&trello.Checklist{}, nil)
+					tr.EXPECT().
+						DeleteChecklist(card1.ID, "checklist 2").
+						Return(nil)
+					tr.EXPECT().
+						CreateCheckItem(trello.CreateCheckItem{IDCard: card1.ID, IDChecklist: "checklist 1", Name: "new item", Checked: false, Pos: "bottom"}).
+						Return(&trello.CheckItem{}, nil)
+					tr.EXPECT().
+						UpdateCheckItem(trello.UpdateCheckItem{ID: "item 1", IDCard: card1.ID, IDChecklist: "checklist 1", Name: "item to check", State: "complete"}).
+						Return(&trello.CheckItem{}, nil)
+					tr.EXPECT().
+						DeleteCheckItem(card1.ID, "item 2").
+						Return(nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(trello.NewCardToEdit(cardWithChecklists1, members), nil, nil, nil, nil)
+					out, _ := yaml.Marshal(editedCardWithChecklists1)
 					e := NewMockEditor(ctrl)
 					e.EXPECT().
 						Edit(in, yamlFileType).
@@ -175,6 +527,15 @@ func TestEdit_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindLabels(board1.ID).
 						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
 					tr.EXPECT().
 						UpdateCard(trello.NewUpdateCard(updatedCard1)).
 						Return(&updatedCard1, nil).
@@ -182,7 +543,7 @@ func TestEdit_Execute(t *testing.T) {
 					return tr
 				},
 				buildEditor: func() Editor {
-					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil)
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
 					e := NewMockEditor(ctrl)
 					e.EXPECT().
 						Edit(in, yamlFileType).
@@ -193,6 +554,238 @@ func TestEdit_Execute(t *testing.T) {
 			},
 			expected: expected{},
 		},
+		"edit /board/list/card - card edition with a new label": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
+					tr.EXPECT().
+						CreateLabel(trello.NewCreateLabel(board1.ID, "green [feature]")).
+						Return(&newLabel, nil)
+					tr.EXPECT().
+						UpdateCard(updateCardWithNewLabel1).
+						Return(&card1, nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
+					out, _ := yaml.Marshal(cteWithNewLabel1)
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				neverPrompt: true,
+			},
+			expected: expected{stderr: "label 'green [feature]' does not exist, it will be created\n"},
+		},
+		"edit /board/list/card - user accepted to create the new label but refused to update the card": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
+					tr.EXPECT().
+						CreateLabel(gomock.Any()).
+						Times(0)
+					tr.EXPECT().
+						UpdateCard(gomock.Any()).
+						Times(0)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
+					out, _ := yaml.Marshal(cteWithNewLabel1)
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: &answersStdin{answers: []string{"y", "N"}},
+			},
+			expected: expected{},
+		},
+		"edit /board/list/card - user refused to create the new label": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card1.Name).
+						Return(&card1, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(lists, nil)
+					tr.EXPECT().
+						FindLabels(board1.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
+					tr.EXPECT().
+						CreateLabel(gomock.Any()).
+						Times(0)
+					tr.EXPECT().
+						UpdateCard(gomock.Any()).
+						Times(0)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
+					out, _ := yaml.Marshal(cteWithNewLabel1)
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: &answersStdin{answers: []string{"N", "N"}},
+			},
+			expected: expected{},
+		},
+		// BOARD
+		"edit /board - board edition": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						UpdateBoard(trello.NewUpdateBoard(updatedBoard1)).
+						Return(&updatedBoard1, nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalBoardToEdit(trello.NewBoardToEdit(board1))
+					out, _ := yaml.Marshal(trello.NewBoardToEdit(updatedBoard1))
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{},
+		},
+		"edit /board - user refused to update board": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						UpdateBoard(gomock.Any()).
+						Times(0)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalBoardToEdit(trello.NewBoardToEdit(board1))
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(in, nil)
+					return e
+				},
+				stdin: refuseStdin(),
+			},
+			expected: expected{},
+		},
+		// LIST
+		"edit /board/list - list edition": {
+			given: given{
+				args: []string{"/board/list"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						UpdateList(trello.NewUpdateList(updatedList1)).
+						Return(&updatedList1, nil)
+					return tr
+				},
+				buildEditor: func() Editor {
+					in, _ := editRenderer.MarshalListToEdit(trello.NewListToEdit(list1))
+					out, _ := yaml.Marshal(trello.NewListToEdit(updatedList1))
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(out, nil)
+					return e
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{},
+		},
 		// COMMENTS
 		"edit /board/list/card/comment - comment creation": {
 			given: given{
@@ -324,7 +917,7 @@ func TestEdit_Execute(t *testing.T) {
 				stderr: "no board found with name 'board'\n",
 			},
 		},
-		"no list name": {
+		"edit /board - error when updating board": {
 			given: given{
 				args: []string{"/board"},
 				buildTrelloRepository: func() trello.Repository {
@@ -332,14 +925,23 @@ func TestEdit_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindBoard(board1.Name).
 						Return(&board1, nil)
+					tr.EXPECT().
+						UpdateBoard(trello.NewUpdateBoard(board1)).
+						Return(nil, errors.New("unexpected error"))
 					return tr
 				},
 				buildEditor: func() Editor {
-					return nil
+					in, _ := editRenderer.MarshalBoardToEdit(trello.NewBoardToEdit(board1))
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(in, nil)
+					return e
 				},
+				stdin: acceptStdin(),
 			},
 			expected: expected{
-				stderr: "board edition not implemented yet\n",
+				stderr: "could not edit board 'board': unexpected error\n",
 			},
 		},
 		"no list found": {
@@ -363,7 +965,7 @@ func TestEdit_Execute(t *testing.T) {
 				stderr: "no list found with name 'list'\n",
 			},
 		},
-		"no card name": {
+		"edit /board/list - error when updating list": {
 			given: given{
 				args: []string{"/board/list"},
 				buildTrelloRepository: func() trello.Repository {
@@ -374,14 +976,23 @@ func TestEdit_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindList(board1.ID, list1.Name).
 						Return(&list1, nil)
+					tr.EXPECT().
+						UpdateList(trello.NewUpdateList(list1)).
+						Return(nil, errors.New("unexpected error"))
 					return tr
 				},
 				buildEditor: func() Editor {
-					return nil
+					in, _ := editRenderer.MarshalListToEdit(trello.NewListToEdit(list1))
+					e := NewMockEditor(ctrl)
+					e.EXPECT().
+						Edit(in, yamlFileType).
+						Return(in, nil)
+					return e
 				},
+				stdin: acceptStdin(),
 			},
 			expected: expected{
-				stderr: "list edition not implemented yet\n",
+				stderr: "could not edit list 'list': unexpected error\n",
 			},
 		},
 		"edit /board/list/card - error when updating card": {
@@ -404,13 +1015,22 @@ func TestEdit_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindLabels(board1.ID).
 						Return(labels, nil)
+					tr.EXPECT().
+						FindMembers(board1.ID).
+						Return(members, nil)
+					tr.EXPECT().
+						FindChecklists(card1.ID).
+						Return(nil, nil)
+					tr.EXPECT().
+						FindCustomFields(card1.IDBoard).
+						Return(nil, nil)
 					tr.EXPECT().
 						UpdateCard(trello.NewUpdateCard(card1)).
 						Return(nil, errors.New("unexpected error"))
 					return tr
 				},
 				buildEditor: func() Editor {
-					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil)
+					in, _ := editRenderer.MarshalCardToEdit(cte1, nil, nil, nil, nil)
 					e := NewMockEditor(ctrl)
 					e.EXPECT().
 						Edit(in, yamlFileType).
@@ -512,6 +1132,7 @@ func TestEdit_Execute(t *testing.T) {
 				editor:       tt.given.buildEditor(),
 				stdin:        tt.given.stdin,
 				editRenderer: editRenderer,
+				neverPrompt:  tt.given.neverPrompt,
 			}
 			e.Execute(tt.given.args)
 			actualStderr := stderrBuf.String()
@@ -529,3 +1150,21 @@ type mockReadWriterCloser struct {
 func (m mockReadWriterCloser) Close() error {
 	return nil
 }
+
+// answersStdin answers each prompt with the given answers, one answer per read so a prompt does not consume the next ones
+type answersStdin struct {
+	answers []string
+}
+
+func (a *answersStdin) Read(p []byte) (int, error) {
+	if len(a.answers) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, a.answers[0]+"\n")
+	a.answers = a.answers[1:]
+	return n, nil
+}
+
+func (a *answersStdin) Close() error {
+	return nil
+}
@@ -4,9 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"github.com/l-lin/tcli/conf"
+	wrappedhttp "github.com/l-lin/tcli/http"
 	"github.com/l-lin/tcli/renderer"
 	"github.com/l-lin/tcli/trello"
+	"github.com/manifoldco/promptui"
+	"github.com/rs/zerolog/log"
 	"io"
+	"strings"
 )
 
 type Executor interface {
@@ -30,9 +34,91 @@ func New(conf conf.Conf, cmd string, tr trello.Repository, r renderer.Renderer,
 	return nil
 }
 
+// confirm prompts the user to confirm the action with the given label.
+// In non-interactive mode, e.g. in a script, the action is refused instead of being prompted,
+// and the refusal is written on stderr like a prompt that could not be read, so the command is considered failed
+func confirm(stdin io.ReadCloser, stderr io.Writer, nonInteractive bool, label string) bool {
+	if nonInteractive {
+		fmt.Fprintf(stderr, "%s: confirmation required, use 'run --yes' or the never_prompt config to confirm it\n", strings.TrimSuffix(label, "?"))
+		return false
+	}
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+		Stdin:     stdin,
+	}
+	if _, err := prompt.Run(); err != nil {
+		if err != promptui.ErrAbort && err != promptui.ErrInterrupt {
+			fmt.Fprintf(stderr, "%s: could not read the confirmation: %s\n", strings.TrimSuffix(label, "?"), err)
+		}
+		return false
+	}
+	return true
+}
+
+// isDryRun returns true if the mutations are printed instead of being sent to Trello
+func (e executor) isDryRun() bool {
+	toggle, ok := e.tr.(trello.DryRunToggle)
+	return ok && toggle.IsDryRun()
+}
+
+// printRendered prints the rendered entities on its own line, or nothing if there is nothing to render,
+// like an empty collection in ndjson, so the output stays a valid sequence of records
+func printRendered(w io.Writer, rendered string) {
+	if rendered == "" {
+		return
+	}
+	fmt.Fprintln(w, rendered)
+}
+
+// expandPath expands the glob patterns of the given path into the paths of the matching resources
+func (e executor) expandPath(arg string) []string {
+	pathResolver := trello.NewPathResolver(e.session)
+	paths, err := pathResolver.Expand(e.tr, arg)
+	if err != nil {
+		fmt.Fprintf(e.stderr, "could not expand path '%s': %v\n", arg, err)
+		return nil
+	}
+	return paths
+}
+
+// withCustomFields resolves the values of the custom fields of the given cards of the board, to render them;
+// the cards are rendered without them if the custom fields of the board cannot be fetched
+func (e executor) withCustomFields(idBoard string, cards trello.Cards) trello.Cards {
+	customFields, err := e.tr.FindCustomFields(idBoard)
+	if err != nil {
+		log.Debug().Err(err).Str("idBoard", idBoard).Msg("could not fetch custom fields")
+		return cards
+	}
+	if len(customFields) == 0 {
+		return cards
+	}
+	resolved := make(trello.Cards, 0, len(cards))
+	for _, card := range cards {
+		card.CustomFields = customFields.Values(card)
+		resolved = append(resolved, card)
+	}
+	return resolved
+}
+
+// isListPath returns true if the given path targets a list
+func isListPath(session *trello.Session, arg string) bool {
+	pathResolver := trello.NewPathResolver(session)
+	p, err := pathResolver.Resolve(arg)
+	return err == nil && p.ListName != "" && p.CardName == ""
+}
+
+// isCommentPath returns true if the given path targets a comment
+func isCommentPath(session *trello.Session, arg string) bool {
+	pathResolver := trello.NewPathResolver(session)
+	p, err := pathResolver.Resolve(arg)
+	return err == nil && p.CommentID != ""
+}
+
 // ERRORS -------------------------------------------------------------------
 
 var invalidPathError = errors.New("invalid path")
+var noBoardError = errors.New("no board selected, give the path of a board or 'cd' into it")
 
 type boardNotFoundError string
 
@@ -40,6 +126,12 @@ func (b boardNotFoundError) Error() string {
 	return fmt.Sprintf("no board found with name '%s'", string(b))
 }
 
+type labelNotFoundError string
+
+func (l labelNotFoundError) Error() string {
+	return fmt.Sprintf("no label found with name '%s'", string(l))
+}
+
 type listNotFoundError string
 
 func (l listNotFoundError) Error() string {
@@ -58,6 +150,21 @@ func (c commentNotFoundError) Error() string {
 	return fmt.Sprintf("no comment found with id '%s'", string(c))
 }
 
+// isUnreachable returns true if the error tells that Trello could not be queried, rather than the resource does not exist
+func isUnreachable(err error) bool {
+	return errors.Is(err, wrappedhttp.ErrUnauthorized) || errors.Is(err, wrappedhttp.ErrRateLimited)
+}
+
+// notFoundOr returns the given not found error when a lookup failed, unless Trello could not be queried,
+// e.g. when the access token expired, in which case the error of the lookup is returned instead
+// so it is not reported as not found, nor is the resource created as if it did not exist
+func notFoundOr(err error, notFoundErr error) error {
+	if isUnreachable(err) {
+		return err
+	}
+	return notFoundErr
+}
+
 // STEP EXECUTORS -------------------------------------------------------------------
 
 // start step builder pattern to have fluent way to process the executions
@@ -108,7 +215,7 @@ func (bse *boardStepExecutor) findBoard() *boardStepExecutor {
 	if bse.p.BoardName == "" {
 		bse.err = invalidPathError
 	} else if bse.session.Board, err = bse.tr.FindBoard(bse.p.BoardName); err != nil || bse.session.Board == nil {
-		bse.err = boardNotFoundError(bse.p.BoardName)
+		bse.err = notFoundOr(err, boardNotFoundError(bse.p.BoardName))
 	}
 	return bse
 }
@@ -124,6 +231,26 @@ func (bse *boardStepExecutor) doOnBoard(action func(*trello.Board)) *boardStepEx
 	return bse
 }
 
+func (bse *boardStepExecutor) doOnBoardName(action func(boardName string)) *boardStepExecutor {
+	if bse.isFinished || bse.p.ListName != "" {
+		return bse
+	}
+
+	var bErr boardNotFoundError
+	if bse.err != nil {
+		if !errors.As(bse.err, &bErr) {
+			return bse
+		}
+		// reset error as we are already handling this case afterward
+		bse.err = nil
+	}
+	if bse.p.BoardName != "" {
+		action(bse.p.BoardName)
+		bse.isFinished = true
+	}
+	return bse
+}
+
 func (bse *boardStepExecutor) then() *listStepExecutor {
 	return &listStepExecutor{stepExecutor: bse.stepExecutor}
 }
@@ -153,7 +280,29 @@ func (lse *listStepExecutor) findList() *listStepExecutor {
 	if lse.p.ListName == "" {
 		lse.err = invalidPathError
 	} else if lse.session.List, err = lse.tr.FindList(lse.session.Board.ID, lse.p.ListName); err != nil || lse.session.List == nil {
-		lse.err = listNotFoundError(lse.p.ListName)
+		lse.err = notFoundOr(err, listNotFoundError(lse.p.ListName))
+	}
+	return lse
+}
+
+// findListOrArchived finds the list like findList, then among the archived lists if it is not found
+func (lse *listStepExecutor) findListOrArchived() *listStepExecutor {
+	if lse.err != nil || lse.isFinished {
+		return lse
+	}
+	var err error
+	if lse.p.ListName == "" {
+		lse.err = invalidPathError
+	} else if lse.session.List, err = lse.tr.FindList(lse.session.Board.ID, lse.p.ListName); isUnreachable(err) {
+		lse.err = err
+	} else if err != nil || lse.session.List == nil {
+		lse.session.List = nil
+		if lists, err := lse.tr.FindArchivedLists(lse.session.Board.ID); err == nil {
+			lse.session.List = trello.FindList(lists, lse.p.ListName)
+		}
+		if lse.session.List == nil {
+			lse.err = listNotFoundError(lse.p.ListName)
+		}
 	}
 	return lse
 }
@@ -169,6 +318,26 @@ func (lse *listStepExecutor) doOnList(action func(*trello.List)) *listStepExecut
 	return lse
 }
 
+func (lse *listStepExecutor) doOnListName(action func(listName string, session *trello.Session)) *listStepExecutor {
+	if lse.isFinished || lse.p.CardName != "" {
+		return lse
+	}
+
+	var lErr listNotFoundError
+	if lse.err != nil {
+		if !errors.As(lse.err, &lErr) {
+			return lse
+		}
+		// reset error as we are already handling this case afterward
+		lse.err = nil
+	}
+	if lse.p.ListName != "" {
+		action(lse.p.ListName, lse.session)
+		lse.isFinished = true
+	}
+	return lse
+}
+
 func (lse *listStepExecutor) then() *cardStepExecutor {
 	return &cardStepExecutor{stepExecutor: lse.stepExecutor}
 }
@@ -257,6 +426,21 @@ func (cse *cardStepExecutor) findCard() *cardStepExecutor {
 	if cse.p.CardName == "" {
 		cse.err = invalidPathError
 	} else if cse.session.Card, err = cse.tr.FindCard(cse.session.List.ID, cse.p.CardName); err != nil || cse.session.Card == nil {
+		cse.err = notFoundOr(err, cardNotFoundError(cse.p.CardName))
+	}
+	return cse
+}
+
+// findArchivedCard finds the card among the archived cards of the list
+func (cse *cardStepExecutor) findArchivedCard() *cardStepExecutor {
+	if cse.err != nil || cse.isFinished {
+		return cse
+	}
+	if cse.p.CardName == "" {
+		cse.err = invalidPathError
+	} else if cards, err := cse.tr.FindArchivedCards(cse.session.List.ID); err != nil {
+		cse.err = notFoundOr(err, cardNotFoundError(cse.p.CardName))
+	} else if cse.session.Card = trello.FindCard(cards, cse.p.CardName); cse.session.Card == nil {
 		cse.err = cardNotFoundError(cse.p.CardName)
 	}
 	return cse
@@ -270,7 +454,34 @@ func (cse *cardStepExecutor) then() *commentStepExecutor {
 
 type commentStepExecutor struct {
 	stepExecutor
-	comment *trello.Comment
+	comment    *trello.Comment
+	attachment *trello.Attachment
+}
+
+// findAttachment finds the attachment of the card named like the last part of the path,
+// as it can be either a comment ID or an attachment name
+func (cse *commentStepExecutor) findAttachment() *commentStepExecutor {
+	if cse.err != nil || cse.isFinished || cse.p.CommentID == "" {
+		return cse
+	}
+	attachments, err := cse.tr.FindAttachments(cse.session.Card.ID)
+	if err != nil {
+		cse.err = fmt.Errorf("could not fetch attachments of card '%s': %v", cse.session.Card.Name, err)
+		return cse
+	}
+	cse.attachment = trello.FindAttachment(attachments, cse.p.CommentID)
+	return cse
+}
+
+func (cse *commentStepExecutor) doOnAttachment(action func(card *trello.Card, attachment *trello.Attachment)) *commentStepExecutor {
+	if cse.err != nil || cse.isFinished {
+		return cse
+	}
+	if cse.attachment != nil {
+		action(cse.session.Card, cse.attachment)
+		cse.isFinished = true
+	}
+	return cse
 }
 
 func (cse *commentStepExecutor) doOnEmptyCommentID(action func(session *trello.Session)) *commentStepExecutor {
@@ -292,7 +503,7 @@ func (cse *commentStepExecutor) findComment() *commentStepExecutor {
 	if cse.p.CommentID == "" {
 		cse.err = invalidPathError
 	} else if cse.comment, err = cse.tr.FindComment(cse.session.Card.ID, cse.p.CommentID); err != nil || cse.comment == nil {
-		cse.err = commentNotFoundError(cse.p.CommentID)
+		cse.err = notFoundOr(err, commentNotFoundError(cse.p.CommentID))
 	}
 	return cse
 }
@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"gopkg.in/yaml.v2"
+)
+
+// yamlFlag exports the board in YAML instead of JSON
+const yamlFlag = "--yaml"
+
+type export struct {
+	executor
+	yaml bool
+}
+
+// Execute writes the archive of the given board, or of the current board, in JSON or in YAML
+func (e export) Execute(args []string) {
+	var paths []string
+	for _, arg := range args {
+		if arg == yamlFlag {
+			e.yaml = true
+		} else {
+			paths = append(paths, arg)
+		}
+	}
+	if len(paths) > 1 {
+		fmt.Fprintf(e.stderr, "only one board is accepted\n")
+		return
+	}
+	arg := ""
+	if len(paths) == 1 {
+		arg = paths[0]
+	}
+
+	exec := start(e.tr).
+		resolvePath(e.session, arg).
+		then()
+	if exec.err == nil && exec.p.BoardName == "" {
+		fmt.Fprintf(e.stderr, "%s\n", noBoardError)
+		return
+	}
+	if exec.findBoard(); exec.err != nil {
+		fmt.Fprintf(e.stderr, "%s\n", exec.err)
+		return
+	}
+	archive, err := trello.ExportBoard(e.tr, *exec.session.Board)
+	if err != nil {
+		fmt.Fprintf(e.stderr, "%v\n", err)
+		return
+	}
+
+	var b []byte
+	if e.yaml {
+		b, err = yaml.Marshal(archive)
+	} else {
+		b, err = json.MarshalIndent(archive, "", "  ")
+		b = append(b, '\n')
+	}
+	if err != nil {
+		fmt.Fprintf(e.stderr, "could not marshal board '%s': %v\n", archive.Name, err)
+		return
+	}
+	e.stdout.Write(b)
+}
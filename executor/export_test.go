@@ -0,0 +1,120 @@
+package executor
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestExport_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := trello.Board{ID: "board 1", Name: "board"}
+	buildTrelloRepository := func() trello.Repository {
+		tr := trello.NewMockRepository(ctrl)
+		tr.EXPECT().
+			FindBoard(board.Name).
+			Return(&board, nil)
+		tr.EXPECT().
+			FindLabels(board.ID).
+			Return(trello.Labels{}, nil)
+		tr.EXPECT().
+			FindLists(board.ID).
+			Return(trello.Lists{{ID: "list 1", Name: "todo", Pos: 1}}, nil)
+		tr.EXPECT().
+			FindCards("list 1").
+			Return(trello.Cards{}, nil)
+		return tr
+	}
+
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		args                  []string
+		session               *trello.Session
+		buildTrelloRepository func() trello.Repository
+		expected              expected
+	}{
+		"export in JSON": {
+			args:                  []string{"/board"},
+			session:               &trello.Session{},
+			buildTrelloRepository: buildTrelloRepository,
+			expected: expected{
+				stdout: `{
+  "version": 1,
+  "name": "board",
+  "labels": [],
+  "lists": [
+    {
+      "name": "todo",
+      "pos": 1,
+      "cards": []
+    }
+  ]
+}
+`,
+			},
+		},
+		"export current board in YAML": {
+			args:                  []string{"--yaml"},
+			session:               &trello.Session{Board: &board},
+			buildTrelloRepository: buildTrelloRepository,
+			expected: expected{
+				stdout: `version: 1
+name: board
+labels: []
+lists:
+- name: todo
+  pos: 1
+  cards: []
+`,
+			},
+		},
+		"no board selected": {
+			args:    []string{},
+			session: &trello.Session{},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "no board selected, give the path of a board or 'cd' into it\n",
+			},
+		},
+		"too many boards": {
+			args:    []string{"/board", "/another-board"},
+			session: &trello.Session{},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "only one board is accepted\n",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			e := export{executor: executor{
+				tr:      tt.buildTrelloRepository(),
+				session: tt.session,
+				stdout:  &stdoutBuf,
+				stderr:  &stderrBuf,
+			}}
+			e.Execute(tt.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package executor
 
 import (
+	"context"
 	"github.com/l-lin/tcli/conf"
 	"github.com/l-lin/tcli/renderer"
 	"github.com/l-lin/tcli/trello"
 	"io"
 	"os"
+	"os/signal"
+	"time"
 )
 
 var Factories = []Factory{
@@ -55,9 +58,9 @@ var Factories = []Factory{
 	},
 	{
 		Cmd:         "ls",
-		Description: "list resource content",
+		Description: "list resource content (--mine to only show the cards assigned to you, --archived to show the archived ones)",
 		Create: func(_ conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
-			return &ls{executor{
+			return &ls{executor: executor{
 				tr:      tr,
 				r:       r,
 				session: session,
@@ -79,6 +82,19 @@ var Factories = []Factory{
 			}}
 		},
 	},
+	{
+		Cmd:         "find",
+		Description: "find cards by name, description, label, checklist or comment",
+		Create: func(_ conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &find{executor{
+				tr:      tr,
+				r:       r,
+				session: session,
+				stdout:  stdout,
+				stderr:  stderr,
+			}}
+		},
+	},
 	{
 		Cmd:         "edit",
 		Description: "edit resource content",
@@ -91,11 +107,12 @@ var Factories = []Factory{
 					stdout:  stdout,
 					stderr:  stderr,
 				},
-				stdin:         os.Stdin,
-				editor:        NewOsEditor(conf.Editor),
-				editRenderer:  renderer.NewEdit(conf.Format),
-				defaultLabels: conf.Labels,
-				neverPrompt:   conf.NeverPrompt,
+				stdin:          os.Stdin,
+				editor:         NewOsEditor(conf.Editor),
+				editRenderer:   renderer.NewEdit(conf.Format),
+				defaultLabels:  conf.Labels,
+				neverPrompt:    conf.NeverPrompt,
+				nonInteractive: conf.NonInteractive,
 			}
 		},
 	},
@@ -126,8 +143,24 @@ var Factories = []Factory{
 					stdout:  stdout,
 					stderr:  stderr,
 				},
-				stdin:       os.Stdin,
-				neverPrompt: conf.NeverPrompt,
+				stdin:          os.Stdin,
+				neverPrompt:    conf.NeverPrompt,
+				nonInteractive: conf.NonInteractive,
+			}
+		},
+	},
+	{
+		Cmd:         "restore",
+		Description: "restore archived lists and cards",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &restore{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
 			}
 		},
 	},
@@ -161,6 +194,226 @@ var Factories = []Factory{
 			}
 		},
 	},
+	{
+		Cmd:         "attach",
+		Description: "upload local files as card attachments",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &attach{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+			}
+		},
+	},
+	{
+		Cmd:         "label",
+		Description: "list, add, edit or remove the labels of a board",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &label{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				stdin:          os.Stdin,
+				neverPrompt:    conf.NeverPrompt,
+				nonInteractive: conf.NonInteractive,
+			}
+		},
+	},
+	{
+		Cmd:         "undo",
+		Description: "undo the last mutation",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			h, _ := tr.(trello.History)
+			return &undo{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				history: h,
+			}
+		},
+	},
+	{
+		Cmd:         "redo",
+		Description: "redo the last undone mutation",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			h, _ := tr.(trello.History)
+			return &redo{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				history: h,
+			}
+		},
+	},
+	{
+		Cmd:         "history",
+		Description: "show the mutations that can be undone or redone",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			h, _ := tr.(trello.History)
+			return &history{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				history: h,
+			}
+		},
+	},
+	{
+		Cmd:         "log",
+		Description: "show the activity of a board, list or card (--since <date>)",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &activityLog{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+			}
+		},
+	},
+	{
+		Cmd:         "watch",
+		Description: "print the activity of a board or list as it happens (--interval <duration>)",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &watch{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				offline:  conf.Offline,
+				interval: defaultWatchInterval,
+				now:      time.Now,
+				interrupted: func() (context.Context, context.CancelFunc) {
+					return signal.NotifyContext(context.Background(), os.Interrupt)
+				},
+			}
+		},
+	},
+	{
+		Cmd:         "export",
+		Description: "write a board with its lists, cards and comments in JSON (--yaml for YAML)",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &export{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+			}
+		},
+	},
+	{
+		Cmd:         "import",
+		Description: "create a new board from an exported board",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &importBoard{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				stdin: os.Stdin,
+			}
+		},
+	},
+	{
+		Cmd:         "report",
+		Description: "write a Markdown report of a board or list (--html, --preview, --comments)",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			preview, err := renderer.NewTermDescription()
+			if err != nil {
+				preview = renderer.PlainDescription{}
+			}
+			return &report{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				preview: preview,
+			}
+		},
+	},
+	{
+		Cmd:         "warm",
+		Description: "fetch in advance the lists, cards and labels of boards",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &warm{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+			}
+		},
+	},
+	{
+		Cmd:         "sync",
+		Description: "send the mutations performed offline, --force to ignore the conflicts, --discard to drop them",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			return &synchronize{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				offline: conf.Offline,
+				outbox:  trello.NewOutbox(conf),
+			}
+		},
+	},
+	{
+		Cmd:         "dry-run",
+		Description: "print the mutations instead of sending them (on, off or toggle)",
+		Create: func(conf conf.Conf, tr trello.Repository, r renderer.Renderer, session *trello.Session, stdout, stderr io.Writer) Executor {
+			toggle, _ := tr.(trello.DryRunToggle)
+			return &dryRun{
+				executor: executor{
+					tr:      tr,
+					r:       r,
+					session: session,
+					stdout:  stdout,
+					stderr:  stderr,
+				},
+				toggle: toggle,
+			}
+		},
+	},
 }
 
 type Factory struct {
@@ -0,0 +1,76 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+type find struct {
+	executor
+}
+
+// Execute searches the cards matching the query in the current board (or in all the boards at the top level)
+// and prints their paths, so they can be used by the other commands
+func (f find) Execute(args []string) {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if query == "" {
+		fmt.Fprintf(f.stderr, "nothing to find\n")
+		return
+	}
+
+	var idBoards []string
+	idList := ""
+	if f.session != nil && f.session.Board != nil {
+		idBoards = append(idBoards, f.session.Board.ID)
+		if f.session.List != nil {
+			idList = f.session.List.ID
+		}
+	}
+	cards, err := f.tr.SearchCards(query, idBoards...)
+	if err != nil {
+		fmt.Fprintf(f.stderr, "could not search cards with query '%s': %v\n", query, err)
+		return
+	}
+	if len(cards) == 0 {
+		return
+	}
+
+	boards, err := f.tr.FindBoards()
+	if err != nil {
+		fmt.Fprintf(f.stderr, "could not fetch boards: %v\n", err)
+		return
+	}
+	mapListsByIDBoard := map[string]trello.Lists{}
+	for _, card := range cards {
+		if card.Closed || idList != "" && card.IDList != idList {
+			continue
+		}
+		board := trello.FindBoard(boards, card.IDBoard)
+		if board == nil {
+			log.Debug().
+				Str("idCard", card.ID).
+				Str("idBoard", card.IDBoard).
+				Msg("board of the card not found, it may be closed")
+			continue
+		}
+		lists, found := mapListsByIDBoard[board.ID]
+		if !found {
+			if lists, err = f.tr.FindLists(board.ID); err != nil {
+				fmt.Fprintf(f.stderr, "could not fetch lists for board '%s': %v\n", board.Name, err)
+				return
+			}
+			mapListsByIDBoard[board.ID] = lists
+		}
+		list := trello.FindList(lists, card.IDList)
+		if list == nil {
+			log.Debug().
+				Str("idCard", card.ID).
+				Str("idList", card.IDList).
+				Msg("list of the card not found, it may be archived")
+			continue
+		}
+		fmt.Fprintf(f.stdout, "/%s/%s/%s\n", board.TCliID(), list.TCliID(), card.TCliID())
+	}
+}
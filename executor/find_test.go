@@ -0,0 +1,179 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestFind_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type given struct {
+		args                  []string
+		session               *trello.Session
+		buildTrelloRepository func() trello.Repository
+	}
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	board1 := trello.Board{ID: "board 1", Name: "board", ShortLink: "b1"}
+	board2 := trello.Board{ID: "board 2", Name: "another board", ShortLink: "b2"}
+	boards := trello.Boards{board1, board2}
+	list1 := trello.List{ID: "list 1", Name: "list", IDBoard: board1.ID}
+	list2 := trello.List{ID: "list 2", Name: "another list", IDBoard: board1.ID}
+	list3 := trello.List{ID: "list 3", Name: "list 3", IDBoard: board2.ID}
+	card1 := trello.Card{ID: "card 1", Name: "card", ShortLink: "c1", IDBoard: board1.ID, IDList: list1.ID}
+	card2 := trello.Card{ID: "card 2", Name: "another card", ShortLink: "c2", IDBoard: board1.ID, IDList: list2.ID}
+	card3 := trello.Card{ID: "card 3", Name: "card 3", ShortLink: "c3", IDBoard: board2.ID, IDList: list3.ID}
+	closedCard := trello.Card{ID: "card 4", Name: "closed card", ShortLink: "c4", IDBoard: board1.ID, IDList: list1.ID, Closed: true}
+	cardOfClosedBoard := trello.Card{ID: "card 5", Name: "card 5", ShortLink: "c5", IDBoard: "closed board", IDList: "list 5"}
+
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"find at top level": {
+			given: given{
+				args:    []string{"some", "query"},
+				session: &trello.Session{},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						SearchCards("some query").
+						Return(trello.Cards{card1, card2, card3, closedCard, cardOfClosedBoard}, nil)
+					tr.EXPECT().
+						FindBoards().
+						Return(boards, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(trello.Lists{list1, list2}, nil).
+						Times(1)
+					tr.EXPECT().
+						FindLists(board2.ID).
+						Return(trello.Lists{list3}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				stdout: `/board[b1]/list[list 1]/card[c1]
+/board[b1]/another\ list[list 2]/another\ card[c2]
+/another\ board[b2]/list\ 3[list 3]/card\ 3[c3]
+`,
+			},
+		},
+		"find in a board": {
+			given: given{
+				args:    []string{"query"},
+				session: &trello.Session{Board: &board1},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						SearchCards("query", board1.ID).
+						Return(trello.Cards{card1, card2}, nil)
+					tr.EXPECT().
+						FindBoards().
+						Return(boards, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(trello.Lists{list1, list2}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				stdout: `/board[b1]/list[list 1]/card[c1]
+/board[b1]/another\ list[list 2]/another\ card[c2]
+`,
+			},
+		},
+		"find in a list": {
+			given: given{
+				args:    []string{"query"},
+				session: &trello.Session{Board: &board1, List: &list2},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						SearchCards("query", board1.ID).
+						Return(trello.Cards{card1, card2}, nil)
+					tr.EXPECT().
+						FindBoards().
+						Return(boards, nil)
+					tr.EXPECT().
+						FindLists(board1.ID).
+						Return(trello.Lists{list1, list2}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				stdout: "/board[b1]/another\\ list[list 2]/another\\ card[c2]\n",
+			},
+		},
+		"no card found": {
+			given: given{
+				args: []string{"query"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						SearchCards("query").
+						Return(trello.Cards{}, nil)
+					return tr
+				},
+			},
+			expected: expected{},
+		},
+		"no query": {
+			given: given{
+				args: []string{},
+				buildTrelloRepository: func() trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+			},
+			expected: expected{
+				stderr: "nothing to find\n",
+			},
+		},
+		"error when searching cards": {
+			given: given{
+				args: []string{"query"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						SearchCards("query").
+						Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+			},
+			expected: expected{
+				stderr: "could not search cards with query 'query': unexpected error\n",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			f := find{
+				executor{
+					tr:      tt.given.buildTrelloRepository(),
+					session: tt.given.session,
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+			}
+			f.Execute(tt.given.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
@@ -14,17 +14,32 @@ func TestHelp_Execute(t *testing.T) {
 	h.Execute(nil)
 
 	// THEN
-	expected := `help     display help
-exit     exit CLI
-clear    clear the terminal screen & cache
-cd       change level in the hierarchy
-ls       list resource content
-cat      show resource content info
-edit     edit resource content
-touch    create new resource
-rm       archive resource
-mv       move resource
-cp       copy resource
+	expected := `help       display help
+exit       exit CLI
+clear      clear the terminal screen & cache
+cd         change level in the hierarchy
+ls         list resource content (--mine to only show the cards assigned to you, --archived to show the archived ones)
+cat        show resource content info
+find       find cards by name, description, label, checklist or comment
+edit       edit resource content
+touch      create new resource
+rm         archive resource
+restore    restore archived lists and cards
+mv         move resource
+cp         copy resource
+attach     upload local files as card attachments
+label      list, add, edit or remove the labels of a board
+undo       undo the last mutation
+redo       redo the last undone mutation
+history    show the mutations that can be undone or redone
+log        show the activity of a board, list or card (--since <date>)
+watch      print the activity of a board or list as it happens (--interval <duration>)
+export     write a board with its lists, cards and comments in JSON (--yaml for YAML)
+import     create a new board from an exported board
+report     write a Markdown report of a board or list (--html, --preview, --comments)
+warm       fetch in advance the lists, cards and labels of boards
+sync       send the mutations performed offline, --force to ignore the conflicts, --discard to drop them
+dry-run    print the mutations instead of sending them (on, off or toggle)
 
 `
 	actual := buf.String()
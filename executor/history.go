@@ -0,0 +1,25 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+)
+
+type history struct {
+	executor
+	history trello.History
+}
+
+// Execute shows the mutations recorded in the journal
+func (h history) Execute(_ []string) {
+	if h.history == nil {
+		fmt.Fprint(h.stderr, journalDisabledError)
+		return
+	}
+	entries, err := h.history.Entries()
+	if err != nil {
+		fmt.Fprintf(h.stderr, "could not read the journal: %v\n", err)
+		return
+	}
+	printRendered(h.stdout, h.r.RenderJournal(entries))
+}
@@ -0,0 +1,90 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/renderer"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestHistory_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entries := trello.JournalEntries{{ID: 1, Operation: "create card"}}
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		buildHistory  func() trello.History
+		buildRenderer func() renderer.Renderer
+		expected      expected
+	}{
+		"show the journal": {
+			buildHistory: func() trello.History {
+				h := trello.NewMockHistory(ctrl)
+				h.EXPECT().
+					Entries().
+					Return(entries, nil)
+				return h
+			},
+			buildRenderer: func() renderer.Renderer {
+				r := renderer.NewMockRenderer(ctrl)
+				r.EXPECT().
+					RenderJournal(entries).
+					Return("journal")
+				return r
+			},
+			expected: expected{
+				stdout: "journal\n",
+			},
+		},
+		"error when reading the journal": {
+			buildHistory: func() trello.History {
+				h := trello.NewMockHistory(ctrl)
+				h.EXPECT().
+					Entries().
+					Return(nil, errors.New("unexpected error"))
+				return h
+			},
+			buildRenderer: func() renderer.Renderer { return nil },
+			expected: expected{
+				stderr: "could not read the journal: unexpected error\n",
+			},
+		},
+		"journal disabled": {
+			buildHistory:  func() trello.History { return nil },
+			buildRenderer: func() renderer.Renderer { return nil },
+			expected: expected{
+				stderr: journalDisabledError,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			h := history{
+				executor: executor{
+					r:      tt.buildRenderer(),
+					stdout: &stdoutBuf,
+					stderr: &stderrBuf,
+				},
+				history: tt.buildHistory(),
+			}
+			h.Execute(nil)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
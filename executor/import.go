@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"gopkg.in/yaml.v2"
+	"io"
+	"os"
+)
+
+// stdinFile reads the archive from the standard input instead of a file
+const stdinFile = "-"
+
+type importBoard struct {
+	executor
+	stdin io.Reader
+}
+
+// Execute creates a new board from an archive written by the export command, in JSON or in YAML
+func (i importBoard) Execute(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintf(i.stderr, "missing file operand\n")
+		return
+	}
+	if len(args) == 1 {
+		fmt.Fprintf(i.stderr, "missing destination board operand after '%s'\n", args[0])
+		return
+	}
+	if len(args) > 2 {
+		fmt.Fprintf(i.stderr, "only one file and one destination board are accepted\n")
+		return
+	}
+
+	archive, err := i.readArchive(args[0])
+	if err != nil {
+		fmt.Fprintf(i.stderr, "%v\n", err)
+		return
+	}
+
+	exec := start(i.tr).
+		resolvePath(i.session, args[1])
+	if exec.err != nil {
+		fmt.Fprintf(i.stderr, "%s\n", exec.err)
+		return
+	}
+	if exec.p.BoardName == "" || exec.p.ListName != "" {
+		fmt.Fprintf(i.stderr, "destination '%s' is not a board\n", args[1])
+		return
+	}
+	boards, err := i.tr.FindBoards()
+	if err != nil {
+		fmt.Fprintf(i.stderr, "could not fetch boards: %v\n", err)
+		return
+	}
+	if trello.FindBoard(boards, exec.p.BoardName) != nil {
+		fmt.Fprintf(i.stderr, "board '%s' already exists\n", exec.p.BoardName)
+		return
+	}
+
+	if _, err = trello.ImportBoard(i.tr, *archive, exec.p.BoardName); err != nil {
+		fmt.Fprintf(i.stderr, "could not import '%s': %v\n", args[0], err)
+	}
+}
+
+func (i importBoard) readArchive(file string) (*trello.BoardArchive, error) {
+	var b []byte
+	var err error
+	if file == stdinFile {
+		b, err = io.ReadAll(i.stdin)
+	} else {
+		b, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read '%s': %v", file, err)
+	}
+	// JSON being a subset of YAML, both formats are read the same way
+	var archive trello.BoardArchive
+	if err = yaml.Unmarshal(b, &archive); err != nil {
+		return nil, fmt.Errorf("could not parse '%s': %v", file, err)
+	}
+	if archive.Version == 0 {
+		return nil, fmt.Errorf("'%s' is not a board archive", file)
+	}
+	return &archive, nil
+}
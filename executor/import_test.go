@@ -0,0 +1,114 @@
+package executor
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"strings"
+	"testing"
+)
+
+func TestImportBoard_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	archive := `version: 1
+name: template
+lists:
+- name: todo
+  pos: 1
+`
+	var tests = map[string]struct {
+		args                  []string
+		stdin                 string
+		buildTrelloRepository func() trello.Repository
+		expected              string
+	}{
+		"import from stdin": {
+			args:  []string{"-", "/new-board"},
+			stdin: archive,
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoards().
+					Return(trello.Boards{{ID: "board 1", Name: "template"}}, nil)
+				tr.EXPECT().
+					CreateBoard(gomock.Any()).
+					Return(&trello.Board{ID: "board 2", Name: "new-board"}, nil)
+				tr.EXPECT().
+					CreateList(trello.CreateList{Name: "todo", IDBoard: "board 2", Pos: float64(1)}).
+					Return(&trello.List{ID: "list 2", Name: "todo"}, nil)
+				return tr
+			},
+		},
+		"import JSON": {
+			args:  []string{"-", "/new-board"},
+			stdin: `{"version": 1, "name": "template", "lists": []}`,
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoards().
+					Return(trello.Boards{}, nil)
+				tr.EXPECT().
+					CreateBoard(gomock.Any()).
+					Return(&trello.Board{ID: "board 2", Name: "new-board"}, nil)
+				return tr
+			},
+		},
+		"board already exists": {
+			args:  []string{"-", "/template"},
+			stdin: archive,
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoards().
+					Return(trello.Boards{{ID: "board 1", Name: "template"}}, nil)
+				return tr
+			},
+			expected: "board 'template' already exists\n",
+		},
+		"destination is not a board": {
+			args:  []string{"-", "/board/list"},
+			stdin: archive,
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: "destination '/board/list' is not a board\n",
+		},
+		"not a board archive": {
+			args:  []string{"-", "/new-board"},
+			stdin: "foo: bar",
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: "'-' is not a board archive\n",
+		},
+		"missing destination": {
+			args: []string{"board.json"},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: "missing destination board operand after 'board.json'\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stderrBuf := bytes.Buffer{}
+			i := importBoard{
+				executor: executor{
+					tr:      tt.buildTrelloRepository(),
+					session: &trello.Session{},
+					stdout:  &bytes.Buffer{},
+					stderr:  &stderrBuf,
+				},
+				stdin: strings.NewReader(tt.stdin),
+			}
+			i.Execute(tt.args)
+
+			actual := stderrBuf.String()
+			if actual != tt.expected {
+				t.Errorf("expected stderr %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
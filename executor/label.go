@@ -0,0 +1,170 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"io"
+)
+
+const (
+	labelListCmd   = "ls"
+	labelAddCmd    = "add"
+	labelEditCmd   = "edit"
+	labelRemoveCmd = "rm"
+)
+
+// labelExistsFilter finds the labels that already exist with the same color and name, or with the same name
+var labelExistsFilter = trello.LabelFilterOr(trello.LabelFilterByTCliColor, trello.LabelFilterByName)
+
+type label struct {
+	executor
+	stdin       io.ReadCloser
+	neverPrompt bool
+	// nonInteractive refuses the confirmations instead of prompting them, e.g. in a script
+	nonInteractive bool
+}
+
+// Execute manages the labels of a board:
+//
+//	label [ls] [board]
+//	label add <board> <label>...
+//	label edit <board> <label> <new label>
+//	label rm <board> <label>...
+//
+// the labels are written like in the card templates, i.e. "color", "color [name]", "name" or its ID
+func (l label) Execute(args []string) {
+	if len(args) == 0 {
+		l.listLabels("")
+		return
+	}
+	switch args[0] {
+	case labelListCmd:
+		if len(args) > 2 {
+			fmt.Fprintf(l.stderr, "only one board is accepted\n")
+			return
+		}
+		board := ""
+		if len(args) == 2 {
+			board = args[1]
+		}
+		l.listLabels(board)
+	case labelAddCmd:
+		if len(args) < 3 {
+			fmt.Fprintf(l.stderr, "usage: label add <board> <label>...\n")
+			return
+		}
+		l.addLabels(args[1], args[2:])
+	case labelEditCmd:
+		if len(args) != 4 {
+			fmt.Fprintf(l.stderr, "usage: label edit <board> <label> <new label>\n")
+			return
+		}
+		l.editLabel(args[1], args[2], args[3])
+	case labelRemoveCmd:
+		if len(args) < 3 {
+			fmt.Fprintf(l.stderr, "usage: label rm <board> <label>...\n")
+			return
+		}
+		l.removeLabels(args[1], args[2:])
+	default:
+		if len(args) > 1 {
+			fmt.Fprintf(l.stderr, "unknown label command '%s', use one of 'ls', 'add', 'edit' or 'rm'\n", args[0])
+			return
+		}
+		l.listLabels(args[0])
+	}
+}
+
+func (l label) listLabels(arg string) {
+	_, labels, err := l.findLabels(arg)
+	if err != nil {
+		fmt.Fprintf(l.stderr, "%s\n", err)
+		return
+	}
+	printRendered(l.stdout, l.r.RenderLabels(labels))
+}
+
+func (l label) addLabels(arg string, tcliColors []string) {
+	board, labels, err := l.findLabels(arg)
+	if err != nil {
+		fmt.Fprintf(l.stderr, "%s\n", err)
+		return
+	}
+	for _, tcliColor := range tcliColors {
+		if len(labels.FilterBy([]string{tcliColor}, labelExistsFilter)) != 0 {
+			fmt.Fprintf(l.stderr, "label '%s' already exists\n", tcliColor)
+			continue
+		}
+		if _, err = l.tr.CreateLabel(trello.NewCreateLabel(board.ID, tcliColor)); err != nil {
+			fmt.Fprintf(l.stderr, "could not create label '%s': %v\n", tcliColor, err)
+		}
+	}
+}
+
+func (l label) editLabel(arg, query, tcliColor string) {
+	_, labels, err := l.findLabels(arg)
+	if err != nil {
+		fmt.Fprintf(l.stderr, "%s\n", err)
+		return
+	}
+	label, err := findLabel(labels, query)
+	if err != nil {
+		fmt.Fprintf(l.stderr, "%s\n", err)
+		return
+	}
+	if _, err = l.tr.UpdateLabel(trello.NewUpdateLabel(*label, tcliColor)); err != nil {
+		fmt.Fprintf(l.stderr, "could not update label '%s': %v\n", label.ToTCliColor(), err)
+	}
+}
+
+func (l label) removeLabels(arg string, queries []string) {
+	board, labels, err := l.findLabels(arg)
+	if err != nil {
+		fmt.Fprintf(l.stderr, "%s\n", err)
+		return
+	}
+	for _, query := range queries {
+		label, err := findLabel(labels, query)
+		if err != nil {
+			fmt.Fprintf(l.stderr, "%s\n", err)
+			continue
+		}
+		if !l.neverPrompt && !confirm(l.stdin, l.stderr, l.nonInteractive, fmt.Sprintf("Delete label '%s' (it will be removed from all the cards)", label.ToTCliColor())) {
+			continue
+		}
+		if err = l.tr.DeleteLabel(board.ID, label.ID); err != nil {
+			fmt.Fprintf(l.stderr, "could not delete label '%s': %v\n", label.ToTCliColor(), err)
+		}
+	}
+}
+
+// findLabels finds the board of the given path and its labels
+func (l label) findLabels(arg string) (*trello.Board, trello.Labels, error) {
+	exec := start(l.tr).
+		resolvePath(l.session, arg).
+		then()
+	if exec.err == nil && exec.p.BoardName == "" {
+		return nil, nil, noBoardError
+	}
+	if exec.findBoard(); exec.err != nil {
+		return nil, nil, exec.err
+	}
+	board := exec.session.Board
+	labels, err := l.tr.FindLabels(board.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not fetch labels for board '%s': %v", board.Name, err)
+	}
+	return board, labels, nil
+}
+
+// findLabel finds the only label matching the given query
+func findLabel(labels trello.Labels, query string) (*trello.Label, error) {
+	found := labels.FilterBy([]string{query}, cardLabelFilter)
+	if len(found) == 0 {
+		return nil, labelNotFoundError(query)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("several labels match '%s', use the label ID instead", query)
+	}
+	return &found[0], nil
+}
@@ -0,0 +1,288 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/renderer"
+	"github.com/l-lin/tcli/trello"
+	"io"
+	"testing"
+)
+
+func TestLabel_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type given struct {
+		args                  []string
+		session               *trello.Session
+		buildTrelloRepository func() trello.Repository
+		buildRenderer         func() renderer.Renderer
+		stdin                 io.ReadCloser
+	}
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	board := trello.Board{ID: "board 1", Name: "board"}
+	red := "red"
+	bug := trello.Label{ID: "label 1", IDBoard: board.ID, Name: "bug", Color: "red"}
+	feature := trello.Label{ID: "label 2", IDBoard: board.ID, Name: "feature", Color: "green"}
+	green := trello.Label{ID: "label 3", IDBoard: board.ID, Color: "green"}
+	labels := trello.Labels{bug, feature, green}
+	noRenderer := func() renderer.Renderer { return nil }
+
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"label in a board": {
+			given: given{
+				args:    []string{},
+				session: &trello.Session{Board: &board},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().
+						RenderLabels(labels).
+						Return("labels")
+					return r
+				},
+			},
+			expected: expected{
+				stdout: "labels\n",
+			},
+		},
+		"label ls /board": {
+			given: given{
+				args: []string{"ls", "/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().
+						RenderLabels(labels).
+						Return("labels")
+					return r
+				},
+			},
+			expected: expected{
+				stdout: "labels\n",
+			},
+		},
+		"label at top level": {
+			given: given{
+				args: []string{"ls"},
+				buildTrelloRepository: func() trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: noRenderer,
+			},
+			expected: expected{
+				stderr: "no board selected, give the path of a board or 'cd' into it\n",
+			},
+		},
+		"label ls /unknown": {
+			given: given{
+				args: []string{"ls", "/unknown"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard("unknown").
+						Return(nil, errors.New("not found"))
+					return tr
+				},
+				buildRenderer: noRenderer,
+			},
+			expected: expected{
+				stderr: "no board found with name 'unknown'\n",
+			},
+		},
+		"label add /board": {
+			given: given{
+				args: []string{"add", "/board", "red [urgent]", "bug", "green"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						CreateLabel(trello.CreateLabel{IDBoard: board.ID, Name: "urgent", Color: &red}).
+						Return(&trello.Label{ID: "label 4"}, nil)
+					return tr
+				},
+				buildRenderer: noRenderer,
+			},
+			expected: expected{
+				stderr: "label 'bug' already exists\nlabel 'green' already exists\n",
+			},
+		},
+		"label add without label": {
+			given: given{
+				args:                  []string{"add", "/board"},
+				buildTrelloRepository: func() trello.Repository { return nil },
+				buildRenderer:         noRenderer,
+			},
+			expected: expected{
+				stderr: "usage: label add <board> <label>...\n",
+			},
+		},
+		"label edit /board": {
+			given: given{
+				args: []string{"edit", "/board", "bug", "defect"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						UpdateLabel(trello.UpdateLabel{ID: bug.ID, IDBoard: board.ID, Name: "defect", Color: &red}).
+						Return(&bug, nil)
+					return tr
+				},
+				buildRenderer: noRenderer,
+			},
+			expected: expected{},
+		},
+		"label edit with ambiguous label": {
+			given: given{
+				args: []string{"edit", "/board", "green", "sky"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					return tr
+				},
+				buildRenderer: noRenderer,
+			},
+			expected: expected{
+				stderr: "several labels match 'green', use the label ID instead\n",
+			},
+		},
+		"label rm /board (user accepts to delete)": {
+			given: given{
+				args: []string{"rm", "/board", "red [bug]"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						DeleteLabel(board.ID, bug.ID).
+						Return(nil)
+					return tr
+				},
+				buildRenderer: noRenderer,
+				stdin:         acceptStdin(),
+			},
+			expected: expected{},
+		},
+		"label rm /board (user refuses to delete)": {
+			given: given{
+				args: []string{"rm", "/board", "bug"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					tr.EXPECT().
+						DeleteLabel(gomock.Any(), gomock.Any()).
+						Times(0)
+					return tr
+				},
+				buildRenderer: noRenderer,
+				stdin:         refuseStdin(),
+			},
+			expected: expected{},
+		},
+		"label rm /board unknown": {
+			given: given{
+				args: []string{"rm", "/board", "unknown"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindLabels(board.ID).
+						Return(labels, nil)
+					return tr
+				},
+				buildRenderer: noRenderer,
+			},
+			expected: expected{
+				stderr: "no label found with name 'unknown'\n",
+			},
+		},
+		"unknown label command": {
+			given: given{
+				args:                  []string{"rename", "/board", "bug"},
+				buildTrelloRepository: func() trello.Repository { return nil },
+				buildRenderer:         noRenderer,
+			},
+			expected: expected{
+				stderr: "unknown label command 'rename', use one of 'ls', 'add', 'edit' or 'rm'\n",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			l := label{
+				executor: executor{
+					tr:      tt.given.buildTrelloRepository(),
+					r:       tt.given.buildRenderer(),
+					session: tt.given.session,
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+				stdin: tt.given.stdin,
+			}
+			l.Execute(tt.given.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
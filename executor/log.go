@@ -0,0 +1,100 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"strings"
+	"time"
+)
+
+// sinceFlag only shows the activity after the given date, e.g. "--since yesterday" or "--since '3 days ago'"
+const sinceFlag = "--since"
+
+type activityLog struct {
+	executor
+	since time.Time
+}
+
+// Execute shows the activity of the given board, list or card, or of the current one,
+// from the most recent to the oldest
+func (l activityLog) Execute(args []string) {
+	var paths []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg != sinceFlag && !strings.HasPrefix(arg, sinceFlag+"=") {
+			paths = append(paths, arg)
+			continue
+		}
+		value := strings.TrimPrefix(strings.TrimPrefix(arg, sinceFlag), "=")
+		if arg == sinceFlag {
+			if i+1 >= len(args) {
+				fmt.Fprintf(l.stderr, "missing date after %s\n", sinceFlag)
+				return
+			}
+			i++
+			value = args[i]
+		}
+		since, err := trello.ParseSince(value)
+		if err != nil {
+			fmt.Fprintf(l.stderr, "%v\n", err)
+			return
+		}
+		l.since = since
+	}
+	if len(paths) > 1 {
+		fmt.Fprintf(l.stderr, "only one board, list or card is accepted\n")
+		return
+	}
+	arg := ""
+	if len(paths) == 1 {
+		arg = paths[0]
+	}
+
+	exec := start(l.tr).
+		resolvePath(l.session, arg).
+		then()
+	if exec.err == nil && exec.p.BoardName == "" {
+		fmt.Fprintf(l.stderr, "%s\n", noBoardError)
+		return
+	}
+	var actions trello.Actions
+	var err error
+	listExec := exec.
+		findBoard().
+		doOnBoard(func(board *trello.Board) {
+			if actions, err = l.tr.FindBoardActions(board.ID, l.since); err != nil {
+				err = fmt.Errorf("could not fetch activity of board '%s': %v", board.Name, err)
+			}
+		}).
+		then()
+	cardExec := listExec.
+		findList().
+		doOnList(func(list *trello.List) {
+			if actions, err = l.tr.FindBoardActions(list.IDBoard, l.since); err != nil {
+				err = fmt.Errorf("could not fetch activity of list '%s': %v", list.Name, err)
+				return
+			}
+			actions = actions.FilterByList(list.ID)
+		}).
+		then()
+	cardExec.
+		findCard().
+		doOnCard(func(card *trello.Card) {
+			if actions, err = l.tr.FindCardActions(card.ID, l.since); err != nil {
+				err = fmt.Errorf("could not fetch activity of card '%s': %v", card.Name, err)
+			}
+		})
+	if cardExec.err != nil {
+		fmt.Fprintf(l.stderr, "%s\n", cardExec.err)
+		return
+	}
+	if !cardExec.isFinished {
+		fmt.Fprintf(l.stderr, "only boards, lists and cards have an activity\n")
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(l.stderr, "%v\n", err)
+		return
+	}
+	printRendered(l.stdout, l.r.RenderActions(actions))
+}
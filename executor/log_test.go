@@ -0,0 +1,169 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/renderer"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+	"time"
+)
+
+func TestActivityLog_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := trello.Board{ID: "board 1", Name: "board"}
+	list := trello.List{ID: "list 1", Name: "list", IDBoard: board.ID}
+	card := trello.Card{ID: "card 1", Name: "card", IDList: list.ID}
+	inList := trello.Action{ID: "action 1", Data: trello.ActionData{List: &trello.ActionResource{ID: list.ID}}}
+	inOtherList := trello.Action{ID: "action 2", Data: trello.ActionData{List: &trello.ActionResource{ID: "list 2"}}}
+
+	type given struct {
+		args                  []string
+		buildTrelloRepository func() trello.Repository
+		buildRenderer         func() renderer.Renderer
+	}
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"board activity": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					tr.EXPECT().FindBoardActions(board.ID, time.Time{}).Return(trello.Actions{inList, inOtherList}, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().RenderActions(trello.Actions{inList, inOtherList}).Return("board activity")
+					return r
+				},
+			},
+			expected: expected{stdout: "board activity\n"},
+		},
+		"list activity since a date": {
+			given: given{
+				args: []string{"--since", "2026-10-01", "/board/list"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, list.Name).Return(&list, nil)
+					tr.EXPECT().
+						FindBoardActions(board.ID, time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)).
+						Return(trello.Actions{inList, inOtherList}, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().RenderActions(trello.Actions{inList}).Return("list activity")
+					return r
+				},
+			},
+			expected: expected{stdout: "list activity\n"},
+		},
+		"card activity": {
+			given: given{
+				args: []string{"/board/list/card", "--since=3 days ago"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, list.Name).Return(&list, nil)
+					tr.EXPECT().FindCard(list.ID, card.Name).Return(&card, nil)
+					tr.EXPECT().FindCardActions(card.ID, gomock.Any()).Return(trello.Actions{inList}, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().RenderActions(trello.Actions{inList}).Return("card activity")
+					return r
+				},
+			},
+			expected: expected{stdout: "card activity\n"},
+		},
+		"invalid since": {
+			given: given{
+				args: []string{"/board", "--since", "someday"},
+				buildTrelloRepository: func() trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "invalid date 'someday', use a date like '2026-11-01 17:00', '2026-11-01', 'tomorrow' or 'in 3 days'\n"},
+		},
+		"missing since": {
+			given: given{
+				args: []string{"/board", "--since"},
+				buildTrelloRepository: func() trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "missing date after --since\n"},
+		},
+		"error when fetching activity": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					tr.EXPECT().FindBoardActions(board.ID, time.Time{}).Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "could not fetch activity of board 'board': unexpected error\n"},
+		},
+		"no board selected": {
+			given: given{
+				args: []string{},
+				buildTrelloRepository: func() trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "no board selected, give the path of a board or 'cd' into it\n"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			l := activityLog{
+				executor: executor{
+					tr:      tt.given.buildTrelloRepository(),
+					r:       tt.given.buildRenderer(),
+					session: &trello.Session{},
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+			}
+			l.Execute(tt.given.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
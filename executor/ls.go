@@ -5,16 +5,48 @@ import (
 	"github.com/l-lin/tcli/trello"
 )
 
+const (
+	// mineFlag only shows the cards assigned to the current member
+	mineFlag = "--mine"
+	// archivedFlag shows the archived lists of a board, or the archived cards of a list
+	archivedFlag = "--archived"
+)
+
 type ls struct {
 	executor
+	mine     bool
+	archived bool
 }
 
 func (l ls) Execute(args []string) {
-	if len(args) == 0 {
+	var paths []string
+	for _, arg := range args {
+		switch arg {
+		case mineFlag:
+			l.mine = true
+		case archivedFlag:
+			l.archived = true
+		default:
+			paths = append(paths, arg)
+		}
+	}
+	if l.archived {
+		if len(paths) == 0 {
+			l.executeArchived("")
+		}
+		// the paths are not expanded, as the globs only match the lists and cards that are not archived
+		for _, path := range paths {
+			l.executeArchived(path)
+		}
+		return
+	}
+	if len(paths) == 0 {
 		l.execute("")
 	}
-	for _, arg := range args {
-		l.execute(arg)
+	for _, path := range paths {
+		for _, p := range l.expandPath(path) {
+			l.execute(p)
+		}
 	}
 }
 
@@ -48,12 +80,38 @@ func (l ls) execute(arg string) {
 	}
 }
 
+func (l ls) executeArchived(arg string) {
+	exec := start(l.tr).
+		resolvePath(l.session, arg).
+		then()
+	if exec.err == nil && exec.p.BoardName == "" {
+		fmt.Fprintf(l.stderr, "%s\n", noBoardError)
+		return
+	}
+	listExec := exec.
+		findBoard().
+		doOnBoard(func(board *trello.Board) {
+			l.renderArchivedLists(*board)
+		}).
+		then()
+	listExec.
+		findListOrArchived().
+		doOnList(func(list *trello.List) {
+			l.renderArchivedCards(*list)
+		})
+	if listExec.err != nil {
+		fmt.Fprintf(l.stderr, "%s\n", listExec.err)
+	} else if !listExec.isFinished {
+		fmt.Fprintf(l.stderr, "only the archived lists of a board and the archived cards of a list can be listed\n")
+	}
+}
+
 func (l ls) renderBoards() {
 	boards, err := l.tr.FindBoards()
 	if err != nil {
 		fmt.Fprintf(l.stderr, "could not fetch boards: %v\n", err)
 	} else {
-		fmt.Fprintf(l.stdout, "%s\n", l.r.RenderBoards(boards))
+		printRendered(l.stdout, l.r.RenderBoards(boards))
 	}
 }
 
@@ -62,7 +120,7 @@ func (l ls) renderLists(board trello.Board) {
 	if err != nil {
 		fmt.Fprintf(l.stderr, "could not fetch lists for board '%s': %v\n", board.Name, err)
 	} else {
-		fmt.Fprintf(l.stdout, "%s\n", l.r.RenderLists(lists))
+		printRendered(l.stdout, l.r.RenderLists(lists))
 	}
 }
 
@@ -70,9 +128,43 @@ func (l ls) renderCards(list trello.List) {
 	cards, err := l.tr.FindCards(list.ID)
 	if err != nil {
 		fmt.Fprintf(l.stderr, "could not fetch cards for list '%s': %v\n", list.Name, err)
+		return
+	}
+	if l.mine {
+		member, err := l.tr.FindCurrentMember()
+		if err != nil {
+			fmt.Fprintf(l.stderr, "could not fetch current member: %v\n", err)
+			return
+		}
+		cards = cards.AssignedTo(member.ID)
+	}
+	printRendered(l.stdout, l.r.RenderCards(l.withCustomFields(list.IDBoard, cards)))
+}
+
+func (l ls) renderArchivedLists(board trello.Board) {
+	lists, err := l.tr.FindArchivedLists(board.ID)
+	if err != nil {
+		fmt.Fprintf(l.stderr, "could not fetch archived lists for board '%s': %v\n", board.Name, err)
 	} else {
-		fmt.Fprintf(l.stdout, "%s\n", l.r.RenderCards(cards))
+		printRendered(l.stdout, l.r.RenderLists(lists))
+	}
+}
+
+func (l ls) renderArchivedCards(list trello.List) {
+	cards, err := l.tr.FindArchivedCards(list.ID)
+	if err != nil {
+		fmt.Fprintf(l.stderr, "could not fetch archived cards for list '%s': %v\n", list.Name, err)
+		return
+	}
+	if l.mine {
+		member, err := l.tr.FindCurrentMember()
+		if err != nil {
+			fmt.Fprintf(l.stderr, "could not fetch current member: %v\n", err)
+			return
+		}
+		cards = cards.AssignedTo(member.ID)
 	}
+	printRendered(l.stdout, l.r.RenderCards(l.withCustomFields(list.IDBoard, cards)))
 }
 
 func (l ls) renderComments(card trello.Card) {
@@ -80,7 +172,7 @@ func (l ls) renderComments(card trello.Card) {
 	if err != nil {
 		fmt.Fprintf(l.stderr, "could not fetch comments for card '%s': %v\n", card.Name, err)
 	} else {
-		fmt.Fprintf(l.stdout, "%s\n", l.r.RenderComments(comments))
+		printRendered(l.stdout, l.r.RenderComments(comments))
 	}
 }
 
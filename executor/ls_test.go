@@ -31,8 +31,9 @@ func TestLs_Execute(t *testing.T) {
 	lists1 := trello.Lists{list1, list2}
 	lists2 := trello.Lists{list3}
 	card1 := trello.Card{ID: "card 1", Name: "card"}
-	card2 := trello.Card{ID: "card 2", Name: "another card"}
+	card2 := trello.Card{ID: "card 2", Name: "another card", IDMembers: []string{"member 1"}}
 	cards := trello.Cards{card1, card2}
+	member1 := trello.Member{ID: "member 1", Username: "member1"}
 	comment1 := trello.Comment{ID: "comment"}
 	comment2 := trello.Comment{ID: "another comment"}
 	comments := trello.Comments{comment1, comment2}
@@ -61,6 +62,22 @@ func TestLs_Execute(t *testing.T) {
 			},
 			expected: expected{stdout: "boards content\n"},
 		},
+		"ls without board in ndjson": {
+			given: given{
+				args: []string{},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoards().
+						Return(trello.Boards{}, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewInNDJSONRenderer()
+				},
+			},
+			expected: expected{stdout: ""},
+		},
 		"ls ": {
 			given: given{
 				args: []string{""},
@@ -118,6 +135,9 @@ func TestLs_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCards(list1.ID).
 						Return(cards, nil)
+					tr.EXPECT().
+						FindCustomFields(list1.IDBoard).
+						Return(nil, nil)
 					return tr
 				},
 				buildRenderer: func() renderer.Renderer {
@@ -130,6 +150,63 @@ func TestLs_Execute(t *testing.T) {
 			},
 			expected: expected{stdout: "cards content\n"},
 		},
+		"ls /board/list --mine": {
+			given: given{
+				args: []string{"/board/list", "--mine"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCards(list1.ID).
+						Return(cards, nil)
+					tr.EXPECT().
+						FindCurrentMember().
+						Return(&member1, nil)
+					tr.EXPECT().
+						FindCustomFields(list1.IDBoard).
+						Return(nil, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().
+						RenderCards(trello.Cards{card2}).
+						Return("my cards content")
+					return r
+				},
+			},
+			expected: expected{stdout: "my cards content\n"},
+		},
+		"ls /board/list --mine - error when fetching current member": {
+			given: given{
+				args: []string{"/board/list", "--mine"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCards(list1.ID).
+						Return(cards, nil)
+					tr.EXPECT().
+						FindCurrentMember().
+						Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "could not fetch current member: unexpected error\n"},
+		},
 		"ls /board /another-board": {
 			given: given{
 				args: []string{board1.Name, board2.Name},
@@ -388,13 +465,107 @@ func TestLs_Execute(t *testing.T) {
 				stderr: "could not fetch comments for card 'card': unexpected error\n",
 			},
 		},
+		"ls --archived /board": {
+			given: given{
+				args: []string{"--archived", "/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindArchivedLists(board1.ID).
+						Return(lists2, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().
+						RenderLists(lists2).
+						Return("archived lists content")
+					return r
+				},
+			},
+			expected: expected{
+				stdout: "archived lists content\n",
+			},
+		},
+		"ls --archived /board/archived-list": {
+			given: given{
+				args: []string{"/board/list 3", "--archived"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list3.Name).
+						Return(nil, errors.New("list not found"))
+					tr.EXPECT().
+						FindArchivedLists(board1.ID).
+						Return(lists2, nil)
+					tr.EXPECT().
+						FindArchivedCards(list3.ID).
+						Return(cards, nil)
+					tr.EXPECT().
+						FindCustomFields(list3.IDBoard).
+						Return(nil, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().
+						RenderCards(cards).
+						Return("archived cards content")
+					return r
+				},
+			},
+			expected: expected{
+				stdout: "archived cards content\n",
+			},
+		},
+		"ls --archived /board/list/card": {
+			given: given{
+				args: []string{"--archived", "/board/list/card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board1.Name).
+						Return(&board1, nil)
+					tr.EXPECT().
+						FindList(board1.ID, list1.Name).
+						Return(&list1, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					return nil
+				},
+			},
+			expected: expected{
+				stderr: "only the archived lists of a board and the archived cards of a list can be listed\n",
+			},
+		},
+		"ls --archived without board": {
+			given: given{
+				args: []string{"--archived"},
+				buildTrelloRepository: func() trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return nil
+				},
+			},
+			expected: expected{
+				stderr: "no board selected, give the path of a board or 'cd' into it\n",
+			},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			stdoutBuf := bytes.Buffer{}
 			stderrBuf := bytes.Buffer{}
 			l := ls{
-				executor{
+				executor: executor{
 					tr:      tt.given.buildTrelloRepository(),
 					r:       tt.given.buildRenderer(),
 					session: &trello.Session{},
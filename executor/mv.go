@@ -23,8 +23,19 @@ func (m mv) Execute(args []string) {
 		return
 	}
 
+	sources := m.expandPath(args[0])
+	if len(sources) > 1 && !isListPath(m.session, args[1]) {
+		fmt.Fprintf(m.stderr, "cannot move several cards to '%s', give a list as destination\n", args[1])
+		return
+	}
+	for _, source := range sources {
+		m.execute(source, args[1])
+	}
+}
+
+func (m mv) execute(source, dest string) {
 	execSource := start(m.tr).
-		resolvePath(m.session, args[0]).
+		resolvePath(m.session, source).
 		then().
 		findBoard().
 		then().
@@ -42,7 +53,7 @@ func (m mv) Execute(args []string) {
 	sourceCard := execSource.session.Card
 
 	execDest := start(m.tr).
-		resolvePath(m.session, args[1]).
+		resolvePath(m.session, dest).
 		then().
 		findBoard().
 		then().
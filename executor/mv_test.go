@@ -16,6 +16,7 @@ func TestMv_Execute(t *testing.T) {
 	list1 := trello.List{ID: "list 1", Name: "list"}
 	list2 := trello.List{ID: "list 2", Name: "another-list"}
 	card := trello.Card{ID: "card 1", Name: "card", IDList: list1.ID}
+	card2 := trello.Card{ID: "card 2", Name: "another-card", IDList: list1.ID}
 	type given struct {
 		args                  []string
 		buildTrelloRepository func() trello.Repository
@@ -83,6 +84,68 @@ func TestMv_Execute(t *testing.T) {
 			expected: expected{},
 		},
 		// ERRORS
+		"/> mv /board/list/*card* /board/another-list": {
+			given: given{
+				args: []string{"/board/list/*card*", "/board/another-list"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil).
+						AnyTimes()
+					tr.EXPECT().
+						FindList(board.ID, list1.Name).
+						Return(&list1, nil).
+						Times(3)
+					tr.EXPECT().
+						FindList(board.ID, list2.Name).
+						Return(&list2, nil).
+						Times(2)
+					tr.EXPECT().
+						FindCards(list1.ID).
+						Return(trello.Cards{card, card2, {ID: "card 3", Name: "bug"}}, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card.TCliID()).
+						Return(&card, nil)
+					tr.EXPECT().
+						FindCard(list1.ID, card2.TCliID()).
+						Return(&card2, nil)
+					updatedCard := trello.NewUpdateCard(card)
+					updatedCard.IDList = list2.ID
+					tr.EXPECT().
+						UpdateCard(updatedCard).
+						Return(nil, nil)
+					updatedCard2 := trello.NewUpdateCard(card2)
+					updatedCard2.IDList = list2.ID
+					tr.EXPECT().
+						UpdateCard(updatedCard2).
+						Return(nil, nil)
+					return tr
+				},
+			},
+			expected: expected{},
+		},
+		"/> mv /board/list/*card* /board/list/new-card-name": {
+			given: given{
+				args: []string{"/board/list/*card*", "/board/list/new-card-name"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list1.Name).
+						Return(&list1, nil)
+					tr.EXPECT().
+						FindCards(list1.ID).
+						Return(trello.Cards{card, card2}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				stderr: "cannot move several cards to '/board/list/new-card-name', give a list as destination\n",
+			},
+		},
 		"mv": {
 			given: given{
 				args: []string{},
@@ -0,0 +1,27 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+)
+
+type redo struct {
+	executor
+	history trello.History
+}
+
+// Execute performs again the last undone mutation of the journal
+func (r redo) Execute(_ []string) {
+	if r.history == nil {
+		fmt.Fprint(r.stderr, journalDisabledError)
+		return
+	}
+	entry, err := r.history.Redo()
+	if err != nil {
+		fmt.Fprintf(r.stderr, "%v\n", err)
+		return
+	}
+	if !r.isDryRun() {
+		fmt.Fprintf(r.stdout, "redone: %s\n", entry)
+	}
+}
@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestRedo_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		buildHistory func() trello.History
+		expected     expected
+	}{
+		"redo last undone mutation": {
+			buildHistory: func() trello.History {
+				h := trello.NewMockHistory(ctrl)
+				h.EXPECT().
+					Redo().
+					Return(&trello.JournalEntry{Operation: "update card", After: &trello.JournalSnapshot{Card: &trello.Card{Name: "card"}}}, nil)
+				return h
+			},
+			expected: expected{
+				stdout: "redone: update card 'card'\n",
+			},
+		},
+		"nothing to redo": {
+			buildHistory: func() trello.History {
+				h := trello.NewMockHistory(ctrl)
+				h.EXPECT().
+					Redo().
+					Return(nil, errors.New("nothing to redo"))
+				return h
+			},
+			expected: expected{
+				stderr: "nothing to redo\n",
+			},
+		},
+		"journal disabled": {
+			buildHistory: func() trello.History { return nil },
+			expected: expected{
+				stderr: journalDisabledError,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			r := redo{
+				executor: executor{
+					stdout: &stdoutBuf,
+					stderr: &stderrBuf,
+				},
+				history: tt.buildHistory(),
+			}
+			r.Execute(nil)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/renderer"
+	"github.com/l-lin/tcli/trello"
+)
+
+const (
+	// htmlFlag writes the report in HTML instead of Markdown
+	htmlFlag = "--html"
+	// previewFlag renders the report for the terminal instead of writing the raw Markdown
+	previewFlag = "--preview"
+	// commentsFlag adds the excerpts of the most recent comments of the cards
+	commentsFlag = "--comments"
+)
+
+// maximum number of comments reported per card
+const reportedComments = 3
+
+type report struct {
+	executor
+	preview     renderer.Description
+	html        bool
+	showPreview bool
+	comments    bool
+}
+
+// Execute writes the report of the given board or list, or of the current one, in Markdown or in HTML
+func (r report) Execute(args []string) {
+	var paths []string
+	for _, arg := range args {
+		switch arg {
+		case htmlFlag:
+			r.html = true
+		case previewFlag:
+			r.showPreview = true
+		case commentsFlag:
+			r.comments = true
+		default:
+			paths = append(paths, arg)
+		}
+	}
+	if r.html && r.showPreview {
+		fmt.Fprintf(r.stderr, "%s and %s cannot be used together\n", htmlFlag, previewFlag)
+		return
+	}
+	if len(paths) > 1 {
+		fmt.Fprintf(r.stderr, "only one board or list is accepted\n")
+		return
+	}
+	arg := ""
+	if len(paths) == 1 {
+		arg = paths[0]
+	}
+
+	exec := start(r.tr).
+		resolvePath(r.session, arg).
+		then()
+	if exec.err == nil && exec.p.BoardName == "" {
+		fmt.Fprintf(r.stderr, "%s\n", noBoardError)
+		return
+	}
+	var rep *renderer.Report
+	var err error
+	listExec := exec.
+		findBoard().
+		doOnBoard(func(board *trello.Board) {
+			var lists trello.Lists
+			if lists, err = r.tr.FindLists(board.ID); err != nil {
+				err = fmt.Errorf("could not fetch lists of board '%s': %v", board.Name, err)
+				return
+			}
+			rep, err = r.buildReport(*board, lists)
+		}).
+		then()
+	listExec.
+		findList().
+		doOnList(func(list *trello.List) {
+			rep, err = r.buildReport(*listExec.session.Board, trello.Lists{*list})
+		})
+	if listExec.err != nil {
+		fmt.Fprintf(r.stderr, "%s\n", listExec.err)
+		return
+	}
+	if !listExec.isFinished {
+		fmt.Fprintf(r.stderr, "only boards and lists can be reported\n")
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(r.stderr, "%v\n", err)
+		return
+	}
+	r.write(*rep)
+}
+
+func (r report) buildReport(board trello.Board, lists trello.Lists) (*renderer.Report, error) {
+	rep := &renderer.Report{Board: board}
+	for _, list := range lists {
+		cards, err := r.tr.FindCards(list.ID)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch cards of list '%s': %v", list.Name, err)
+		}
+		reportList := renderer.ReportList{List: list}
+		for _, card := range cards {
+			reportCard := renderer.ReportCard{Card: card}
+			if r.comments {
+				comments, err := r.tr.FindComments(card.ID)
+				if err != nil {
+					return nil, fmt.Errorf("could not fetch comments of card '%s': %v", card.Name, err)
+				}
+				// Trello returns the most recent comments first
+				if len(comments) > reportedComments {
+					comments = comments[:reportedComments]
+				}
+				reportCard.Comments = comments
+			}
+			reportList.Cards = append(reportList.Cards, reportCard)
+		}
+		rep.Lists = append(rep.Lists, reportList)
+	}
+	return rep, nil
+}
+
+func (r report) write(rep renderer.Report) {
+	out := rep.Markdown()
+	var err error
+	if r.html {
+		out, err = rep.HTML()
+	} else if r.showPreview {
+		out, err = r.preview.Render(out)
+	}
+	if err != nil {
+		fmt.Fprintf(r.stderr, "could not render report of board '%s': %v\n", rep.Board.Name, err)
+		return
+	}
+	fmt.Fprint(r.stdout, out)
+}
@@ -0,0 +1,141 @@
+package executor
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+// prefixDescription renders the descriptions in a recognizable way
+type prefixDescription struct{}
+
+func (u prefixDescription) Render(description string) (string, error) {
+	return "preview of " + description, nil
+}
+
+func TestReport_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := trello.Board{ID: "board 1", Name: "board"}
+	list := trello.List{ID: "list 1", Name: "doing"}
+	card := trello.Card{ID: "card 1", Name: "card", ShortURL: "https://trello.com/c/abc"}
+
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		args                  []string
+		buildTrelloRepository func() trello.Repository
+		expected              expected
+	}{
+		"report board": {
+			args: []string{"/board"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindLists(board.ID).
+					Return(trello.Lists{list}, nil)
+				tr.EXPECT().
+					FindCards(list.ID).
+					Return(trello.Cards{card}, nil)
+				return tr
+			},
+			expected: expected{
+				stdout: "# board\n\n## doing\n\n- [card](https://trello.com/c/abc)\n",
+			},
+		},
+		"report list with comments and preview": {
+			args: []string{"/board/doing", "--comments", "--preview"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindList(board.ID, list.Name).
+					Return(&list, nil)
+				tr.EXPECT().
+					FindCards(list.ID).
+					Return(trello.Cards{card}, nil)
+				tr.EXPECT().
+					FindComments(card.ID).
+					Return(trello.Comments{
+						{Data: trello.CommentData{Text: "4"}, MemberCreator: trello.CommentMemberCreator{FullName: "Member"}},
+						{Data: trello.CommentData{Text: "3"}, MemberCreator: trello.CommentMemberCreator{FullName: "Member"}},
+						{Data: trello.CommentData{Text: "2"}, MemberCreator: trello.CommentMemberCreator{FullName: "Member"}},
+						{Data: trello.CommentData{Text: "1"}, MemberCreator: trello.CommentMemberCreator{FullName: "Member"}},
+					}, nil)
+				return tr
+			},
+			expected: expected{
+				stdout: "preview of # board\n\n## doing\n\n- [card](https://trello.com/c/abc)\n" +
+					"  > **Member**: 4\n  > **Member**: 3\n  > **Member**: 2\n",
+			},
+		},
+		"report card": {
+			args: []string{"/board/doing/card"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindList(board.ID, list.Name).
+					Return(&list, nil)
+				return tr
+			},
+			expected: expected{
+				stderr: "only boards and lists can be reported\n",
+			},
+		},
+		"html and preview": {
+			args: []string{"/board", "--html", "--preview"},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "--html and --preview cannot be used together\n",
+			},
+		},
+		"no board selected": {
+			args: []string{},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "no board selected, give the path of a board or 'cd' into it\n",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			r := report{
+				executor: executor{
+					tr:      tt.buildTrelloRepository(),
+					session: &trello.Session{},
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+				preview: prefixDescription{},
+			}
+			r.Execute(tt.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
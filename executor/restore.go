@@ -0,0 +1,71 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+)
+
+type restore struct {
+	executor
+}
+
+// Execute reopens the given archived lists and cards
+func (r restore) Execute(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintf(r.stderr, "missing list or card operand\n")
+		return
+	}
+	// the paths are not expanded, as the globs only match the lists and cards that are not archived
+	for _, arg := range args {
+		r.execute(arg)
+	}
+}
+
+func (r restore) execute(arg string) {
+	exec := start(r.tr).
+		resolvePath(r.session, arg).
+		then()
+	if exec.err == nil && exec.p.ListName == "" {
+		fmt.Fprintf(r.stderr, "only lists and cards can be restored\n")
+		return
+	}
+	listExec := exec.
+		findBoard().
+		then()
+	cardExec := listExec.
+		findListOrArchived().
+		doOnList(func(list *trello.List) {
+			r.restoreList(*list)
+		}).
+		then()
+	cardExec.
+		findArchivedCard().
+		doOnCard(func(card *trello.Card) {
+			r.restoreCard(*card)
+		})
+	if cardExec.err != nil {
+		fmt.Fprintf(r.stderr, "%s\n", cardExec.err)
+	} else if !cardExec.isFinished {
+		fmt.Fprintf(r.stderr, "only lists and cards can be restored\n")
+	}
+}
+
+func (r restore) restoreList(list trello.List) {
+	if !list.Closed {
+		fmt.Fprintf(r.stderr, "list '%s' is not archived\n", list.Name)
+		return
+	}
+	updateList := trello.NewUpdateList(list)
+	updateList.Closed = false
+	if _, err := r.tr.UpdateList(updateList); err != nil {
+		fmt.Fprintf(r.stderr, "could not restore list '%s': %v\n", list.Name, err)
+	}
+}
+
+func (r restore) restoreCard(card trello.Card) {
+	updateCard := trello.NewUpdateCard(card)
+	updateCard.Closed = false
+	if _, err := r.tr.UpdateCard(updateCard); err != nil {
+		fmt.Fprintf(r.stderr, "could not restore card '%s': %v\n", card.Name, err)
+	}
+}
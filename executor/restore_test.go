@@ -0,0 +1,169 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestRestore_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := trello.Board{ID: "board 1", Name: "board"}
+	list := trello.List{ID: "list 1", Name: "list", IDBoard: board.ID}
+	archivedList := trello.List{ID: "list 2", Name: "archived list", IDBoard: board.ID, Closed: true}
+	archivedCard := trello.Card{ID: "card 1", Name: "card", IDBoard: board.ID, IDList: list.ID, Closed: true}
+
+	type expected struct {
+		stderr string
+	}
+	var tests = map[string]struct {
+		args                  []string
+		buildTrelloRepository func() trello.Repository
+		expected              expected
+	}{
+		"restore list": {
+			args: []string{"/board/archived list"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindList(board.ID, archivedList.Name).
+					Return(nil, errors.New("list not found"))
+				tr.EXPECT().
+					FindArchivedLists(board.ID).
+					Return(trello.Lists{archivedList}, nil)
+				updateList := trello.NewUpdateList(archivedList)
+				updateList.Closed = false
+				tr.EXPECT().
+					UpdateList(updateList).
+					Return(&trello.List{ID: archivedList.ID}, nil)
+				return tr
+			},
+		},
+		"restore card": {
+			args: []string{"/board/list/card"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindList(board.ID, list.Name).
+					Return(&list, nil)
+				tr.EXPECT().
+					FindArchivedCards(list.ID).
+					Return(trello.Cards{archivedCard}, nil)
+				updateCard := trello.NewUpdateCard(archivedCard)
+				updateCard.Closed = false
+				tr.EXPECT().
+					UpdateCard(updateCard).
+					Return(&trello.Card{ID: archivedCard.ID}, nil)
+				return tr
+			},
+		},
+		"list not archived": {
+			args: []string{"/board/list"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindList(board.ID, list.Name).
+					Return(&list, nil)
+				return tr
+			},
+			expected: expected{
+				stderr: "list 'list' is not archived\n",
+			},
+		},
+		"card not archived": {
+			args: []string{"/board/list/another card"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindList(board.ID, list.Name).
+					Return(&list, nil)
+				tr.EXPECT().
+					FindArchivedCards(list.ID).
+					Return(trello.Cards{archivedCard}, nil)
+				return tr
+			},
+			expected: expected{
+				stderr: "no card found with name 'another card'\n",
+			},
+		},
+		"error when restoring": {
+			args: []string{"/board/list/card"},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board.Name).
+					Return(&board, nil)
+				tr.EXPECT().
+					FindList(board.ID, list.Name).
+					Return(&list, nil)
+				tr.EXPECT().
+					FindArchivedCards(list.ID).
+					Return(trello.Cards{archivedCard}, nil)
+				tr.EXPECT().
+					UpdateCard(gomock.Any()).
+					Return(nil, errors.New("unexpected error"))
+				return tr
+			},
+			expected: expected{
+				stderr: "could not restore card 'card': unexpected error\n",
+			},
+		},
+		"board": {
+			args: []string{"/board"},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "only lists and cards can be restored\n",
+			},
+		},
+		"no operand": {
+			args: []string{},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "missing list or card operand\n",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			r := restore{
+				executor: executor{
+					tr:      tt.buildTrelloRepository(),
+					session: &trello.Session{},
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+			}
+			r.Execute(tt.args)
+
+			if stdoutBuf.String() != "" {
+				t.Errorf("expected no stdout, actual stdout %v", stdoutBuf.String())
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
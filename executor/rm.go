@@ -3,7 +3,6 @@ package executor
 import (
 	"fmt"
 	"github.com/l-lin/tcli/trello"
-	"github.com/manifoldco/promptui"
 	"io"
 )
 
@@ -11,6 +10,8 @@ type rm struct {
 	executor
 	stdin       io.ReadCloser
 	neverPrompt bool
+	// nonInteractive refuses the confirmations instead of prompting them, e.g. in a script
+	nonInteractive bool
 }
 
 func (r rm) Execute(args []string) {
@@ -19,11 +20,25 @@ func (r rm) Execute(args []string) {
 		return
 	}
 	for _, arg := range args {
-		r.execute(arg)
+		if r.isAllCardsInList(arg) {
+			// archive all the cards in a single call instead of archiving them one by one
+			r.execute(arg)
+			continue
+		}
+		for _, p := range r.expandPath(arg) {
+			r.execute(p)
+		}
 	}
 	return
 }
 
+// isAllCardsInList returns true if the path targets all the cards of a single list, e.g. /board/list/*
+func (r rm) isAllCardsInList(arg string) bool {
+	pathResolver := trello.NewPathResolver(r.session)
+	p, err := pathResolver.Resolve(arg)
+	return err == nil && p.CardName == "*" && p.CommentID == "" && !trello.HasGlob(p.BoardName) && !trello.HasGlob(p.ListName)
+}
+
 func (r rm) execute(arg string) {
 	if arg == "" {
 		fmt.Fprintf(r.stderr, "missing card operand\n")
@@ -37,22 +52,30 @@ func (r rm) execute(arg string) {
 		}).
 		findBoard().
 		doOnBoard(func(board *trello.Board) {
-			fmt.Fprintf(r.stderr, "board archiving not implemented yet\n")
+			if !r.neverPrompt && !confirm(r.stdin, r.stderr, r.nonInteractive, fmt.Sprintf("Close board '%s'", board.Name)) {
+				return
+			}
+
+			if err := r.tr.CloseBoard(board.ID); err != nil {
+				fmt.Fprintf(r.stderr, "could not close board '%s': %s\n", board.Name, err)
+			}
 		}).
 		then().
 		findList().
 		doOnList(func(list *trello.List) {
-			fmt.Fprintf(r.stderr, "list archiving not implemented yet\n")
+			if !r.neverPrompt && !confirm(r.stdin, r.stderr, r.nonInteractive, fmt.Sprintf("Archive list '%s'", list.Name)) {
+				return
+			}
+
+			if err := r.tr.ArchiveList(list.IDBoard, list.ID); err != nil {
+				fmt.Fprintf(r.stderr, "could not archive list '%s': %s\n", list.Name, err)
+			}
 		}).
 		then().
 		findAllCardsInList().
 		doOnAllCardsInList(func(list *trello.List, cards trello.Cards) {
-			prompt := promptui.Prompt{
-				Label:     fmt.Sprintf("Archive all cards in the list '%s'", list.Name),
-				IsConfirm: true,
-				Stdin:     r.stdin,
-			}
-			if _, err := prompt.Run(); err != nil {
+			// archiving all the cards is confirmed even with never_prompt, except in a script where it cannot be prompted
+			if !(r.neverPrompt && r.nonInteractive) && !confirm(r.stdin, r.stderr, r.nonInteractive, fmt.Sprintf("Archive all cards in the list '%s'", list.Name)) {
 				return
 			}
 
@@ -62,15 +85,8 @@ func (r rm) execute(arg string) {
 		}).
 		orFindCard().
 		doOnCard(func(card *trello.Card) {
-			if !r.neverPrompt {
-				prompt := promptui.Prompt{
-					Label:     fmt.Sprintf("Archive card '%s'", card.Name),
-					IsConfirm: true,
-					Stdin:     r.stdin,
-				}
-				if _, err := prompt.Run(); err != nil {
-					return
-				}
+			if !r.neverPrompt && !confirm(r.stdin, r.stderr, r.nonInteractive, fmt.Sprintf("Archive card '%s'", card.Name)) {
+				return
 			}
 
 			updatedCard := trello.NewUpdateCard(*card)
@@ -80,17 +96,20 @@ func (r rm) execute(arg string) {
 			}
 		}).
 		then().
+		findAttachment().
+		doOnAttachment(func(card *trello.Card, attachment *trello.Attachment) {
+			if !r.neverPrompt && !confirm(r.stdin, r.stderr, r.nonInteractive, fmt.Sprintf("Delete attachment '%s'", attachment.Name)) {
+				return
+			}
+
+			if err := r.tr.DeleteAttachment(card.ID, attachment.ID); err != nil {
+				fmt.Fprintf(r.stderr, "could not delete attachment '%s': %s\n", attachment.Name, err)
+			}
+		}).
 		findComment().
 		doOnComment(func(comment *trello.Comment) {
-			if !r.neverPrompt {
-				prompt := promptui.Prompt{
-					Label:     fmt.Sprintf("Delete comment '%s'", comment.ID),
-					IsConfirm: true,
-					Stdin:     r.stdin,
-				}
-				if _, err := prompt.Run(); err != nil {
-					return
-				}
+			if !r.neverPrompt && !confirm(r.stdin, r.stderr, r.nonInteractive, fmt.Sprintf("Delete comment '%s'", comment.ID)) {
+				return
 			}
 
 			if err := r.tr.DeleteComment(comment.Data.Card.ID, comment.ID); err != nil {
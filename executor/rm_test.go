@@ -15,7 +15,7 @@ func TestRm_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	board := trello.Board{ID: "board 1", Name: "board"}
-	list := trello.List{ID: "list 1", Name: "list"}
+	list := trello.List{ID: "list 1", Name: "list", IDBoard: board.ID}
 	card := trello.Card{ID: "card 1", Name: "card"}
 	updatedCard := trello.NewUpdateCard(card)
 	updatedCard.Closed = true
@@ -23,11 +23,13 @@ func TestRm_Execute(t *testing.T) {
 	updatedCard2 := trello.NewUpdateCard(card2)
 	updatedCard2.Closed = true
 	comment := trello.Comment{ID: "comment", Data: trello.CommentData{Card: trello.CommentDataCard{ID: card.ID}}}
+	attachment := trello.Attachment{ID: "attachment 1", Name: "file.pdf", IsUpload: true}
 
 	type given struct {
 		args                  []string
 		buildTrelloRepository func() trello.Repository
 		stdin                 io.ReadCloser
+		neverPrompt           bool
 	}
 	type expected struct {
 		stdout string
@@ -112,6 +114,9 @@ func TestRm_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCard(list.ID, card.Name).
 						Return(&card, nil)
+					tr.EXPECT().
+						FindAttachments(card.ID).
+						Return(trello.Attachments{attachment}, nil)
 					tr.EXPECT().
 						FindComment(card.ID, comment.ID).
 						Return(&comment, nil)
@@ -138,6 +143,9 @@ func TestRm_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCard(list.ID, card.Name).
 						Return(&card, nil)
+					tr.EXPECT().
+						FindAttachments(card.ID).
+						Return(trello.Attachments{attachment}, nil)
 					tr.EXPECT().
 						FindComment(card.ID, comment.ID).
 						Return(&comment, nil)
@@ -169,6 +177,39 @@ func TestRm_Execute(t *testing.T) {
 				stdin: acceptStdin(),
 			},
 		},
+		"rm /board/list/*card (user accepts to archive)": {
+			given: given{
+				args: []string{"/board/list/*card"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil).
+						Times(3)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil).
+						Times(3)
+					tr.EXPECT().
+						FindCards(list.ID).
+						Return(trello.Cards{card, card2, {ID: "card 3", Name: "bug"}}, nil)
+					tr.EXPECT().
+						FindCard(list.ID, "card[]").
+						Return(&card, nil)
+					tr.EXPECT().
+						FindCard(list.ID, "another card[]").
+						Return(&card2, nil)
+					tr.EXPECT().
+						UpdateCard(updatedCard).
+						Return(nil, nil)
+					tr.EXPECT().
+						UpdateCard(updatedCard2).
+						Return(nil, nil)
+					return tr
+				},
+				neverPrompt: true,
+			},
+		},
 		// ERRORS
 		"rm /../..": {
 			given: given{
@@ -215,11 +256,49 @@ func TestRm_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindBoard(board.Name).
 						Return(&board, nil)
+					tr.EXPECT().
+						CloseBoard(board.ID).
+						Return(nil)
 					return tr
 				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{},
+		},
+		"rm /board (user refused)": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						CloseBoard(board.ID).
+						Times(0)
+					return tr
+				},
+				stdin: refuseStdin(),
+			},
+			expected: expected{},
+		},
+		"rm /board (error when closing board)": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						CloseBoard(board.ID).
+						Return(errors.New("unexpected error"))
+					return tr
+				},
+				stdin: acceptStdin(),
 			},
 			expected: expected{
-				stderr: "board archiving not implemented yet\n",
+				stderr: fmt.Sprintf("could not close board '%s': unexpected error\n", board.Name),
 			},
 		},
 		"rm /board/unknown-list": {
@@ -251,11 +330,35 @@ func TestRm_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindList(board.ID, list.Name).
 						Return(&list, nil)
+					tr.EXPECT().
+						ArchiveList(board.ID, list.ID).
+						Return(nil)
+					return tr
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{},
+		},
+		"rm /board/list (error when archiving list)": {
+			given: given{
+				args: []string{"/board/list"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil)
+					tr.EXPECT().
+						ArchiveList(board.ID, list.ID).
+						Return(errors.New("unexpected error"))
 					return tr
 				},
+				stdin: acceptStdin(),
 			},
 			expected: expected{
-				stderr: "list archiving not implemented yet\n",
+				stderr: fmt.Sprintf("could not archive list '%s': unexpected error\n", list.Name),
 			},
 		},
 		"rm /board/list/unknown-card": {
@@ -304,6 +407,57 @@ func TestRm_Execute(t *testing.T) {
 				stderr: fmt.Sprintf("could not archive card '%s': unexpected error\n", updatedCard.Name),
 			},
 		},
+		"rm /board/list/card/file.pdf (user accepts to delete)": {
+			given: given{
+				args: []string{"/board/list/card/file.pdf"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil)
+					tr.EXPECT().
+						FindCard(list.ID, card.Name).
+						Return(&card, nil)
+					tr.EXPECT().
+						FindAttachments(card.ID).
+						Return(trello.Attachments{attachment}, nil)
+					tr.EXPECT().
+						DeleteAttachment(card.ID, attachment.ID).
+						Return(nil)
+					return tr
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{},
+		},
+		"rm /board/list/card/file.pdf (error when fetching attachments)": {
+			given: given{
+				args: []string{"/board/list/card/file.pdf"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil)
+					tr.EXPECT().
+						FindCard(list.ID, card.Name).
+						Return(&card, nil)
+					tr.EXPECT().
+						FindAttachments(card.ID).
+						Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+				stdin: acceptStdin(),
+			},
+			expected: expected{
+				stderr: "could not fetch attachments of card 'card': unexpected error\n",
+			},
+		},
 		"rm /board/list/card/comment (error when deleting comment)": {
 			given: given{
 				args: []string{"/board/list/card/comment"},
@@ -318,6 +472,9 @@ func TestRm_Execute(t *testing.T) {
 					tr.EXPECT().
 						FindCard(list.ID, card.Name).
 						Return(&card, nil)
+					tr.EXPECT().
+						FindAttachments(card.ID).
+						Return(trello.Attachments{attachment}, nil)
 					tr.EXPECT().
 						FindComment(card.ID, comment.ID).
 						Return(&comment, nil)
@@ -345,7 +502,8 @@ func TestRm_Execute(t *testing.T) {
 					stdout:  &stdoutBuf,
 					stderr:  &stderrBuf,
 				},
-				stdin: tt.given.stdin,
+				stdin:       tt.given.stdin,
+				neverPrompt: tt.given.neverPrompt,
 			}
 			r.Execute(tt.given.args)
 
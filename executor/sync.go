@@ -0,0 +1,57 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+)
+
+const (
+	forceFlag   = "--force"
+	discardFlag = "--discard"
+)
+
+type synchronize struct {
+	executor
+	offline bool
+	outbox  *trello.Outbox
+}
+
+// Execute sends to Trello the mutations performed offline, reporting the ones in conflict
+func (s synchronize) Execute(args []string) {
+	if s.offline {
+		fmt.Fprintf(s.stderr, "sync is not available offline, run it without --offline\n")
+		return
+	}
+	opts := trello.SyncOptions{DryRun: s.isDryRun()}
+	for _, arg := range args {
+		switch arg {
+		case forceFlag:
+			opts.Force = true
+		case discardFlag:
+			opts.Discard = true
+		default:
+			fmt.Fprintf(s.stderr, "unknown flag '%s'\n", arg)
+			return
+		}
+	}
+	results, err := s.outbox.Sync(s.tr, opts)
+	if err != nil {
+		fmt.Fprintf(s.stderr, "could not synchronize: %v\n", err)
+	}
+	if err == nil && len(results) == 0 {
+		fmt.Fprintf(s.stdout, "nothing to sync\n")
+		return
+	}
+	for _, result := range results {
+		var conflictErr *trello.ConflictError
+		switch {
+		case result.Err == nil:
+			fmt.Fprintf(s.stdout, "synced: %s\n", result.Entry)
+		case errors.As(result.Err, &conflictErr):
+			fmt.Fprintf(s.stderr, "conflict: %s: %v\n", result.Entry, conflictErr)
+		default:
+			fmt.Fprintf(s.stderr, "failed: %s: %v\n", result.Entry, result.Err)
+		}
+	}
+}
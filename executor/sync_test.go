@@ -0,0 +1,117 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/conf"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestSynchronize_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createComment := trello.CreateComment{IDCard: "card 1", Text: "comment"}
+
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		args                  []string
+		offline               bool
+		queued                []trello.CreateComment
+		buildTrelloRepository func() trello.Repository
+		expected              expected
+	}{
+		"synced": {
+			queued: []trello.CreateComment{createComment},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().Refresh()
+				tr.EXPECT().
+					CreateComment(createComment).
+					Return(&trello.Comment{ID: "comment 1"}, nil)
+				return tr
+			},
+			expected: expected{
+				stdout: "synced: create comment on card 'card 1'\n",
+			},
+		},
+		"failed": {
+			queued: []trello.CreateComment{createComment},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().Refresh()
+				tr.EXPECT().
+					CreateComment(createComment).
+					Return(nil, errors.New("unexpected error"))
+				return tr
+			},
+			expected: expected{
+				stderr: "failed: create comment on card 'card 1': unexpected error\n",
+			},
+		},
+		"nothing to sync": {
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stdout: "nothing to sync\n",
+			},
+		},
+		"offline": {
+			offline: true,
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "sync is not available offline, run it without --offline\n",
+			},
+		},
+		"unknown flag": {
+			args: []string{"--unknown"},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: expected{
+				stderr: "unknown flag '--unknown'\n",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+			offline := trello.NewOffline(c)
+			for _, queued := range tt.queued {
+				if _, err := offline.CreateComment(queued); err != nil {
+					t.Fatalf("could not queue the comment: %v", err)
+				}
+			}
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			s := synchronize{
+				executor: executor{
+					tr:      tt.buildTrelloRepository(),
+					session: &trello.Session{},
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+				offline: tt.offline,
+				outbox:  trello.NewOutbox(c),
+			}
+			s.Execute(tt.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
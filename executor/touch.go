@@ -33,12 +33,30 @@ func (t touch) execute(arg string) {
 		}).
 		findBoard().
 		doOnBoard(func(board *trello.Board) {
-			fmt.Fprintf(t.stderr, "board creation not implemented yet\n")
+			fmt.Fprintf(t.stderr, "board '%s' already exists\n", board.Name)
+		}).
+		doOnBoardName(func(boardName string) {
+			createBoard := trello.CreateBoard{
+				Name: boardName,
+			}
+			if _, err := t.tr.CreateBoard(createBoard); err != nil {
+				fmt.Fprintf(t.stderr, "could not create board '%s': %v\n", boardName, err)
+			}
 		}).
 		then().
 		findList().
 		doOnList(func(list *trello.List) {
-			fmt.Fprintf(t.stderr, "list creation not implemented yet\n")
+			fmt.Fprintf(t.stderr, "list '%s' already exists\n", list.Name)
+		}).
+		doOnListName(func(listName string, session *trello.Session) {
+			createList := trello.CreateList{
+				Name:    listName,
+				IDBoard: session.Board.ID,
+				Pos:     "bottom",
+			}
+			if _, err := t.tr.CreateList(createList); err != nil {
+				fmt.Fprintf(t.stderr, "could not create list '%s': %v\n", listName, err)
+			}
 		}).
 		then().
 		doOnCardName(func(cardName string, session *trello.Session) {
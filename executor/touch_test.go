@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/golang/mock/gomock"
+	wrappedhttp "github.com/l-lin/tcli/http"
 	"github.com/l-lin/tcli/trello"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func TestTouch_Execute(t *testing.T) {
@@ -67,6 +70,142 @@ func TestTouch_Execute(t *testing.T) {
 				stderr: "missing card operand\n",
 			},
 		},
+		"/> touch /new-board": {
+			given: given{
+				args: []string{"/new-board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard("new-board").
+						Return(nil, errors.New("not found"))
+					tr.EXPECT().
+						CreateBoard(trello.CreateBoard{Name: "new-board"}).
+						Return(&trello.Board{ID: "board 2", Name: "new-board"}, nil)
+					return tr
+				},
+				session: &trello.Session{},
+			},
+			expected: expected{},
+		},
+		"/> touch /new-board (error when creating board)": {
+			given: given{
+				args: []string{"/new-board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard("new-board").
+						Return(nil, errors.New("not found"))
+					tr.EXPECT().
+						CreateBoard(trello.CreateBoard{Name: "new-board"}).
+						Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+				session: &trello.Session{},
+			},
+			expected: expected{
+				stderr: "could not create board 'new-board': unexpected error\n",
+			},
+		},
+		"/> touch /new-board (board not found by Trello)": {
+			given: given{
+				args: []string{"/new-board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard("new-board").
+						Return(nil, &wrappedhttp.StatusError{StatusCode: http.StatusNotFound})
+					tr.EXPECT().
+						CreateBoard(trello.CreateBoard{Name: "new-board"}).
+						Return(&trello.Board{ID: "board 2", Name: "new-board"}, nil)
+					return tr
+				},
+				session: &trello.Session{},
+			},
+			expected: expected{},
+		},
+		"/> touch /new-board (unauthorized by Trello)": {
+			given: given{
+				args: []string{"/new-board"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard("new-board").
+						Return(nil, &wrappedhttp.StatusError{StatusCode: http.StatusUnauthorized})
+					tr.EXPECT().
+						CreateBoard(gomock.Any()).
+						Times(0)
+					return tr
+				},
+				session: &trello.Session{},
+			},
+			expected: expected{
+				stderr: "unauthorized by Trello, check the API key and the access token in the configuration\n",
+			},
+		},
+		"/> touch /board/new-list (rate limited by Trello)": {
+			given: given{
+				args: []string{"/board/new-list"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, "new-list").
+						Return(nil, &wrappedhttp.StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: 10 * time.Second})
+					tr.EXPECT().
+						CreateList(gomock.Any()).
+						Times(0)
+					return tr
+				},
+				session: &trello.Session{},
+			},
+			expected: expected{
+				stderr: "Trello rate limit reached, retry in 10s\n",
+			},
+		},
+		"/> touch /board/new-list": {
+			given: given{
+				args: []string{"/board/new-list"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, "new-list").
+						Return(nil, errors.New("not found"))
+					tr.EXPECT().
+						CreateList(trello.CreateList{Name: "new-list", IDBoard: board.ID, Pos: "bottom"}).
+						Return(&trello.List{ID: "list 2", Name: "new-list"}, nil)
+					return tr
+				},
+				session: &trello.Session{},
+			},
+			expected: expected{},
+		},
+		"/> touch /board/new-list (error when creating list)": {
+			given: given{
+				args: []string{"/board/new-list"},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, "new-list").
+						Return(nil, errors.New("not found"))
+					tr.EXPECT().
+						CreateList(trello.CreateList{Name: "new-list", IDBoard: board.ID, Pos: "bottom"}).
+						Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+				session: &trello.Session{},
+			},
+			expected: expected{
+				stderr: "could not create list 'new-list': unexpected error\n",
+			},
+		},
 		"/> touch /board/list/card": {
 			given: given{
 				args: []string{"/board/list/card"},
@@ -184,7 +323,7 @@ func TestTouch_Execute(t *testing.T) {
 				session: &trello.Session{},
 			},
 			expected: expected{
-				stderr: "board creation not implemented yet\n",
+				stderr: "board 'board' already exists\n",
 			},
 		},
 		"/> touch /board/list/card (board not found)": {
@@ -219,7 +358,7 @@ func TestTouch_Execute(t *testing.T) {
 				session: &trello.Session{},
 			},
 			expected: expected{
-				stderr: "list creation not implemented yet\n",
+				stderr: "list 'list' already exists\n",
 			},
 		},
 		"/> touch /board/list/card (list not found)": {
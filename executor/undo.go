@@ -0,0 +1,29 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+)
+
+const journalDisabledError = "the journal is disabled, set 'journal.size' in the configuration to enable it\n"
+
+type undo struct {
+	executor
+	history trello.History
+}
+
+// Execute undoes the last mutation of the journal
+func (u undo) Execute(_ []string) {
+	if u.history == nil {
+		fmt.Fprint(u.stderr, journalDisabledError)
+		return
+	}
+	entry, err := u.history.Undo()
+	if err != nil {
+		fmt.Fprintf(u.stderr, "%v\n", err)
+		return
+	}
+	if !u.isDryRun() {
+		fmt.Fprintf(u.stdout, "undone: %s\n", entry)
+	}
+}
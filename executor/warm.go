@@ -0,0 +1,39 @@
+package executor
+
+import (
+	"fmt"
+)
+
+type warm struct {
+	executor
+}
+
+// Execute fetches in advance the lists, cards and labels of the given boards, or of the current board
+func (w warm) Execute(args []string) {
+	if len(args) == 0 {
+		args = []string{""}
+	}
+	for _, arg := range args {
+		for _, path := range w.expandPath(arg) {
+			w.execute(path)
+		}
+	}
+}
+
+func (w warm) execute(arg string) {
+	exec := start(w.tr).
+		resolvePath(w.session, arg).
+		then()
+	if exec.err == nil && exec.p.BoardName == "" {
+		fmt.Fprintf(w.stderr, "%s\n", noBoardError)
+		return
+	}
+	if exec.findBoard(); exec.err != nil {
+		fmt.Fprintf(w.stderr, "%s\n", exec.err)
+		return
+	}
+	board := exec.session.Board
+	if err := w.tr.Prefetch(board.ID); err != nil {
+		fmt.Fprintf(w.stderr, "could not prefetch board '%s': %v\n", board.Name, err)
+	}
+}
@@ -0,0 +1,99 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestWarm_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board1 := trello.Board{ID: "board 1", Name: "board"}
+	board2 := trello.Board{ID: "board 2", Name: "another board"}
+
+	var tests = map[string]struct {
+		args                  []string
+		session               *trello.Session
+		buildTrelloRepository func() trello.Repository
+		expected              string
+	}{
+		"current board": {
+			args:    []string{},
+			session: &trello.Session{Board: &board1},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board1.Name).
+					Return(&board1, nil)
+				tr.EXPECT().
+					Prefetch(board1.ID).
+					Return(nil)
+				return tr
+			},
+		},
+		"several boards": {
+			args:    []string{"/board", "/another board"},
+			session: &trello.Session{},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board1.Name).
+					Return(&board1, nil)
+				tr.EXPECT().
+					Prefetch(board1.ID).
+					Return(nil)
+				tr.EXPECT().
+					FindBoard(board2.Name).
+					Return(&board2, nil)
+				tr.EXPECT().
+					Prefetch(board2.ID).
+					Return(nil)
+				return tr
+			},
+		},
+		"no board selected": {
+			args:    []string{},
+			session: &trello.Session{},
+			buildTrelloRepository: func() trello.Repository {
+				return trello.NewMockRepository(ctrl)
+			},
+			expected: "no board selected, give the path of a board or 'cd' into it\n",
+		},
+		"prefetch error": {
+			args:    []string{"/board"},
+			session: &trello.Session{},
+			buildTrelloRepository: func() trello.Repository {
+				tr := trello.NewMockRepository(ctrl)
+				tr.EXPECT().
+					FindBoard(board1.Name).
+					Return(&board1, nil)
+				tr.EXPECT().
+					Prefetch(board1.ID).
+					Return(errors.New("unexpected error"))
+				return tr
+			},
+			expected: "could not prefetch board 'board': unexpected error\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stderrBuf := bytes.Buffer{}
+			w := warm{executor{
+				tr:      tt.buildTrelloRepository(),
+				session: tt.session,
+				stdout:  &bytes.Buffer{},
+				stderr:  &stderrBuf,
+			}}
+			w.Execute(tt.args)
+
+			actual := stderrBuf.String()
+			if actual != tt.expected {
+				t.Errorf("expected stderr %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
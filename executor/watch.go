@@ -0,0 +1,139 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"strings"
+	"time"
+)
+
+const (
+	// intervalFlag sets the delay between two fetches of the activity, e.g. "--interval 1m"
+	intervalFlag = "--interval"
+	// defaultWatchInterval keeps the requests far below the Trello rate limits
+	defaultWatchInterval = 15 * time.Second
+)
+
+type watch struct {
+	executor
+	offline  bool
+	interval time.Duration
+	now      func() time.Time
+	// interrupted returns a context that is done when the user stops watching, e.g. with Ctrl+C
+	interrupted func() (context.Context, context.CancelFunc)
+}
+
+// Execute prints the activity of the given board or list, or of the current one, as it happens,
+// until it is interrupted
+func (w watch) Execute(args []string) {
+	if w.offline {
+		fmt.Fprintf(w.stderr, "watch is not available offline, run it without --offline\n")
+		return
+	}
+	var paths []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg != intervalFlag && !strings.HasPrefix(arg, intervalFlag+"=") {
+			paths = append(paths, arg)
+			continue
+		}
+		value := strings.TrimPrefix(strings.TrimPrefix(arg, intervalFlag), "=")
+		if arg == intervalFlag {
+			if i+1 >= len(args) {
+				fmt.Fprintf(w.stderr, "missing duration after %s\n", intervalFlag)
+				return
+			}
+			i++
+			value = args[i]
+		}
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			fmt.Fprintf(w.stderr, "invalid interval '%s', use a duration like 30s or 1m\n", value)
+			return
+		}
+		w.interval = interval
+	}
+	if len(paths) > 1 {
+		fmt.Fprintf(w.stderr, "only one board or list is accepted\n")
+		return
+	}
+	arg := ""
+	if len(paths) == 1 {
+		arg = paths[0]
+	}
+
+	exec := start(w.tr).
+		resolvePath(w.session, arg).
+		then()
+	if exec.err == nil && exec.p.BoardName == "" {
+		fmt.Fprintf(w.stderr, "%s\n", noBoardError)
+		return
+	}
+	var board *trello.Board
+	var list *trello.List
+	listExec := exec.
+		findBoard().
+		doOnBoard(func(b *trello.Board) {
+			board = b
+		}).
+		then()
+	listExec.
+		findList().
+		doOnList(func(l *trello.List) {
+			board = listExec.session.Board
+			list = l
+		})
+	if listExec.err != nil {
+		fmt.Fprintf(w.stderr, "%s\n", listExec.err)
+		return
+	}
+	if !listExec.isFinished {
+		fmt.Fprintf(w.stderr, "only boards and lists can be watched\n")
+		return
+	}
+
+	ctx, stop := w.interrupted()
+	defer stop()
+	w.poll(ctx, *board, list)
+}
+
+// poll fetches the activity of the board since the last fetch, then prints the actions not printed yet,
+// from the oldest to the most recent.
+// Fetching the activity through the caches evicts the resources changed by the actions,
+// so the next commands, e.g. ls, show the cards as they were moved
+func (w watch) poll(ctx context.Context, board trello.Board, list *trello.List) {
+	since := w.now()
+	// actions already printed, as Trello returns the ones of the same second as the since date again
+	printed := map[string]bool{}
+	for {
+		actions, err := w.tr.FindBoardActions(board.ID, since)
+		if err != nil {
+			// Trello may be temporarily unavailable, so keep watching
+			fmt.Fprintf(w.stderr, "could not fetch activity of board '%s': %v\n", board.Name, err)
+		} else {
+			since = actions.Latest(since)
+			if list != nil {
+				actions = actions.FilterByList(list.ID)
+			}
+			newActions := trello.Actions{}
+			for _, action := range actions {
+				if !printed[action.ID] {
+					newActions = append(newActions, action)
+				}
+			}
+			printed = map[string]bool{}
+			for _, action := range actions {
+				printed[action.ID] = true
+			}
+			if len(newActions) > 0 {
+				printRendered(w.stdout, w.r.RenderActions(newActions.Reversed()))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.interval):
+		}
+	}
+}
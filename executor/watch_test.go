@@ -0,0 +1,320 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/conf"
+	"github.com/l-lin/tcli/renderer"
+	"github.com/l-lin/tcli/trello"
+	"github.com/l-lin/tcli/trello/fake"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatch_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := trello.Board{ID: "board 1", Name: "board"}
+	list := trello.List{ID: "list 1", Name: "list", IDBoard: board.ID}
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	created := trello.Action{ID: "action 1", Date: "2026-10-01T09:00:10.000Z", Data: trello.ActionData{List: &trello.ActionResource{ID: list.ID}}}
+	commented := trello.Action{ID: "action 2", Date: "2026-10-01T09:00:20.000Z", Data: trello.ActionData{List: &trello.ActionResource{ID: "list 2"}}}
+	moved := trello.Action{ID: "action 3", Date: "2026-10-01T09:00:20.500Z", Data: trello.ActionData{ListBefore: &trello.ActionResource{ID: "list 2"}, ListAfter: &trello.ActionResource{ID: list.ID}}}
+
+	type given struct {
+		args                  []string
+		offline               bool
+		buildTrelloRepository func(stop context.CancelFunc) trello.Repository
+		buildRenderer         func() renderer.Renderer
+	}
+	type expected struct {
+		stdout string
+		stderr string
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"watch board": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func(stop context.CancelFunc) trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					gomock.InOrder(
+						tr.EXPECT().
+							FindBoardActions(board.ID, now).
+							Return(trello.Actions{commented, created}, nil),
+						tr.EXPECT().
+							FindBoardActions(board.ID, time.Date(2026, 10, 1, 9, 0, 20, 0, time.UTC)).
+							DoAndReturn(func(_ string, _ time.Time) (trello.Actions, error) {
+								stop()
+								return trello.Actions{moved, commented}, nil
+							}),
+					)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					gomock.InOrder(
+						r.EXPECT().RenderActions(trello.Actions{created, commented}).Return("created and commented"),
+						r.EXPECT().RenderActions(trello.Actions{moved}).Return("moved"),
+					)
+					return r
+				},
+			},
+			expected: expected{stdout: "created and commented\nmoved\n"},
+		},
+		"watch list": {
+			given: given{
+				args: []string{"/board/list", "--interval=1ms"},
+				buildTrelloRepository: func(stop context.CancelFunc) trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, list.Name).Return(&list, nil)
+					gomock.InOrder(
+						tr.EXPECT().
+							FindBoardActions(board.ID, now).
+							Return(trello.Actions{commented, created}, nil),
+						tr.EXPECT().
+							FindBoardActions(board.ID, gomock.Any()).
+							DoAndReturn(func(_ string, _ time.Time) (trello.Actions, error) {
+								stop()
+								return trello.Actions{moved, commented}, nil
+							}),
+					)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					gomock.InOrder(
+						r.EXPECT().RenderActions(trello.Actions{created}).Return("created"),
+						r.EXPECT().RenderActions(trello.Actions{moved}).Return("moved"),
+					)
+					return r
+				},
+			},
+			expected: expected{stdout: "created\nmoved\n"},
+		},
+		"keep watching when the activity cannot be fetched": {
+			given: given{
+				args: []string{"/board"},
+				buildTrelloRepository: func(stop context.CancelFunc) trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					gomock.InOrder(
+						tr.EXPECT().
+							FindBoardActions(board.ID, now).
+							Return(nil, errors.New("unexpected error")),
+						tr.EXPECT().
+							FindBoardActions(board.ID, now).
+							DoAndReturn(func(_ string, _ time.Time) (trello.Actions, error) {
+								stop()
+								return trello.Actions{created}, nil
+							}),
+					)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					r := renderer.NewMockRenderer(ctrl)
+					r.EXPECT().RenderActions(trello.Actions{created}).Return("created")
+					return r
+				},
+			},
+			expected: expected{
+				stdout: "created\n",
+				stderr: "could not fetch activity of board 'board': unexpected error\n",
+			},
+		},
+		"card": {
+			given: given{
+				args: []string{"/board/list/card"},
+				buildTrelloRepository: func(_ context.CancelFunc) trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard(board.Name).Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, list.Name).Return(&list, nil)
+					return tr
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "only boards and lists can be watched\n"},
+		},
+		"invalid interval": {
+			given: given{
+				args: []string{"/board", "--interval", "often"},
+				buildTrelloRepository: func(_ context.CancelFunc) trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "invalid interval 'often', use a duration like 30s or 1m\n"},
+		},
+		"missing interval": {
+			given: given{
+				args: []string{"/board", "--interval"},
+				buildTrelloRepository: func(_ context.CancelFunc) trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "missing duration after --interval\n"},
+		},
+		"several paths": {
+			given: given{
+				args: []string{"/board", "/another-board"},
+				buildTrelloRepository: func(_ context.CancelFunc) trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "only one board or list is accepted\n"},
+		},
+		"no board selected": {
+			given: given{
+				args: []string{},
+				buildTrelloRepository: func(_ context.CancelFunc) trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "no board selected, give the path of a board or 'cd' into it\n"},
+		},
+		"offline": {
+			given: given{
+				args:    []string{"/board"},
+				offline: true,
+				buildTrelloRepository: func(_ context.CancelFunc) trello.Repository {
+					return trello.NewMockRepository(ctrl)
+				},
+				buildRenderer: func() renderer.Renderer {
+					return renderer.NewMockRenderer(ctrl)
+				},
+			},
+			expected: expected{stderr: "watch is not available offline, run it without --offline\n"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdoutBuf := bytes.Buffer{}
+			stderrBuf := bytes.Buffer{}
+			ctx, stop := context.WithCancel(context.Background())
+			defer stop()
+			w := watch{
+				executor: executor{
+					tr:      tt.given.buildTrelloRepository(stop),
+					r:       tt.given.buildRenderer(),
+					session: &trello.Session{},
+					stdout:  &stdoutBuf,
+					stderr:  &stderrBuf,
+				},
+				offline:  tt.given.offline,
+				interval: time.Millisecond,
+				now:      func() time.Time { return now },
+				interrupted: func() (context.Context, context.CancelFunc) {
+					return ctx, stop
+				},
+			}
+			w.Execute(tt.given.args)
+
+			actualStdout := stdoutBuf.String()
+			if actualStdout != tt.expected.stdout {
+				t.Errorf("expected stdout %v, actual stdout %v", tt.expected.stdout, actualStdout)
+			}
+			actualStderr := stderrBuf.String()
+			if actualStderr != tt.expected.stderr {
+				t.Errorf("expected stderr %v, actual stderr %v", tt.expected.stderr, actualStderr)
+			}
+		})
+	}
+}
+
+func TestWatch_Execute_RefreshesCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// GIVEN a card listed through the in-memory cache
+	s := fake.NewServer()
+	defer s.Close()
+	outside := trello.NewHttpRepository(conf.Conf{Trello: s.TrelloConf()}, false)
+	board, _ := outside.CreateBoard(trello.CreateBoard{Name: "board"})
+	todo, _ := outside.CreateList(trello.CreateList{Name: "todo", IDBoard: board.ID, Pos: "bottom"})
+	done, _ := outside.CreateList(trello.CreateList{Name: "done", IDBoard: board.ID, Pos: "bottom"})
+	card, _ := outside.CreateCard(trello.CreateCard{Name: "card", IDList: todo.ID})
+	tr := trello.NewCacheInMemory(trello.NewHttpRepository(conf.Conf{Trello: s.TrelloConf()}, false))
+	r := renderer.NewMockRenderer(ctrl)
+	r.EXPECT().
+		RenderCards(gomock.Any()).
+		DoAndReturn(func(cards trello.Cards) string {
+			var names []string
+			for _, c := range cards {
+				names = append(names, c.Name)
+			}
+			return strings.Join(names, ",")
+		}).
+		AnyTimes()
+	ls := func(path string) string {
+		stdoutBuf := bytes.Buffer{}
+		l := ls{executor: executor{tr: tr, r: r, session: &trello.Session{}, stdout: &stdoutBuf, stderr: &bytes.Buffer{}}}
+		l.Execute([]string{path})
+		return stdoutBuf.String()
+	}
+	if actual := ls("/board/done"); actual != "" {
+		t.Fatalf("expected no card in the list, actual %v", actual)
+	}
+
+	// and moved outside tcli, which the cache does not see yet
+	since := time.Now().Add(-time.Second)
+	moved := trello.NewUpdateCard(*card)
+	moved.IDList = done.ID
+	if _, err := outside.UpdateCard(moved); err != nil {
+		t.Fatal(err)
+	}
+	if actual := ls("/board/done"); actual != "" {
+		t.Fatalf("expected the cached cards, actual %v", actual)
+	}
+
+	// WHEN the move is shown by watch
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	r.EXPECT().
+		RenderActions(gomock.Any()).
+		DoAndReturn(func(_ trello.Actions) string {
+			stop()
+			return "moved"
+		})
+	stderrBuf := bytes.Buffer{}
+	w := watch{
+		executor: executor{tr: tr, r: r, session: &trello.Session{}, stdout: &bytes.Buffer{}, stderr: &stderrBuf},
+		interval: time.Millisecond,
+		now:      func() time.Time { return since },
+		interrupted: func() (context.Context, context.CancelFunc) {
+			return ctx, stop
+		},
+	}
+	w.Execute([]string{"/board"})
+
+	// THEN the next ls shows the card in its new list
+	if stderrBuf.Len() != 0 {
+		t.Errorf("expected no error, actual %v", stderrBuf.String())
+	}
+	if actual := ls("/board/done"); actual != "card\n" {
+		t.Errorf("expected the moved card, actual %v", actual)
+	}
+	if actual := ls("/board/todo"); actual != "" {
+		t.Errorf("expected no card left in the previous list, actual %v", actual)
+	}
+}
@@ -17,5 +17,6 @@ require (
 	github.com/spf13/cobra v1.1.3
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.7.1
+	github.com/yuin/goldmark v1.2.0
 	gopkg.in/yaml.v2 v2.4.0
 )
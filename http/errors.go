@@ -0,0 +1,53 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrUnauthorized is matched by the errors of the responses with a 401 status code
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound is matched by the errors of the responses with a 404 status code
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited is matched by the errors of the responses with a 429 status code
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// StatusError is returned when the status code of the http response is not OK
+type StatusError struct {
+	StatusCode int
+	Body       string
+	// RetryAfter is the delay to wait before sending a new request, given by the Retry-After header
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return "unauthorized by Trello, check the API key and the access token in the configuration"
+	case http.StatusNotFound:
+		return "resource not found in Trello"
+	case http.StatusTooManyRequests:
+		if e.RetryAfter > 0 {
+			return fmt.Sprintf("Trello rate limit reached, retry in %s", e.RetryAfter)
+		}
+		return "Trello rate limit reached, retry later"
+	}
+	return fmt.Sprintf("http response status was %d and http response body was %s", e.StatusCode, e.Body)
+}
+
+// Is makes the StatusError match ErrUnauthorized, ErrNotFound or ErrRateLimited with errors.Is
+func (e *StatusError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
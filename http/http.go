@@ -1,15 +1,41 @@
 package http
 
 import (
-	"fmt"
 	"github.com/henvic/httpretty"
 	"io"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// maximum number of times a request is retried
+	defaultMaxRetries = 3
+	// delay before the first retry, doubled at each retry
+	defaultBackoff = 500 * time.Millisecond
+	// maximum delay between two retries
+	defaultMaxBackoff = 10 * time.Second
+
+	// headers returned by Trello to give the remaining number of requests allowed in the current interval
+	// see https://developer.atlassian.com/cloud/trello/guides/rest-api/rate-limits/
+	rateLimitRemainingHeader = "X-Rate-Limit-Api-Token-Remaining"
+	rateLimitIntervalHeader  = "X-Rate-Limit-Api-Token-Interval-Ms"
 )
 
 // Client is a wrapper to factorize http calls
 type Client struct {
 	*http.Client
+	maxRetries int
+	backoff    time.Duration
+	maxBackoff time.Duration
+	sleep      func(time.Duration)
+	now        func() time.Time
+
+	mu sync.Mutex
+	// no request is sent before this date when the rate limit is reached
+	notBefore time.Time
 }
 
 func NewClient(debug bool) *Client {
@@ -27,24 +53,125 @@ func NewClient(debug bool) *Client {
 		}
 		client.Transport = logger.RoundTripper(http.DefaultTransport)
 	}
-	return &Client{Client: client}
+	return &Client{
+		Client:     client,
+		maxRetries: defaultMaxRetries,
+		backoff:    defaultBackoff,
+		maxBackoff: defaultMaxBackoff,
+		sleep:      time.Sleep,
+		now:        time.Now,
+	}
 }
 
-// DoOnlyOk invokes http.Client.Do and return http.Response only if response status code is OK
-func (c Client) DoOnlyOk(request *http.Request) (*http.Response, error) {
-	response, err := c.Client.Do(request)
+// DoOnlyOk invokes http.Client.Do and return http.Response only if response status code is OK.
+// The idempotent requests are retried with an exponential backoff when the rate limit is reached
+// or when Trello is temporarily unavailable.
+// A *StatusError is returned if the response status code is not OK.
+func (c *Client) DoOnlyOk(request *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		c.waitRateLimit()
+		response, err := c.Client.Do(request)
+		if err == nil {
+			c.updateRateLimit(response)
+			if response.StatusCode == http.StatusOK {
+				return response, nil
+			}
+			err = newStatusError(response)
+		}
+		if attempt >= c.maxRetries || !isRetryable(err) || !rewind(request) {
+			return nil, err
+		}
+		c.sleep(c.retryDelay(attempt, err))
+	}
+}
+
+// waitRateLimit waits until the end of the rate limit interval if there is no more request allowed in it
+func (c *Client) waitRateLimit() {
+	c.mu.Lock()
+	wait := c.notBefore.Sub(c.now())
+	c.mu.Unlock()
+	if wait > 0 {
+		c.sleep(wait)
+	}
+}
+
+func (c *Client) updateRateLimit(response *http.Response) {
+	remaining, err := strconv.Atoi(response.Header.Get(rateLimitRemainingHeader))
+	if err != nil || remaining > 0 {
+		return
+	}
+	interval, err := strconv.Atoi(response.Header.Get(rateLimitIntervalHeader))
 	if err != nil {
-		return nil, err
+		return
 	}
+	c.mu.Lock()
+	c.notBefore = c.now().Add(time.Duration(interval) * time.Millisecond)
+	c.mu.Unlock()
+}
 
-	if response.StatusCode != http.StatusOK {
-		defer response.Body.Close()
-		var body []byte
-		body, err = io.ReadAll(response.Body)
-		if err != nil {
-			return nil, err
-		}
-		return nil, fmt.Errorf("http response status was %d and http response body was %s", response.StatusCode, string(body))
+// retryDelay returns the delay given by Trello when rate limited, otherwise an exponential backoff with jitter
+func (c *Client) retryDelay(attempt int, err error) time.Duration {
+	if statusErr, ok := err.(*StatusError); ok && statusErr.RetryAfter > 0 {
+		return statusErr.RetryAfter
+	}
+	delay := c.backoff << uint(attempt)
+	if delay <= 0 || delay > c.maxBackoff {
+		delay = c.maxBackoff
+	}
+	// randomize between half and the full delay, so concurrent requests do not retry at the same time
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// isRetryable returns true if the request may succeed when sent again
+func isRetryable(err error) bool {
+	statusErr, ok := err.(*StatusError)
+	if !ok {
+		// network error
+		return true
+	}
+	switch statusErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// rewind the body of the request so it can be sent again, returning false if the request must not be retried
+func rewind(request *http.Request) bool {
+	if !isIdempotent(request.Method) {
+		return false
+	}
+	if request.Body == nil || request.Body == http.NoBody {
+		return true
+	}
+	if request.GetBody == nil {
+		return false
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return false
+	}
+	request.Body = body
+	return true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func newStatusError(response *http.Response) error {
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	statusErr := &StatusError{StatusCode: response.StatusCode, Body: string(body)}
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		statusErr.RetryAfter = time.Duration(seconds) * time.Second
 	}
-	return response, nil
+	return statusErr
 }
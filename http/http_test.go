@@ -1,9 +1,13 @@
 package http
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_DoOnlyOk(t *testing.T) {
@@ -44,3 +48,161 @@ func TestClient_DoOnlyOk(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func newTestClient(sleeps *[]time.Duration) *Client {
+	client := NewClient(false)
+	client.sleep = func(d time.Duration) {
+		*sleeps = append(*sleeps, d)
+	}
+	return client
+}
+
+func TestClient_DoOnlyOk_Retries(t *testing.T) {
+	type expected struct {
+		requests   int
+		statusCode int
+		sleeps     int
+	}
+	var tests = map[string]struct {
+		method     string
+		statusCode int
+		expected   expected
+	}{
+		"retry idempotent request when Trello is unavailable": {
+			method:     http.MethodGet,
+			statusCode: http.StatusServiceUnavailable,
+			expected:   expected{requests: defaultMaxRetries + 1, statusCode: http.StatusServiceUnavailable, sleeps: defaultMaxRetries},
+		},
+		"retry idempotent request with body": {
+			method:     http.MethodPut,
+			statusCode: http.StatusBadGateway,
+			expected:   expected{requests: defaultMaxRetries + 1, statusCode: http.StatusBadGateway, sleeps: defaultMaxRetries},
+		},
+		"do not retry non idempotent request": {
+			method:     http.MethodPost,
+			statusCode: http.StatusServiceUnavailable,
+			expected:   expected{requests: 1, statusCode: http.StatusServiceUnavailable},
+		},
+		"do not retry client error": {
+			method:     http.MethodGet,
+			statusCode: http.StatusBadRequest,
+			expected:   expected{requests: 1, statusCode: http.StatusBadRequest},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if b, _ := io.ReadAll(r.Body); string(b) != "body" {
+					t.Errorf("expected body to be sent at each request, actual %s", string(b))
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer ts.Close()
+			var sleeps []time.Duration
+			client := newTestClient(&sleeps)
+
+			request, _ := http.NewRequest(tt.method, ts.URL, strings.NewReader("body"))
+			_, err := client.DoOnlyOk(request)
+
+			var statusErr *StatusError
+			if !errors.As(err, &statusErr) || statusErr.StatusCode != tt.expected.statusCode {
+				t.Errorf("expected status error %d, actual %v", tt.expected.statusCode, err)
+			}
+			if requests != tt.expected.requests {
+				t.Errorf("expected %d requests, actual %d", tt.expected.requests, requests)
+			}
+			if len(sleeps) != tt.expected.sleeps {
+				t.Errorf("expected %d sleeps, actual %v", tt.expected.sleeps, sleeps)
+			}
+			for i, sleep := range sleeps {
+				if max := defaultBackoff << uint(i); sleep < max/2 || sleep > max {
+					t.Errorf("expected sleep %d between %v and %v, actual %v", i, max/2, max, sleep)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_DoOnlyOk_RateLimit(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set(rateLimitRemainingHeader, "0")
+		w.Header().Set(rateLimitIntervalHeader, "10000")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+	var sleeps []time.Duration
+	client := newTestClient(&sleeps)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	client.now = func() time.Time { return now }
+
+	// the Retry-After header is honored
+	request, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+	if _, err := client.DoOnlyOk(request); err != nil {
+		t.Fatal(err)
+	}
+	if len(sleeps) != 1 || sleeps[0] != 2*time.Second {
+		t.Errorf("expected to wait 2s, actual %v", sleeps)
+	}
+
+	// no request is allowed until the end of the interval
+	now = now.Add(4 * time.Second)
+	request, _ = http.NewRequest(http.MethodGet, ts.URL, nil)
+	if _, err := client.DoOnlyOk(request); err != nil {
+		t.Fatal(err)
+	}
+	if len(sleeps) != 2 || sleeps[1] != 6*time.Second {
+		t.Errorf("expected to wait 6s, actual %v", sleeps)
+	}
+}
+
+func TestClient_DoOnlyOk_TypedErrors(t *testing.T) {
+	var tests = map[string]struct {
+		statusCode int
+		expected   error
+		message    string
+	}{
+		"unauthorized": {
+			statusCode: http.StatusUnauthorized,
+			expected:   ErrUnauthorized,
+			message:    "unauthorized by Trello, check the API key and the access token in the configuration",
+		},
+		"not found": {
+			statusCode: http.StatusNotFound,
+			expected:   ErrNotFound,
+			message:    "resource not found in Trello",
+		},
+		"rate limited": {
+			statusCode: http.StatusTooManyRequests,
+			expected:   ErrRateLimited,
+			message:    "Trello rate limit reached, retry later",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer ts.Close()
+			var sleeps []time.Duration
+			client := newTestClient(&sleeps)
+
+			request, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+			_, err := client.DoOnlyOk(request)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, err)
+			}
+			if err.Error() != tt.message {
+				t.Errorf("expected message %v, actual %v", tt.message, err.Error())
+			}
+		})
+	}
+}
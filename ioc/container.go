@@ -1,13 +1,14 @@
 package ioc
 
 import (
-	"github.com/charmbracelet/glamour"
 	"github.com/l-lin/tcli/conf"
 	"github.com/l-lin/tcli/prompt"
 	"github.com/l-lin/tcli/renderer"
 	"github.com/l-lin/tcli/trello"
+	"github.com/l-lin/tcli/trello/fake"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"os"
 )
 
 // Container IoC used to bootstrap the beans
@@ -35,19 +36,42 @@ func Bootstrap(inputs Inputs) *Container {
 
 func (c *Container) registerTrelloRepository() {
 	var tr trello.Repository
-	tr = trello.NewHttpRepository(*c.Conf, c.Debug)
-
-	if c.Inputs.NoCache {
-		c.TrelloRepository = tr
+	if c.Conf.Offline {
+		// the mirror is already on disk, so only the in-memory cache is useful
+		tr = trello.NewOffline(*c.Conf)
+		tr = trello.NewCacheInMemory(tr)
+	} else if c.Inputs.Fake {
+		// the fake server is in memory, so nothing must be mirrored nor cached on disk
+		tr = trello.NewHttpRepository(*c.Conf, c.Debug)
+		if !c.Inputs.NoCache {
+			tr = trello.NewCacheInMemory(tr)
+		}
 	} else {
-		var cacheTr trello.Repository
-		cacheTr = trello.NewCacheInMemory(tr)
-
-		c.TrelloRepository = cacheTr
+		tr = trello.NewHttpRepository(*c.Conf, c.Debug)
+		// the mirror must be the closest decorator to Trello, to keep all the resources fetched from it
+		tr = trello.NewMirror(tr, *c.Conf)
+		if !c.Inputs.NoCache {
+			tr = trello.NewCacheOnDisk(tr, *c.Conf)
+			tr = trello.NewCacheInMemory(tr)
+		}
+	}
+	// the journal must be the first decorator, to see the resources fetched by the commands before mutating them;
+	// it is kept in memory with the fake server, as the changes it records are lost when tcli exits
+	if c.Inputs.Fake {
+		tr = trello.NewJournalInMemory(tr, *c.Conf)
+	} else {
+		tr = trello.NewJournal(tr, *c.Conf)
 	}
+	// the dry-run must wrap the journal, so the mutations it prevents are not recorded;
+	// its diffs are printed on stderr, not to mix them with the output of the commands, e.g. in json
+	c.TrelloRepository = trello.NewDryRun(tr, c.Inputs.DryRun, os.Stderr)
 }
 
 func (c *Container) registerConf() {
+	if c.Inputs.Fake {
+		c.registerFakeConf()
+		return
+	}
 	var cr conf.Repository
 	cr = conf.NewFileRepository(c.File, c.Viper)
 	var cp conf.Provider
@@ -59,6 +83,15 @@ func (c *Container) registerConf() {
 			Msg("could not initialize config")
 	}
 	c.Conf = cp.Get()
+	if c.Inputs.Offline {
+		c.Conf.Offline = true
+	}
+}
+
+// registerFakeConf uses the default config with a fake Trello server, without reading nor writing the config file
+func (c *Container) registerFakeConf() {
+	c.Conf = conf.NewConf()
+	c.Conf.Trello = fake.NewDemoServer().TrelloConf()
 }
 
 func (c *Container) setLogLevel() {
@@ -74,7 +107,7 @@ func (c *Container) setLogLevel() {
 func (c *Container) registerRenderer() {
 	var cdr renderer.Description
 	var err error
-	if cdr, err = glamour.NewTermRenderer(glamour.WithAutoStyle()); err != nil {
+	if cdr, err = renderer.NewTermDescription(); err != nil {
 		log.Fatal().
 			Stack().
 			Err(err).
@@ -84,8 +117,21 @@ func (c *Container) registerRenderer() {
 	var lr renderer.Labels
 	lr = renderer.TermEnvLabel{}
 
+	output := c.Inputs.Output
+	if output == "" {
+		output = c.Conf.Output
+	}
+	if output == "" {
+		output = "table"
+	}
+	if !conf.IsSupportedOutput(output) {
+		log.Fatal().
+			Str("output", output).
+			Msg("unsupported output, use one of 'table', 'json' or 'ndjson'")
+	}
+
 	var r renderer.Renderer
-	r = renderer.NewInTableRenderer(lr, cdr)
+	r = renderer.New(output, lr, cdr, renderer.TermEnvOverdue{})
 	c.Renderer = r
 }
 
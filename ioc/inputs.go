@@ -8,4 +8,8 @@ type Inputs struct {
 	Debug   bool
 	File    string
 	NoCache bool
+	Output  string
+	DryRun  bool
+	Offline bool
+	Fake    bool
 }
@@ -19,8 +19,24 @@ func main() {
 	rootCmd.AddCommand(cmd.NewEditCmd())
 	rootCmd.AddCommand(cmd.NewTouchCmd())
 	rootCmd.AddCommand(cmd.NewRMCmd())
+	rootCmd.AddCommand(cmd.NewRestoreCmd())
 	rootCmd.AddCommand(cmd.NewMVCmd())
 	rootCmd.AddCommand(cmd.NewCPCmd())
+	rootCmd.AddCommand(cmd.NewFindCmd())
+	rootCmd.AddCommand(cmd.NewLabelCmd())
+	rootCmd.AddCommand(cmd.NewAttachCmd())
+	rootCmd.AddCommand(cmd.NewUndoCmd())
+	rootCmd.AddCommand(cmd.NewRedoCmd())
+	rootCmd.AddCommand(cmd.NewHistoryCmd())
+	rootCmd.AddCommand(cmd.NewLogCmd())
+	rootCmd.AddCommand(cmd.NewWatchCmd())
+	rootCmd.AddCommand(cmd.NewExportCmd())
+	rootCmd.AddCommand(cmd.NewImportCmd())
+	rootCmd.AddCommand(cmd.NewReportCmd())
+	rootCmd.AddCommand(cmd.NewWarmCmd())
+	rootCmd.AddCommand(cmd.NewSyncCmd())
+	rootCmd.AddCommand(cmd.NewServeWebhookCmd())
+	rootCmd.AddCommand(cmd.NewRunCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Err(err).Msg("error when executing the root command")
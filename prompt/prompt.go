@@ -47,9 +47,18 @@ type Prompt struct {
 	Session *trello.Session
 	stdout  io.Writer
 	stderr  io.Writer
+	// webhookReceiver receives the actions performed outside tcli, nil if the webhooks are not served
+	webhookReceiver *trello.WebhookReceiver
+}
+
+// ReceiveWebhooks makes the prompt forget the resources changed by the actions received by the given receiver,
+// before executing each command and completing each input
+func (p *Prompt) ReceiveWebhooks(receiver *trello.WebhookReceiver) {
+	p.webhookReceiver = receiver
 }
 
 func (p *Prompt) Executor(in string) {
+	p.forgetChangedResources()
 	input := strings.TrimSpace(in)
 	if input == "" {
 		return
@@ -78,6 +87,7 @@ func (p *Prompt) Executor(in string) {
 }
 
 func (p *Prompt) Completer(d prompt.Document) []prompt.Suggest {
+	p.forgetChangedResources()
 	c := completer.New(p.tr, p.Session)
 	input := d.TextBeforeCursor()
 	cmd, _ := getCmd(input)
@@ -92,8 +102,27 @@ func (p *Prompt) Completer(d prompt.Document) []prompt.Suggest {
 	return c.Complete(cmd, args)
 }
 
+// forgetChangedResources applies the actions received by the webhooks to the repository,
+// in the goroutine of the prompt, so the resources are not changed while a command is reading them
+func (p *Prompt) forgetChangedResources() {
+	if p.webhookReceiver == nil {
+		return
+	}
+	if actions := p.webhookReceiver.Apply(p.tr); len(actions) > 0 {
+		log.Debug().
+			Int("actions", len(actions)).
+			Msg("forgetting the resources changed outside tcli")
+	}
+}
+
 func (p *Prompt) LivePrefix() (string, bool) {
 	builder := strings.Builder{}
+	if p.conf.Offline {
+		builder.WriteString("[offline] ")
+	}
+	if toggle, ok := p.tr.(trello.DryRunToggle); ok && toggle.IsDryRun() {
+		builder.WriteString("[dry-run] ")
+	}
 	builder.WriteString("/")
 	if p.Session.Board != nil {
 		builder.WriteString(fmt.Sprintf("%s", p.Session.Board.Name))
@@ -207,14 +236,15 @@ func (p *Prompt) executeWithPipe(cmd string, args []string, pipeIndex int) {
 	var stderr bytes.Buffer
 	if e := executor.New(p.conf, cmd, p.tr, p.r, p.Session, &stdout, &stderr); e != nil {
 		e.Execute(args[:pipeIndex])
-		executor.NewOS(bytes.NewReader(stdout.Bytes()), os.Stdout, os.Stderr).Execute(args[pipeIndex+1:])
+		io.Copy(p.stderr, &stderr)
+		executor.NewOS(bytes.NewReader(stdout.Bytes()), p.stdout, p.stderr).Execute(args[pipeIndex+1:])
 	} else {
 		fmt.Fprintf(p.stderr, "command not found: %s\n", cmd)
 	}
 }
 
 func (p *Prompt) execute(cmd string, args []string) {
-	if e := executor.New(p.conf, cmd, p.tr, p.r, p.Session, os.Stdout, os.Stderr); e != nil {
+	if e := executor.New(p.conf, cmd, p.tr, p.r, p.Session, p.stdout, p.stderr); e != nil {
 		e.Execute(args)
 	} else {
 		fmt.Fprintf(p.stderr, "command not found: %s\n", cmd)
@@ -1,6 +1,7 @@
 package prompt
 
 import (
+	"github.com/l-lin/tcli/conf"
 	"github.com/l-lin/tcli/trello"
 	"reflect"
 	"testing"
@@ -48,6 +49,28 @@ func TestPrompt_LivePrefix(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("dry-run", func(t *testing.T) {
+		s := Prompt{
+			tr:      trello.NewDryRun(nil, true, nil),
+			Session: &trello.Session{Board: &trello.Board{Name: "board"}},
+		}
+		actual, _ := s.LivePrefix()
+		if actual != "[dry-run] /board> " {
+			t.Errorf("expected %v, actual %v", "[dry-run] /board> ", actual)
+		}
+	})
+
+	t.Run("offline", func(t *testing.T) {
+		s := Prompt{
+			conf:    conf.Conf{Offline: true},
+			Session: &trello.Session{Board: &trello.Board{Name: "board"}},
+		}
+		actual, _ := s.LivePrefix()
+		if actual != "[offline] /board> " {
+			t.Errorf("expected %v, actual %v", "[offline] /board> ", actual)
+		}
+	})
 }
 
 func TestGetCmd(t *testing.T) {
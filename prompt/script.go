@@ -0,0 +1,79 @@
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/l-lin/tcli/conf"
+	"github.com/l-lin/tcli/renderer"
+	"github.com/l-lin/tcli/trello"
+	"io"
+	"strings"
+)
+
+const scriptCommentPrefix = "#"
+
+func NewScript(conf conf.Conf, tr trello.Repository, r renderer.Renderer, failFast bool, stdout, stderr io.Writer) *Script {
+	// the confirmations cannot be prompted, as stdin may not be a terminal, or may be the script itself
+	conf.NonInteractive = true
+	w := &stderrWatcher{Writer: stderr}
+	p := NewPrompt(conf, tr, r)
+	p.stdout = stdout
+	p.stderr = w
+	return &Script{
+		Prompt:   p,
+		stderr:   w,
+		failFast: failFast,
+	}
+}
+
+// Script executes tcli commands in non-interactive mode, one command per line
+// the session is kept across the lines, exactly like in the interactive mode
+type Script struct {
+	*Prompt
+	stderr   *stderrWatcher
+	failFast bool
+}
+
+// Run executes each line read from the given reader
+// a command is considered failed if it writes on stderr, e.g. when it requires a confirmation
+// that is not given by the never_prompt config
+// blank lines and lines starting with '#' are ignored
+func (s *Script) Run(in io.Reader) error {
+	nbFailures := 0
+	scanner := bufio.NewScanner(in)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, scriptCommentPrefix) {
+			continue
+		}
+
+		s.stderr.written = false
+		s.Executor(line)
+		if s.stderr.written {
+			nbFailures++
+			if s.failFast {
+				return fmt.Errorf("command at line %d failed: %s", lineNumber, line)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if nbFailures > 0 {
+		return fmt.Errorf("%d command(s) failed", nbFailures)
+	}
+	return nil
+}
+
+// stderrWatcher keeps track whether something was written on stderr
+type stderrWatcher struct {
+	io.Writer
+	written bool
+}
+
+func (w *stderrWatcher) Write(p []byte) (int, error) {
+	if len(p) > 0 {
+		w.written = true
+	}
+	return w.Writer.Write(p)
+}
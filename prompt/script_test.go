@@ -0,0 +1,166 @@
+package prompt
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/conf"
+	"github.com/l-lin/tcli/trello"
+	"strings"
+	"testing"
+)
+
+func TestScript_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := trello.Board{ID: "board 1", Name: "board"}
+	list := trello.List{ID: "list 1", Name: "list", IDBoard: board.ID}
+	card := trello.Card{ID: "card 1", Name: "card", IDList: list.ID, IDBoard: board.ID}
+
+	type given struct {
+		script                string
+		failFast              bool
+		conf                  conf.Conf
+		buildTrelloRepository func() trello.Repository
+	}
+	type expected struct {
+		hasError bool
+		stderr   string
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"session kept across lines": {
+			given: given{
+				script: `# create a card in the list
+cd /board/list
+
+touch card`,
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil).
+						Times(2)
+					tr.EXPECT().
+						Prefetch(board.ID).
+						Return(nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil).
+						Times(2)
+					tr.EXPECT().
+						CreateCard(trello.CreateCard{Name: "card", IDList: list.ID}).
+						Return(&trello.Card{ID: "card 1", Name: "card"}, nil)
+					return tr
+				},
+			},
+			expected: expected{},
+		},
+		"command writing on stderr": {
+			given: given{
+				script: `unknown-command
+cd /unknown-board`,
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard("unknown-board").
+						Return(nil, errors.New("not found"))
+					return tr
+				},
+			},
+			expected: expected{
+				hasError: true,
+				stderr: `command not found: unknown-command
+no board found with name 'unknown-board'
+`,
+			},
+		},
+		"fail fast": {
+			given: given{
+				script: `unknown-command
+cd /board`,
+				failFast: true,
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(gomock.Any()).
+						Times(0)
+					return tr
+				},
+			},
+			expected: expected{
+				hasError: true,
+				stderr:   "command not found: unknown-command\n",
+			},
+		},
+		"confirmation refused without prompting": {
+			given: given{
+				script: "rm /board/list/card",
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil)
+					tr.EXPECT().
+						FindCard(list.ID, card.Name).
+						Return(&card, nil)
+					tr.EXPECT().
+						UpdateCard(gomock.Any()).
+						Times(0)
+					return tr
+				},
+			},
+			expected: expected{
+				hasError: true,
+				stderr:   "Archive card 'card': confirmation required, use 'run --yes' or the never_prompt config to confirm it\n",
+			},
+		},
+		"confirmation given with never_prompt": {
+			given: given{
+				script: "rm /board/list/card",
+				conf:   conf.Conf{NeverPrompt: true},
+				buildTrelloRepository: func() trello.Repository {
+					tr := trello.NewMockRepository(ctrl)
+					tr.EXPECT().
+						FindBoard(board.Name).
+						Return(&board, nil)
+					tr.EXPECT().
+						FindList(board.ID, list.Name).
+						Return(&list, nil)
+					tr.EXPECT().
+						FindCard(list.ID, card.Name).
+						Return(&card, nil)
+					archived := trello.NewUpdateCard(card)
+					archived.Closed = true
+					tr.EXPECT().
+						UpdateCard(archived).
+						Return(&card, nil)
+					return tr
+				},
+			},
+			expected: expected{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdout := bytes.Buffer{}
+			stderr := bytes.Buffer{}
+			s := NewScript(tt.given.conf, tt.given.buildTrelloRepository(), nil, tt.given.failFast, &stdout, &stderr)
+
+			actualErr := s.Run(strings.NewReader(tt.given.script))
+
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
+			}
+			if stderr.String() != tt.expected.stderr {
+				t.Errorf("expected stderr:\n%v\nactual:\n%v", tt.expected.stderr, stderr.String())
+			}
+		})
+	}
+}
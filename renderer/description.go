@@ -1,10 +1,17 @@
 package renderer
 
+import "github.com/charmbracelet/glamour"
+
 // Description rendering entity descriptions
 type Description interface {
 	Render(description string) (string, error)
 }
 
+// NewTermDescription renders the Markdown descriptions with the style matching the terminal background
+func NewTermDescription() (Description, error) {
+	return glamour.NewTermRenderer(glamour.WithAutoStyle())
+}
+
 type PlainDescription struct{}
 
 func (p PlainDescription) Render(description string) (string, error) {
@@ -1,10 +1,18 @@
 package renderer
 
-import "github.com/l-lin/tcli/trello"
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/l-lin/tcli/trello"
+	"html/template"
+	"strings"
+)
 
 type Edit interface {
-	MarshalCardToCreate(trello.CardToCreate, trello.Lists, trello.Labels) ([]byte, error)
-	MarshalCardToEdit(trello.CardToEdit, trello.Lists, trello.Labels) ([]byte, error)
+	MarshalBoardToEdit(trello.BoardToEdit) ([]byte, error)
+	MarshalListToEdit(trello.ListToEdit) ([]byte, error)
+	MarshalCardToCreate(trello.CardToCreate, trello.Lists, trello.Labels, trello.Members) ([]byte, error)
+	MarshalCardToEdit(trello.CardToEdit, trello.Lists, trello.Labels, trello.Members, trello.CustomFields) ([]byte, error)
 	Unmarshal([]byte, interface{}) error
 	GetFileType() string
 }
@@ -15,3 +23,15 @@ func NewEdit(format string) Edit {
 	}
 	return NewEditInPrettyYaml()
 }
+
+// quote the given string in a double-quoted string that is valid in both YAML and TOML,
+// without escaping its HTML characters so the edited value is the same as the original one
+func quote(s string) template.HTML {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return template.HTML(`""`)
+	}
+	return template.HTML(strings.TrimSuffix(b.String(), "\n"))
+}
@@ -13,7 +13,65 @@ func NewEditInPrettyToml() Edit {
 	return EditInPrettyToml{}
 }
 
-func (e EditInPrettyToml) MarshalCardToCreate(ctc trello.CardToCreate, lists trello.Lists, labels trello.Labels) ([]byte, error) {
+func (e EditInPrettyToml) MarshalBoardToEdit(bte trello.BoardToEdit) ([]byte, error) {
+	t := `
+{{- /* ---------------- NAME ---------------- */ -}}
+name = {{quote .Board.Name}}
+{{/* ---------------- CLOSED ---------------- */ -}}
+# whether the board should be closed (closed: true)
+closed = {{.Board.Closed}}
+{{/* ---------------- DESCRIPTION ---------------- */ -}}
+desc = '''
+{{htmlSafe .BoardDescription}}
+'''
+`
+	tpl := template.Must(template.New("edit-board").Funcs(template.FuncMap{
+		"htmlSafe": func(html string) template.HTML {
+			return template.HTML(html)
+		},
+		"quote": quote,
+	}).Parse(t))
+	tplParams := struct {
+		Board            trello.BoardToEdit
+		BoardDescription string
+	}{
+		Board:            bte,
+		BoardDescription: bte.Desc,
+	}
+	w := bytes.NewBufferString("")
+	if err := tpl.Execute(w, tplParams); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+func (e EditInPrettyToml) MarshalListToEdit(lte trello.ListToEdit) ([]byte, error) {
+	t := `
+{{- /* ---------------- NAME ---------------- */ -}}
+name = {{quote .List.Name}}
+{{/* ---------------- CLOSED ---------------- */ -}}
+# whether the list should be archived (closed: true)
+closed = {{.List.Closed}}
+{{/* ---------------- POSITION ---------------- */ -}}
+# the position of the list in its board: "top", "bottom" or a positive float
+pos = "{{.List.Pos}}"
+`
+	tpl := template.Must(template.New("edit-list").Funcs(template.FuncMap{
+		"quote": quote,
+	}).Parse(t))
+	tplParams := struct {
+		List trello.ListToEdit
+	}{
+		List: lte,
+	}
+	w := bytes.NewBufferString("")
+	if err := tpl.Execute(w, tplParams); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+func (e EditInPrettyToml) MarshalCardToCreate(ctc trello.CardToCreate, lists trello.Lists, labels trello.Labels, members trello.Members) ([]byte, error) {
 	t := `
 {{- /* ---------------- NAME ---------------- */ -}}
 name = "{{.Card.Name}}"
@@ -28,8 +86,12 @@ idList = "{{.Card.IDList}}"
 {{/* ---------------- POSITION ---------------- */ -}}
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "bottom"
+{{/* ---------------- DATES ---------------- */ -}}
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = "{{.Card.Start}}"
+due = "{{.Card.Due}}"
 {{/* ---------------- LABELS ---------------- */ -}}
-# available labels (use color or ID):
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 {{- if .Labels -}}
   {{range $label := .Labels}}
 # {{$label.ID}}: {{$label.Color}}{{if $label.Name}} [{{$label.Name}}]{{end}}
@@ -42,19 +104,35 @@ labels = [
   {{- end -}}
 {{- end -}}
 ]
+{{/* ---------------- MEMBERS ---------------- */ -}}
+# available members (use username or ID):
+{{- if .Members -}}
+  {{range $member := .Members}}
+# {{$member.ID}}: {{$member.Username}}{{if $member.FullName}} [{{$member.FullName}}]{{end}}
+  {{- end -}}
+{{end}}
+members = [
+{{- if .Card.Members -}}
+  {{- range $i, $member := .Card.Members -}}
+    {{if $i}},{{end}}"{{$member}}"
+  {{- end -}}
+{{- end -}}
+]
 {{/* ---------------- DESCRIPTION ---------------- */ -}}
 desc = '''
 '''
 `
 	tpl := template.Must(template.New("create-card").Parse(t))
 	tplParams := struct {
-		Card   trello.CardToCreate
-		Lists  trello.Lists
-		Labels trello.Labels
+		Card    trello.CardToCreate
+		Lists   trello.Lists
+		Labels  trello.Labels
+		Members trello.Members
 	}{
-		Card:   ctc,
-		Lists:  lists,
-		Labels: labels,
+		Card:    ctc,
+		Lists:   lists,
+		Labels:  labels,
+		Members: members,
 	}
 	w := bytes.NewBufferString("")
 	if err := tpl.Execute(w, tplParams); err != nil {
@@ -63,7 +141,7 @@ desc = '''
 	return w.Bytes(), nil
 }
 
-func (e EditInPrettyToml) MarshalCardToEdit(cte trello.CardToEdit, lists trello.Lists, labels trello.Labels) ([]byte, error) {
+func (e EditInPrettyToml) MarshalCardToEdit(cte trello.CardToEdit, lists trello.Lists, labels trello.Labels, members trello.Members, customFields trello.CustomFields) ([]byte, error) {
 	t := `
 {{- /* ---------------- NAME ---------------- */ -}}
 name = "{{.Card.Name}}"
@@ -81,8 +159,14 @@ idList = "{{.Card.IDList}}"
 {{/* ---------------- POSITION ---------------- */ -}}
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "{{.Card.Pos}}"
+{{/* ---------------- DATES ---------------- */ -}}
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = "{{.Card.Start}}"
+due = "{{.Card.Due}}"
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = {{.Card.DueComplete}}
 {{/* ---------------- LABELS ---------------- */ -}}
-# available labels (use color or ID):
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 {{- if .Labels -}}
 {{range $label := .Labels}}
 # {{$label.ID}}: {{$label.Color}}{{if $label.Name}} [{{$label.Name}}]{{end}}
@@ -95,26 +179,73 @@ labels = [
   {{- end -}}
 {{- end -}}
 ]
+{{/* ---------------- MEMBERS ---------------- */ -}}
+# available members (use username or ID):
+{{- if .Members -}}
+  {{range $member := .Members}}
+# {{$member.ID}}: {{$member.Username}}{{if $member.FullName}} [{{$member.FullName}}]{{end}}
+  {{- end -}}
+{{end}}
+members = [
+{{- if .Card.Members -}}
+  {{- range $i, $member := .Card.Members -}}
+    {{if $i}},{{end}}"{{$member}}"
+  {{- end -}}
+{{- end -}}
+]
 {{/* ---------------- DESCRIPTION ---------------- */ -}}
 desc = '''
 {{htmlSafe .CardDescription}}
 '''
+{{/* ---------------- CUSTOM FIELDS ---------------- */ -}}
+{{- if .CustomFields -}}
+# available custom fields ("" to clear a value, natural dates like the due date, true or false for the checkboxes):
+{{- range $customField := .CustomFields}}
+# {{$customField.ID}}: {{$customField.Name}} ({{$customField.Type}})
+  {{- range $option := $customField.Options}}
+#   {{$option.ID}}: {{$option.Value.Text}}
+  {{- end}}
+{{- end}}
+[customFields]
+{{- range $customField := .CustomFields}}
+{{quote $customField.Name}} = {{quote (index $.Card.CustomFields $customField.Name)}}
+{{- end}}
+{{end -}}
+{{/* ---------------- CHECKLISTS ---------------- */ -}}
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+{{- range $checklist := .Card.Checklists}}
+[[checklists]]
+id = {{quote $checklist.ID}}
+name = {{quote $checklist.Name}}
+{{- range $item := $checklist.Items}}
+[[checklists.items]]
+id = {{quote $item.ID}}
+name = {{quote $item.Name}}
+checked = {{$item.Checked}}
+{{- end}}
+{{- end}}
 `
 	tpl := template.Must(template.New("edit-card").Funcs(template.FuncMap{
 		"htmlSafe": func(html string) template.HTML {
 			return template.HTML(html)
 		},
+		"quote": quote,
 	}).Parse(t))
 	tplParams := struct {
 		Card            trello.CardToEdit
 		Lists           trello.Lists
 		Labels          trello.Labels
+		Members         trello.Members
+		CustomFields    trello.CustomFields
 		CardDescription string
 	}{
 		Card:            cte,
 		Lists:           lists,
 		CardDescription: cte.Desc,
 		Labels:          labels,
+		Members:         members,
+		CustomFields:    customFields.SortedByPos(),
 	}
 	w := bytes.NewBufferString("")
 	if err := tpl.Execute(w, tplParams); err != nil {
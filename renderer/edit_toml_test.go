@@ -3,6 +3,7 @@ package renderer
 import (
 	"github.com/l-lin/tcli/trello"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -11,6 +12,7 @@ func TestEditInToml_MarshalCardToCreate(t *testing.T) {
 		ctc        trello.CardToCreate
 		boardLists trello.Lists
 		labels     trello.Labels
+		members    trello.Members
 	}
 	type expected struct {
 		hasError bool
@@ -49,11 +51,16 @@ func TestEditInToml_MarshalCardToCreate(t *testing.T) {
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "bottom"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels = []
+# available members (use username or ID):
+members = []
 desc = '''
 '''
 `,
@@ -83,11 +90,51 @@ desc = '''
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "bottom"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels = ["red","black"]
+# available members (use username or ID):
+members = []
+desc = '''
+'''
+`,
+			},
+		},
+		"card with members": {
+			given: given{
+				ctc: trello.CardToCreate{
+					Name:    "card",
+					IDList:  "list 1",
+					Members: []string{"member1", "member 2"},
+				},
+				boardLists: trello.Lists{},
+				labels:     trello.Labels{},
+				members: trello.Members{
+					{ID: "member 1", Username: "member1", FullName: "Member 1"},
+					{ID: "member 2", Username: "member2"},
+				},
+			},
+			expected: expected{
+				hasError: false,
+				content: `name = "card"
+# available lists:
+idList = "list 1"
+# the position of the card in its list: "top", "bottom" or a positive float
+pos = "bottom"
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# available labels (use color, name or ID, a new "color [name]" label can be created):
+labels = []
+# available members (use username or ID):
+# member 1: member1 [Member 1]
+# member 2: member2
+members = ["member1","member 2"]
 desc = '''
 '''
 `,
@@ -97,7 +144,7 @@ desc = '''
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			e := EditInPrettyToml{}
-			actual, actualErr := e.MarshalCardToCreate(tt.given.ctc, tt.given.boardLists, tt.given.labels)
+			actual, actualErr := e.MarshalCardToCreate(tt.given.ctc, tt.given.boardLists, tt.given.labels, tt.given.members)
 			if tt.expected.hasError != (actualErr != nil) {
 				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
 				t.FailNow()
@@ -111,9 +158,11 @@ desc = '''
 
 func TestEditInToml_RenderCardToEdit(t *testing.T) {
 	type given struct {
-		cte        trello.CardToEdit
-		boardLists trello.Lists
-		labels     trello.Labels
+		cte          trello.CardToEdit
+		boardLists   trello.Lists
+		labels       trello.Labels
+		members      trello.Members
+		customFields trello.CustomFields
 	}
 	type expected struct {
 		hasError bool
@@ -161,11 +210,18 @@ closed = false
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "123"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels = ["red [name red]","black"]
+# available members (use username or ID):
+members = []
 desc = '''
 # card description
 
@@ -173,6 +229,8 @@ desc = '''
 
 foobar
 '''
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
 `,
 			},
 		},
@@ -202,11 +260,18 @@ closed = false
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "123"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels = ["red [name red]","black"]
+# available members (use username or ID):
+members = []
 desc = '''
 # card description
 
@@ -214,6 +279,8 @@ desc = '''
 
 foobar
 '''
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
 `,
 			},
 		},
@@ -250,8 +317,15 @@ closed = false
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "123"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 labels = []
+# available members (use username or ID):
+members = []
 desc = '''
 # card description
 
@@ -259,6 +333,127 @@ desc = '''
 
 foobar
 '''
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+`,
+			},
+		},
+		"card with checklists": {
+			given: given{
+				cte: trello.CardToEdit{
+					Name:   "card",
+					Desc:   "description",
+					IDList: "list 1",
+					Pos:    "123",
+					Labels: []string{},
+					Checklists: []trello.ChecklistToEdit{
+						{
+							ID:   "checklist 1",
+							Name: "Acceptance criteria",
+							Items: []trello.CheckItemToEdit{
+								{ID: "item 1", Name: "can't be \"quoted\" & <escaped>", Checked: true},
+								{ID: "item 2", Name: "second criterion", Checked: false},
+							},
+						},
+					},
+				},
+				boardLists: trello.Lists{},
+				labels:     trello.Labels{},
+			},
+			expected: expected{
+				hasError: false,
+				content: `name = "card"
+# whether the card should be archived (closed: true)
+closed = false
+# available lists:
+idList = "list 1"
+# the position of the card in its list: "top", "bottom" or a positive float
+pos = "123"
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
+labels = []
+# available members (use username or ID):
+members = []
+desc = '''
+description
+'''
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+[[checklists]]
+id = "checklist 1"
+name = "Acceptance criteria"
+[[checklists.items]]
+id = "item 1"
+name = "can't be \"quoted\" & <escaped>"
+checked = true
+[[checklists.items]]
+id = "item 2"
+name = "second criterion"
+checked = false
+`,
+			},
+		},
+		"card with custom fields": {
+			given: given{
+				cte: trello.CardToEdit{
+					Name:   "card",
+					Desc:   "description",
+					IDList: "list 1",
+					Pos:    "123",
+					Labels: []string{},
+					CustomFields: map[string]string{
+						"Priority": "High",
+						"Points":   "3",
+					},
+				},
+				boardLists: trello.Lists{},
+				labels:     trello.Labels{},
+				customFields: trello.CustomFields{
+					{ID: "field 2", Name: "Points", Type: trello.CustomFieldTypeNumber, Pos: 2},
+					{ID: "field 1", Name: "Priority", Type: trello.CustomFieldTypeList, Pos: 1, Options: trello.CustomFieldOptions{
+						{ID: "option 1", Value: trello.CustomFieldItemValue{Text: "High"}},
+						{ID: "option 2", Value: trello.CustomFieldItemValue{Text: "Low"}},
+					}},
+					{ID: "field 3", Name: "Reviewed", Type: trello.CustomFieldTypeCheckbox, Pos: 3},
+				},
+			},
+			expected: expected{
+				hasError: false,
+				content: `name = "card"
+# whether the card should be archived (closed: true)
+closed = false
+# available lists:
+idList = "list 1"
+# the position of the card in its list: "top", "bottom" or a positive float
+pos = "123"
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
+labels = []
+# available members (use username or ID):
+members = []
+desc = '''
+description
+'''
+# available custom fields ("" to clear a value, natural dates like the due date, true or false for the checkboxes):
+# field 1: Priority (list)
+#   option 1: High
+#   option 2: Low
+# field 2: Points (number)
+# field 3: Reviewed (checkbox)
+[customFields]
+"Priority" = "High"
+"Points" = "3"
+"Reviewed" = ""
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
 `,
 			},
 		},
@@ -266,7 +461,7 @@ foobar
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			e := EditInPrettyToml{}
-			actual, actualErr := e.MarshalCardToEdit(tt.given.cte, tt.given.boardLists, tt.given.labels)
+			actual, actualErr := e.MarshalCardToEdit(tt.given.cte, tt.given.boardLists, tt.given.labels, tt.given.members, tt.given.customFields)
 			if tt.expected.hasError != (actualErr != nil) {
 				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
 				t.FailNow()
@@ -304,11 +499,18 @@ closed = false
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "123"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels = ["red [name red]","black"]
+# available members (use username or ID):
+members = []
 desc = '''
 # card description
 
@@ -326,10 +528,11 @@ foobar
 
 foobar
 `,
-					Closed: false,
-					IDList: "list 1",
-					Pos:    "123",
-					Labels: []string{labels[0].ToTCliColor(), labels[2].ToTCliColor()},
+					Closed:  false,
+					IDList:  "list 1",
+					Pos:     "123",
+					Labels:  []string{labels[0].ToTCliColor(), labels[2].ToTCliColor()},
+					Members: []string{},
 				},
 				hasError: false,
 			},
@@ -342,11 +545,18 @@ closed = false
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "123"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels = ["red [name red]","black"]
+# available members (use username or ID):
+members = []
 desc = '''
 # card description
 
@@ -364,10 +574,11 @@ foobar
 
 foobar
 `,
-					Closed: false,
-					IDList: "list 1",
-					Pos:    "123",
-					Labels: []string{labels[0].ToTCliColor(), labels[2].ToTCliColor()},
+					Closed:  false,
+					IDList:  "list 1",
+					Pos:     "123",
+					Labels:  []string{labels[0].ToTCliColor(), labels[2].ToTCliColor()},
+					Members: []string{},
 				},
 				hasError: false,
 			},
@@ -383,8 +594,15 @@ closed = false
 idList = "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos = "123"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 labels = []
+# available members (use username or ID):
+members = []
 desc = '''
 # card description
 
@@ -402,10 +620,90 @@ foobar
 
 foobar
 `,
-					Closed: false,
-					IDList: "list 1",
-					Pos:    "123",
-					Labels: []string{},
+					Closed:  false,
+					IDList:  "list 1",
+					Pos:     "123",
+					Labels:  []string{},
+					Members: []string{},
+				},
+			},
+		},
+		"card with checklists": {
+			given: `name = "card"
+closed = false
+idList = "list 1"
+pos = "123"
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start = ""
+due = ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete = false
+labels = []
+# available members (use username or ID):
+members = []
+desc = '''
+description
+'''
+[[checklists]]
+id = "checklist 1"
+name = "Acceptance criteria"
+[[checklists.items]]
+id = "item 1"
+name = "can't be \"quoted\" & <escaped>"
+checked = true
+[[checklists.items]]
+name = "new criterion"
+checked = false
+[[checklists]]
+name = "new checklist"
+`,
+			expected: expected{
+				cte: trello.CardToEdit{
+					Name:    "card",
+					Desc:    "description\n",
+					IDList:  "list 1",
+					Pos:     "123",
+					Labels:  []string{},
+					Members: []string{},
+					Checklists: []trello.ChecklistToEdit{
+						{
+							ID:   "checklist 1",
+							Name: "Acceptance criteria",
+							Items: []trello.CheckItemToEdit{
+								{ID: "item 1", Name: "can't be \"quoted\" & <escaped>", Checked: true},
+								{Name: "new criterion", Checked: false},
+							},
+						},
+						{Name: "new checklist"},
+					},
+				},
+			},
+		},
+		"card with custom fields": {
+			given: `name = "card"
+idList = "list 1"
+pos = "123"
+labels = []
+members = []
+desc = '''
+description
+'''
+[customFields]
+"Priority" = "Low"
+"Points" = ""
+`,
+			expected: expected{
+				cte: trello.CardToEdit{
+					Name:    "card",
+					Desc:    "description\n",
+					IDList:  "list 1",
+					Pos:     "123",
+					Labels:  []string{},
+					Members: []string{},
+					CustomFields: map[string]string{
+						"Priority": "Low",
+						"Points":   "",
+					},
 				},
 			},
 		},
@@ -425,3 +723,131 @@ foobar
 		})
 	}
 }
+
+func TestEditInToml_MarshalBoardToEdit(t *testing.T) {
+	type expected struct {
+		hasError bool
+		content  string
+	}
+	var tests = map[string]struct {
+		given    trello.BoardToEdit
+		expected expected
+	}{
+		"board with long description": {
+			given: trello.BoardToEdit{
+				Name: "board",
+				Desc: `# board description
+
+foobar`,
+				Closed: true,
+			},
+			expected: expected{
+				hasError: false,
+				content: `name = "board"
+# whether the board should be closed (closed: true)
+closed = true
+desc = '''
+# board description
+
+foobar
+'''
+`,
+			},
+		},
+		"board with special characters": {
+			given: trello.BoardToEdit{
+				Name: `R&D's "board" <x>`,
+				Desc: `R&D <b>"desc"</b>`,
+			},
+			expected: expected{
+				hasError: false,
+				content: `name = "R&D's \"board\" <x>"
+# whether the board should be closed (closed: true)
+closed = false
+desc = '''
+R&D <b>"desc"</b>
+'''
+`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := EditInPrettyToml{}
+			actual, actualErr := e.MarshalBoardToEdit(tt.given)
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
+				t.FailNow()
+			}
+			if string(actual) != tt.expected.content {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected.content, string(actual))
+			}
+			// the multi-line description keeps the line break before its closing delimiter
+			var unmarshalled trello.BoardToEdit
+			if err := e.Unmarshal(actual, &unmarshalled); err != nil || unmarshalled.Name != tt.given.Name ||
+				strings.TrimSuffix(unmarshalled.Desc, "\n") != tt.given.Desc || unmarshalled.Closed != tt.given.Closed {
+				t.Errorf("expected %v, actual %v (err %v)", tt.given, unmarshalled, err)
+			}
+		})
+	}
+}
+
+func TestEditInToml_MarshalListToEdit(t *testing.T) {
+	type expected struct {
+		hasError bool
+		content  string
+	}
+	var tests = map[string]struct {
+		given    trello.ListToEdit
+		expected expected
+	}{
+		"list": {
+			given: trello.ListToEdit{
+				Name:   "list",
+				Closed: true,
+				Pos:    "top",
+			},
+			expected: expected{
+				hasError: false,
+				content: `name = "list"
+# whether the list should be archived (closed: true)
+closed = true
+# the position of the list in its board: "top", "bottom" or a positive float
+pos = "top"
+`,
+			},
+		},
+		"list with special characters": {
+			given: trello.ListToEdit{
+				Name: `R&D "x" <y>`,
+				Pos:  "bottom",
+			},
+			expected: expected{
+				hasError: false,
+				content: `name = "R&D \"x\" <y>"
+# whether the list should be archived (closed: true)
+closed = false
+# the position of the list in its board: "top", "bottom" or a positive float
+pos = "bottom"
+`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := EditInPrettyToml{}
+			actual, actualErr := e.MarshalListToEdit(tt.given)
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
+				t.FailNow()
+			}
+			if string(actual) != tt.expected.content {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected.content, string(actual))
+			}
+			var unmarshalled trello.ListToEdit
+			if err := e.Unmarshal(actual, &unmarshalled); err != nil || unmarshalled != tt.given {
+				t.Errorf("expected %v, actual %v (err %v)", tt.given, unmarshalled, err)
+			}
+		})
+	}
+}
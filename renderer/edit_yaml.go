@@ -14,11 +14,19 @@ func NewEditInYaml() Edit {
 	return EditInYaml{}
 }
 
-func (e EditInYaml) MarshalCardToEdit(cte trello.CardToEdit, _ trello.Lists, _ trello.Labels) ([]byte, error) {
+func (e EditInYaml) MarshalBoardToEdit(bte trello.BoardToEdit) ([]byte, error) {
+	return yaml.Marshal(bte)
+}
+
+func (e EditInYaml) MarshalListToEdit(lte trello.ListToEdit) ([]byte, error) {
+	return yaml.Marshal(lte)
+}
+
+func (e EditInYaml) MarshalCardToEdit(cte trello.CardToEdit, _ trello.Lists, _ trello.Labels, _ trello.Members, _ trello.CustomFields) ([]byte, error) {
 	return yaml.Marshal(cte)
 }
 
-func (e EditInYaml) MarshalCardToCreate(create trello.CardToCreate, _ trello.Lists, _ trello.Labels) ([]byte, error) {
+func (e EditInYaml) MarshalCardToCreate(create trello.CardToCreate, _ trello.Lists, _ trello.Labels, _ trello.Members) ([]byte, error) {
 	return yaml.Marshal(create)
 }
 
@@ -36,7 +44,63 @@ func NewEditInPrettyYaml() Edit {
 
 type EditInPrettyYaml struct{}
 
-func (e EditInPrettyYaml) MarshalCardToCreate(ctc trello.CardToCreate, lists trello.Lists, labels trello.Labels) ([]byte, error) {
+func (e EditInPrettyYaml) MarshalBoardToEdit(bte trello.BoardToEdit) ([]byte, error) {
+	t := `
+{{- /* ---------------- NAME ---------------- */ -}}
+name: {{ quote .Board.Name }}
+{{/* ---------------- CLOSED ---------------- */ -}}
+# whether the board should be closed (closed: true)
+closed: {{ .Board.Closed }}
+{{/* ---------------- DESCRIPTION ---------------- */ -}}
+desc: |-
+{{htmlSafe .BoardDescription}}`
+	tpl := template.Must(template.New("edit-board").Funcs(template.FuncMap{
+		"htmlSafe": func(html string) template.HTML {
+			return template.HTML(html)
+		},
+		"quote": quote,
+	}).Parse(t))
+	tplParams := struct {
+		Board            trello.BoardToEdit
+		BoardDescription string
+	}{
+		Board:            bte,
+		BoardDescription: e.transformDescription(bte.Desc),
+	}
+	w := bytes.NewBufferString("")
+	if err := tpl.Execute(w, tplParams); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+func (e EditInPrettyYaml) MarshalListToEdit(lte trello.ListToEdit) ([]byte, error) {
+	t := `
+{{- /* ---------------- NAME ---------------- */ -}}
+name: {{ quote .List.Name }}
+{{/* ---------------- CLOSED ---------------- */ -}}
+# whether the list should be archived (closed: true)
+closed: {{ .List.Closed }}
+{{/* ---------------- POSITION ---------------- */ -}}
+# the position of the list in its board: "top", "bottom" or a positive float
+pos: {{ .List.Pos }}
+`
+	tpl := template.Must(template.New("edit-list").Funcs(template.FuncMap{
+		"quote": quote,
+	}).Parse(t))
+	tplParams := struct {
+		List trello.ListToEdit
+	}{
+		List: lte,
+	}
+	w := bytes.NewBufferString("")
+	if err := tpl.Execute(w, tplParams); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+func (e EditInPrettyYaml) MarshalCardToCreate(ctc trello.CardToCreate, lists trello.Lists, labels trello.Labels, members trello.Members) ([]byte, error) {
 	t := `
 {{- /* ---------------- NAME ---------------- */ -}}
 name: "{{ .Card.Name }}"
@@ -51,8 +115,12 @@ idList: "{{ .Card.IDList }}"
 {{/* ---------------- POSITION ---------------- */ -}}
 # the position of the card in its list: "top", "bottom" or a positive float
 pos: "bottom"
+{{/* ---------------- DATES ---------------- */ -}}
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: "{{ .Card.Start }}"
+due: "{{ .Card.Due }}"
 {{/* ---------------- LABELS ---------------- */ -}}
-# available labels (use color or ID):
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 {{- if .Labels -}}
   {{ range $label := .Labels }}
 # {{ $label.ID }}: {{ $label.Color }}{{ if $label.Name }} [{{ $label.Name }}]{{ end }}
@@ -65,18 +133,33 @@ labels:
   {{- end }}
 {{- else }}
   - {{ end }}
+{{/* ---------------- MEMBERS ---------------- */ -}}
+# available members (use username or ID):
+{{- if .Members -}}
+{{ range $member := .Members }}
+# {{ $member.ID }}: {{ $member.Username }}{{ if $member.FullName }} [{{ $member.FullName }}]{{ end }}
+{{- end -}}
+{{ end }}
+members:
+{{- if .Card.Members -}}
+  {{ range $member := .Card.Members }}
+  - "{{ $member }}"
+  {{- end -}}
+{{ end }}
 {{/* ---------------- DESCRIPTION ---------------- */ -}}
 desc: |-
   `
 	tpl := template.Must(template.New("create-card").Parse(t))
 	tplParams := struct {
-		Card   trello.CardToCreate
-		Lists  trello.Lists
-		Labels trello.Labels
+		Card    trello.CardToCreate
+		Lists   trello.Lists
+		Labels  trello.Labels
+		Members trello.Members
 	}{
-		Card:   ctc,
-		Lists:  lists,
-		Labels: labels,
+		Card:    ctc,
+		Lists:   lists,
+		Labels:  labels,
+		Members: members,
 	}
 	w := bytes.NewBufferString("")
 	if err := tpl.Execute(w, tplParams); err != nil {
@@ -85,7 +168,7 @@ desc: |-
 	return w.Bytes(), nil
 }
 
-func (e EditInPrettyYaml) MarshalCardToEdit(cte trello.CardToEdit, lists trello.Lists, labels trello.Labels) ([]byte, error) {
+func (e EditInPrettyYaml) MarshalCardToEdit(cte trello.CardToEdit, lists trello.Lists, labels trello.Labels, members trello.Members, customFields trello.CustomFields) ([]byte, error) {
 	t := `
 {{- /* ---------------- NAME ---------------- */ -}}
 name: "{{ .Card.Name }}"
@@ -103,8 +186,14 @@ idList: "{{ .Card.IDList }}"
 {{/* ---------------- POSITION ---------------- */ -}}
 # the position of the card in its list: "top", "bottom" or a positive float
 pos: {{ .Card.Pos }}
+{{/* ---------------- DATES ---------------- */ -}}
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: "{{ .Card.Start }}"
+due: "{{ .Card.Due }}"
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: {{ .Card.DueComplete }}
 {{/* ---------------- LABELS ---------------- */ -}}
-# available labels (use color or ID):
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 {{- if .Labels -}}
 {{ range $label := .Labels }}
 # {{ $label.ID }}: {{ $label.Color }}{{ if $label.Name }} [{{ $label.Name }}]{{ end }}
@@ -116,6 +205,47 @@ labels:
   - "{{$label}}"
   {{- end -}}
 {{ end }}
+{{/* ---------------- MEMBERS ---------------- */ -}}
+# available members (use username or ID):
+{{- if .Members -}}
+{{ range $member := .Members }}
+# {{ $member.ID }}: {{ $member.Username }}{{ if $member.FullName }} [{{ $member.FullName }}]{{ end }}
+{{- end -}}
+{{ end }}
+members:
+{{- if .Card.Members -}}
+  {{ range $member := .Card.Members }}
+  - "{{ $member }}"
+  {{- end -}}
+{{ end }}
+{{/* ---------------- CUSTOM FIELDS ---------------- */ -}}
+{{- if .CustomFields -}}
+# available custom fields ("" to clear a value, natural dates like the due date, true or false for the checkboxes):
+{{- range $customField := .CustomFields }}
+# {{ $customField.ID }}: {{ $customField.Name }} ({{ $customField.Type }})
+  {{- range $option := $customField.Options }}
+#   {{ $option.ID }}: {{ $option.Value.Text }}
+  {{- end }}
+{{- end }}
+customFields:
+{{- range $customField := .CustomFields }}
+  {{ quote $customField.Name }}: {{ quote (index $.Card.CustomFields $customField.Name) }}
+{{- end }}
+{{ end -}}
+{{/* ---------------- CHECKLISTS ---------------- */ -}}
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
+{{- range $checklist := .Card.Checklists }}
+  - id: {{ quote $checklist.ID }}
+    name: {{ quote $checklist.Name }}
+    items:
+  {{- range $item := $checklist.Items }}
+      - id: {{ quote $item.ID }}
+        name: {{ quote $item.Name }}
+        checked: {{ $item.Checked }}
+  {{- end }}
+{{- end }}
 {{/* ---------------- DESCRIPTION ---------------- */ -}}
 desc: |-
 {{htmlSafe .CardDescription}}`
@@ -123,17 +253,22 @@ desc: |-
 		"htmlSafe": func(html string) template.HTML {
 			return template.HTML(html)
 		},
+		"quote": quote,
 	}).Parse(t))
 	tplParams := struct {
 		Card            trello.CardToEdit
 		Lists           trello.Lists
 		Labels          trello.Labels
+		Members         trello.Members
+		CustomFields    trello.CustomFields
 		CardDescription string
 	}{
 		Card:            cte,
 		Lists:           lists,
 		CardDescription: e.transformDescription(cte.Desc),
 		Labels:          labels,
+		Members:         members,
+		CustomFields:    customFields.SortedByPos(),
 	}
 	w := bytes.NewBufferString("")
 	if err := tpl.Execute(w, tplParams); err != nil {
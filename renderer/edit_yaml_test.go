@@ -10,6 +10,7 @@ func TestEditInPrettyYaml_MarshalCardToCreate(t *testing.T) {
 		ctc        trello.CardToCreate
 		boardLists trello.Lists
 		labels     trello.Labels
+		members    trello.Members
 	}
 	type expected struct {
 		hasError bool
@@ -46,12 +47,17 @@ func TestEditInPrettyYaml_MarshalCardToCreate(t *testing.T) {
 idList: "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos: "bottom"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels:
   - 
+# available members (use username or ID):
+members:
 desc: |-
   `,
 			},
@@ -84,13 +90,18 @@ desc: |-
 idList: "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos: "bottom"
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels:
   - "red"
   - "black"
+# available members (use username or ID):
+members:
 desc: |-
   `,
 			},
@@ -99,7 +110,7 @@ desc: |-
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			e := EditInPrettyYaml{}
-			actual, actualErr := e.MarshalCardToCreate(tt.given.ctc, tt.given.boardLists, tt.given.labels)
+			actual, actualErr := e.MarshalCardToCreate(tt.given.ctc, tt.given.boardLists, tt.given.labels, tt.given.members)
 			if tt.expected.hasError != (actualErr != nil) {
 				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
 				t.FailNow()
@@ -113,9 +124,11 @@ desc: |-
 
 func TestEditInYaml_RenderCardToEdit(t *testing.T) {
 	type given struct {
-		cte        trello.CardToEdit
-		boardLists trello.Lists
-		labels     trello.Labels
+		cte          trello.CardToEdit
+		boardLists   trello.Lists
+		labels       trello.Labels
+		members      trello.Members
+		customFields trello.CustomFields
 	}
 	type expected struct {
 		hasError bool
@@ -163,13 +176,23 @@ closed: false
 idList: "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos: 123
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels:
   - "red [name red]"
   - "black"
+# available members (use username or ID):
+members:
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
 desc: |-
   # card description
 
@@ -205,13 +228,23 @@ closed: false
 idList: "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos: 123
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 # id red: red [name red]
 # id sky: sky [name sky]
 # id black: black
 labels:
   - "red [name red]"
   - "black"
+# available members (use username or ID):
+members:
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
 desc: |-
   # card description
 
@@ -254,14 +287,356 @@ closed: false
 idList: "list 1"
 # the position of the card in its list: "top", "bottom" or a positive float
 pos: 123
-# available labels (use color or ID):
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
 labels:
+# available members (use username or ID):
+members:
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
 desc: |-
   # card description
 
   > some context
 
   foobar
+`,
+			},
+		},
+		"card with members": {
+			given: given{
+				cte: trello.CardToEdit{
+					Name:    "card",
+					Desc:    "description",
+					IDList:  "list 1",
+					Pos:     "123",
+					Labels:  []string{},
+					Members: []string{"member1"},
+				},
+				boardLists: trello.Lists{},
+				labels:     trello.Labels{},
+				members: trello.Members{
+					{ID: "member 1", Username: "member1", FullName: "Member 1"},
+					{ID: "member 2", Username: "member2"},
+				},
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "card"
+# whether the card should be archived (closed: true)
+closed: false
+# available lists:
+idList: "list 1"
+# the position of the card in its list: "top", "bottom" or a positive float
+pos: 123
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
+labels:
+# available members (use username or ID):
+# member 1: member1 [Member 1]
+# member 2: member2
+members:
+  - "member1"
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
+desc: |-
+  description
+`,
+			},
+		},
+		"card with dates": {
+			given: given{
+				cte: trello.CardToEdit{
+					Name:        "card",
+					Desc:        "description",
+					IDList:      "list 1",
+					Pos:         "123",
+					Labels:      []string{},
+					Due:         "2026-11-01 17:00",
+					DueComplete: true,
+					Start:       "2026-10-18 12:00",
+				},
+				boardLists: trello.Lists{},
+				labels:     trello.Labels{},
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "card"
+# whether the card should be archived (closed: true)
+closed: false
+# available lists:
+idList: "list 1"
+# the position of the card in its list: "top", "bottom" or a positive float
+pos: 123
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: "2026-10-18 12:00"
+due: "2026-11-01 17:00"
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: true
+# available labels (use color, name or ID, a new "color [name]" label can be created):
+labels:
+# available members (use username or ID):
+members:
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
+desc: |-
+  description
+`,
+			},
+		},
+		"card with checklists": {
+			given: given{
+				cte: trello.CardToEdit{
+					Name:   "card",
+					Desc:   "description",
+					IDList: "list 1",
+					Pos:    "123",
+					Labels: []string{},
+					Checklists: []trello.ChecklistToEdit{
+						{
+							ID:   "checklist 1",
+							Name: "Acceptance criteria",
+							Items: []trello.CheckItemToEdit{
+								{ID: "item 1", Name: "can't be \"quoted\" & <escaped>", Checked: true},
+								{ID: "item 2", Name: "second criterion", Checked: false},
+							},
+						},
+						{
+							ID:    "checklist 2",
+							Name:  "empty checklist",
+							Items: []trello.CheckItemToEdit{},
+						},
+					},
+				},
+				boardLists: trello.Lists{},
+				labels:     trello.Labels{},
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "card"
+# whether the card should be archived (closed: true)
+closed: false
+# available lists:
+idList: "list 1"
+# the position of the card in its list: "top", "bottom" or a positive float
+pos: 123
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
+labels:
+# available members (use username or ID):
+members:
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
+  - id: "checklist 1"
+    name: "Acceptance criteria"
+    items:
+      - id: "item 1"
+        name: "can't be \"quoted\" & <escaped>"
+        checked: true
+      - id: "item 2"
+        name: "second criterion"
+        checked: false
+  - id: "checklist 2"
+    name: "empty checklist"
+    items:
+desc: |-
+  description
+`,
+			},
+		},
+		"card with custom fields": {
+			given: given{
+				cte: trello.CardToEdit{
+					Name:   "card",
+					Desc:   "description",
+					IDList: "list 1",
+					Pos:    "123",
+					Labels: []string{},
+					CustomFields: map[string]string{
+						"Priority": "High",
+						"Points":   "3",
+					},
+				},
+				boardLists: trello.Lists{},
+				labels:     trello.Labels{},
+				customFields: trello.CustomFields{
+					{ID: "field 2", Name: "Points", Type: trello.CustomFieldTypeNumber, Pos: 2},
+					{ID: "field 1", Name: "Priority", Type: trello.CustomFieldTypeList, Pos: 1, Options: trello.CustomFieldOptions{
+						{ID: "option 1", Value: trello.CustomFieldItemValue{Text: "High"}},
+						{ID: "option 2", Value: trello.CustomFieldItemValue{Text: "Low"}},
+					}},
+					{ID: "field 3", Name: "Reviewed", Type: trello.CustomFieldTypeCheckbox, Pos: 3},
+				},
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "card"
+# whether the card should be archived (closed: true)
+closed: false
+# available lists:
+idList: "list 1"
+# the position of the card in its list: "top", "bottom" or a positive float
+pos: 123
+# the dates of the card: "2026-11-01 17:00", "2026-11-01", "today", "tomorrow 17:00", "friday", "in 3 days" or "" to remove it
+start: ""
+due: ""
+# whether the due date is marked as complete (dueComplete: true)
+dueComplete: false
+# available labels (use color, name or ID, a new "color [name]" label can be created):
+labels:
+# available members (use username or ID):
+members:
+# available custom fields ("" to clear a value, natural dates like the due date, true or false for the checkboxes):
+# field 1: Priority (list)
+#   option 1: High
+#   option 2: Low
+# field 2: Points (number)
+# field 3: Reviewed (checkbox)
+customFields:
+  "Priority": "High"
+  "Points": "3"
+  "Reviewed": ""
+# check or uncheck the checklist items, add a checklist or an item without id to create it,
+# remove a checklist or an item to delete it
+checklists:
+desc: |-
+  description
+`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := EditInPrettyYaml{}
+			actual, actualErr := e.MarshalCardToEdit(tt.given.cte, tt.given.boardLists, tt.given.labels, tt.given.members, tt.given.customFields)
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
+				t.FailNow()
+			}
+			if string(actual) != tt.expected.content {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected.content, string(actual))
+			}
+		})
+	}
+}
+
+func TestEditInPrettyYaml_MarshalBoardToEdit(t *testing.T) {
+	type expected struct {
+		hasError bool
+		content  string
+	}
+	var tests = map[string]struct {
+		given    trello.BoardToEdit
+		expected expected
+	}{
+		"board with long description": {
+			given: trello.BoardToEdit{
+				Name: "board",
+				Desc: `# board description
+
+foobar`,
+				Closed: false,
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "board"
+# whether the board should be closed (closed: true)
+closed: false
+desc: |-
+  # board description
+
+  foobar
+`,
+			},
+		},
+		"board with special characters": {
+			given: trello.BoardToEdit{
+				Name: `R&D's "board" <x>`,
+				Desc: `R&D <b>"desc"</b>`,
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "R&D's \"board\" <x>"
+# whether the board should be closed (closed: true)
+closed: false
+desc: |-
+  R&D <b>"desc"</b>
+`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := EditInPrettyYaml{}
+			actual, actualErr := e.MarshalBoardToEdit(tt.given)
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
+				t.FailNow()
+			}
+			if string(actual) != tt.expected.content {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected.content, string(actual))
+			}
+			var unmarshalled trello.BoardToEdit
+			if err := e.Unmarshal(actual, &unmarshalled); err != nil || unmarshalled != tt.given {
+				t.Errorf("expected %v, actual %v (err %v)", tt.given, unmarshalled, err)
+			}
+		})
+	}
+}
+
+func TestEditInPrettyYaml_MarshalListToEdit(t *testing.T) {
+	type expected struct {
+		hasError bool
+		content  string
+	}
+	var tests = map[string]struct {
+		given    trello.ListToEdit
+		expected expected
+	}{
+		"list": {
+			given: trello.ListToEdit{
+				Name:   "list",
+				Closed: false,
+				Pos:    "16384.00",
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "list"
+# whether the list should be archived (closed: true)
+closed: false
+# the position of the list in its board: "top", "bottom" or a positive float
+pos: 16384.00
+`,
+			},
+		},
+		"list with special characters": {
+			given: trello.ListToEdit{
+				Name: `R&D "x" <y>`,
+				Pos:  "top",
+			},
+			expected: expected{
+				hasError: false,
+				content: `name: "R&D \"x\" <y>"
+# whether the list should be archived (closed: true)
+closed: false
+# the position of the list in its board: "top", "bottom" or a positive float
+pos: top
 `,
 			},
 		},
@@ -269,7 +644,7 @@ desc: |-
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			e := EditInPrettyYaml{}
-			actual, actualErr := e.MarshalCardToEdit(tt.given.cte, tt.given.boardLists, tt.given.labels)
+			actual, actualErr := e.MarshalListToEdit(tt.given)
 			if tt.expected.hasError != (actualErr != nil) {
 				t.Errorf("expected error %v, actual error %v", tt.expected.hasError, actualErr)
 				t.FailNow()
@@ -277,6 +652,10 @@ desc: |-
 			if string(actual) != tt.expected.content {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected.content, string(actual))
 			}
+			var unmarshalled trello.ListToEdit
+			if err := e.Unmarshal(actual, &unmarshalled); err != nil || unmarshalled != tt.given {
+				t.Errorf("expected %v, actual %v (err %v)", tt.given, unmarshalled, err)
+			}
 		})
 	}
 }
@@ -0,0 +1,195 @@
+package renderer
+
+import (
+	"encoding/json"
+	"github.com/l-lin/tcli/trello"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+// InJSON renders the Trello entities in JSON, so they can be easily parsed by other tools, like jq
+type InJSON struct {
+	prefix, indent string
+}
+
+func NewInJSONRenderer() Renderer {
+	return InJSON{
+		prefix: "",
+		indent: "  ",
+	}
+}
+
+func (j InJSON) RenderBoards(boards trello.Boards) string {
+	if boards == nil {
+		boards = trello.Boards{}
+	}
+	return j.render(boards)
+}
+
+func (j InJSON) RenderBoard(board trello.Board) string {
+	return j.render(board)
+}
+
+func (j InJSON) RenderLabels(labels trello.Labels) string {
+	if labels == nil {
+		labels = trello.Labels{}
+	}
+	return j.render(labels)
+}
+
+func (j InJSON) RenderLists(lists trello.Lists) string {
+	if lists == nil {
+		lists = trello.Lists{}
+	}
+	return j.render(lists)
+}
+
+func (j InJSON) RenderList(list trello.List) string {
+	return j.render(list)
+}
+
+func (j InJSON) RenderCards(cards trello.Cards) string {
+	if cards == nil {
+		cards = trello.Cards{}
+	}
+	return j.render(cards.SortedByPos())
+}
+
+func (j InJSON) RenderCard(card trello.Card) string {
+	return j.render(card)
+}
+
+func (j InJSON) RenderComments(comments trello.Comments) string {
+	if comments == nil {
+		comments = trello.Comments{}
+	}
+	return j.render(comments.SortedByDateDesc())
+}
+
+func (j InJSON) RenderComment(comment trello.Comment) string {
+	return j.render(comment)
+}
+
+func (j InJSON) RenderJournal(entries trello.JournalEntries) string {
+	if entries == nil {
+		entries = trello.JournalEntries{}
+	}
+	return j.render(entries)
+}
+
+func (j InJSON) RenderActions(actions trello.Actions) string {
+	if actions == nil {
+		actions = trello.Actions{}
+	}
+	return j.render(actions)
+}
+
+func (j InJSON) render(v interface{}) string {
+	b, err := json.MarshalIndent(v, j.prefix, j.indent)
+	if err != nil {
+		log.Debug().
+			Err(err).
+			Msg("could not render in json")
+		return ""
+	}
+	return string(b)
+}
+
+// InNDJSON renders the Trello entities in newline delimited JSON (http://ndjson.org/),
+// i.e. one JSON document per line
+type InNDJSON struct{}
+
+func NewInNDJSONRenderer() Renderer {
+	return InNDJSON{}
+}
+
+func (n InNDJSON) RenderBoards(boards trello.Boards) string {
+	lines := make([]interface{}, len(boards))
+	for i, board := range boards {
+		lines[i] = board
+	}
+	return n.renderLines(lines)
+}
+
+func (n InNDJSON) RenderBoard(board trello.Board) string {
+	return n.render(board)
+}
+
+func (n InNDJSON) RenderLabels(labels trello.Labels) string {
+	lines := make([]interface{}, len(labels))
+	for i, label := range labels {
+		lines[i] = label
+	}
+	return n.renderLines(lines)
+}
+
+func (n InNDJSON) RenderLists(lists trello.Lists) string {
+	lines := make([]interface{}, len(lists))
+	for i, list := range lists {
+		lines[i] = list
+	}
+	return n.renderLines(lines)
+}
+
+func (n InNDJSON) RenderList(list trello.List) string {
+	return n.render(list)
+}
+
+func (n InNDJSON) RenderCards(cards trello.Cards) string {
+	lines := make([]interface{}, len(cards))
+	for i, card := range cards.SortedByPos() {
+		lines[i] = card
+	}
+	return n.renderLines(lines)
+}
+
+func (n InNDJSON) RenderCard(card trello.Card) string {
+	return n.render(card)
+}
+
+func (n InNDJSON) RenderComments(comments trello.Comments) string {
+	lines := make([]interface{}, len(comments))
+	for i, comment := range comments.SortedByDateDesc() {
+		lines[i] = comment
+	}
+	return n.renderLines(lines)
+}
+
+func (n InNDJSON) RenderComment(comment trello.Comment) string {
+	return n.render(comment)
+}
+
+func (n InNDJSON) RenderJournal(entries trello.JournalEntries) string {
+	lines := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		lines[i] = entry
+	}
+	return n.renderLines(lines)
+}
+
+func (n InNDJSON) RenderActions(actions trello.Actions) string {
+	lines := make([]interface{}, len(actions))
+	for i, action := range actions {
+		lines[i] = action
+	}
+	return n.renderLines(lines)
+}
+
+func (n InNDJSON) renderLines(lines []interface{}) string {
+	renderedLines := make([]string, len(lines))
+	for i, line := range lines {
+		renderedLines[i] = n.render(line)
+	}
+	return strings.Join(renderedLines, "\n")
+}
+
+func (n InNDJSON) render(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Debug().
+			Err(err).
+			Msg("could not render in ndjson")
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,202 @@
+package renderer
+
+import (
+	"github.com/l-lin/tcli/trello"
+	"testing"
+)
+
+func TestInJSON_RenderBoards(t *testing.T) {
+	var tests = map[string]struct {
+		given    trello.Boards
+		expected string
+	}{
+		"two boards": {
+			given: trello.Boards{
+				{ID: "1", Name: "Board 1", ShortLink: "abc"},
+				{ID: "2", Name: "Board 2", Closed: true},
+			},
+			expected: `[
+  {
+    "id": "1",
+    "name": "Board 1",
+    "desc": "",
+    "closed": false,
+    "shortLink": "abc",
+    "shortUrl": "",
+    "dateLastActivity": ""
+  },
+  {
+    "id": "2",
+    "name": "Board 2",
+    "desc": "",
+    "closed": true,
+    "shortLink": "",
+    "shortUrl": "",
+    "dateLastActivity": ""
+  }
+]`,
+		},
+		"no board": {
+			given:    nil,
+			expected: `[]`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewInJSONRenderer()
+			actual := r.RenderBoards(tt.given)
+			if actual != tt.expected {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInJSON_RenderList(t *testing.T) {
+	r := NewInJSONRenderer()
+	actual := r.RenderList(trello.List{ID: "1", Name: "List 1", IDBoard: "board 1", Pos: 1024})
+	expected := `{
+  "id": "1",
+  "name": "List 1",
+  "idBoard": "board 1",
+  "closed": false,
+  "pos": 1024
+}`
+	if actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
+func TestInJSON_RenderCards(t *testing.T) {
+	r := NewInJSONRenderer()
+	actual := r.RenderCards(trello.Cards{
+		{ID: "2", Name: "Card 2", Pos: 2},
+		{ID: "1", Name: "Card 1", Pos: 1, Labels: trello.Labels{{ID: "label 1", Color: "red"}}},
+	})
+	expected := `[
+  {
+    "id": "1",
+    "name": "Card 1",
+    "desc": "",
+    "idBoard": "",
+    "idList": "",
+    "closed": false,
+    "shortLink": "",
+    "shortUrl": "",
+    "pos": 1,
+    "dueComplete": false,
+    "labels": [
+      {
+        "id": "label 1",
+        "idBoard": "",
+        "name": "",
+        "color": "red"
+      }
+    ]
+  },
+  {
+    "id": "2",
+    "name": "Card 2",
+    "desc": "",
+    "idBoard": "",
+    "idList": "",
+    "closed": false,
+    "shortLink": "",
+    "shortUrl": "",
+    "pos": 2,
+    "dueComplete": false,
+    "labels": null
+  }
+]`
+	if actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
+func TestInNDJSON_RenderLabels(t *testing.T) {
+	r := NewInNDJSONRenderer()
+	actual := r.RenderLabels(trello.Labels{
+		{ID: "label 1", IDBoard: "board 1", Color: "red", Name: "bug"},
+		{ID: "label 2", IDBoard: "board 1", Color: "green"},
+	})
+	expected := `{"id":"label 1","idBoard":"board 1","name":"bug","color":"red"}
+{"id":"label 2","idBoard":"board 1","name":"","color":"green"}`
+	if actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
+func TestInNDJSON_RenderLists(t *testing.T) {
+	var tests = map[string]struct {
+		given    trello.Lists
+		expected string
+	}{
+		"two lists": {
+			given: trello.Lists{
+				{ID: "1", Name: "List 1"},
+				{ID: "2", Name: "List 2"},
+			},
+			expected: `{"id":"1","name":"List 1","idBoard":"","closed":false,"pos":0}
+{"id":"2","name":"List 2","idBoard":"","closed":false,"pos":0}`,
+		},
+		"no list": {
+			given:    trello.Lists{},
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewInNDJSONRenderer()
+			actual := r.RenderLists(tt.given)
+			if actual != tt.expected {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInNDJSON_RenderComments(t *testing.T) {
+	r := NewInNDJSONRenderer()
+	actual := r.RenderComments(trello.Comments{
+		{ID: "1", Date: "2021-02-04T14:19:25.229Z", Data: trello.CommentData{Text: "first"}},
+		{ID: "2", Date: "2021-02-08T21:02:58.117Z", Data: trello.CommentData{Text: "second"}},
+	})
+	expected := `{"id":"2","date":"2021-02-08T21:02:58.117Z","data":{"card":{"id":"","name":"","shortLink":""},"text":"second"},"memberCreator":{"id":"","fullName":"","initials":"","username":""}}
+{"id":"1","date":"2021-02-04T14:19:25.229Z","data":{"card":{"id":"","name":"","shortLink":""},"text":"first"},"memberCreator":{"id":"","fullName":"","initials":"","username":""}}`
+	if actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
+func TestInNDJSON_RenderActions(t *testing.T) {
+	r := NewInNDJSONRenderer()
+	actual := r.RenderActions(trello.Actions{
+		{ID: "1", Type: "createCard", Date: "2021-02-04T14:19:25.229Z", Data: trello.ActionData{Card: &trello.ActionCard{ID: "card 1", Name: "card"}}},
+		{ID: "2", Type: "commentCard", Date: "2021-02-03T14:19:25.229Z", Data: trello.ActionData{Text: "comment"}},
+	})
+	expected := `{"id":"1","type":"createCard","date":"2021-02-04T14:19:25.229Z","data":{"card":{"id":"card 1","name":"card","idShort":0,"closed":false}},"memberCreator":{"id":"","fullName":"","username":""}}
+{"id":"2","type":"commentCard","date":"2021-02-03T14:19:25.229Z","data":{"text":"comment"},"memberCreator":{"id":"","fullName":"","username":""}}`
+	if actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
+func TestNew(t *testing.T) {
+	var tests = map[string]struct {
+		given    string
+		expected Renderer
+	}{
+		"json":    {given: "json", expected: InJSON{prefix: "", indent: "  "}},
+		"ndjson":  {given: "ndjson", expected: InNDJSON{}},
+		"table":   {given: "table", expected: NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})},
+		"default": {given: "", expected: NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := New(tt.given, PlainLabel{}, PlainDescription{}, PlainOverdue{})
+			if actual != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
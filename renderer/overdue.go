@@ -0,0 +1,22 @@
+package renderer
+
+import "github.com/muesli/termenv"
+
+var overdueColor = termenv.ANSIBrightRed
+
+// Overdue rendering the lines of the overdue cards
+type Overdue interface {
+	Render(line string) string
+}
+
+type PlainOverdue struct{}
+
+func (p PlainOverdue) Render(line string) string {
+	return line
+}
+
+type TermEnvOverdue struct{}
+
+func (t TermEnvOverdue) Render(line string) string {
+	return termenv.String(line).Foreground(overdueColor).String()
+}
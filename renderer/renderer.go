@@ -9,10 +9,24 @@ import (
 type Renderer interface {
 	RenderBoards(trello.Boards) string
 	RenderBoard(trello.Board) string
+	RenderLabels(trello.Labels) string
 	RenderLists(trello.Lists) string
 	RenderList(trello.List) string
 	RenderCards(trello.Cards) string
 	RenderCard(trello.Card) string
 	RenderComments(trello.Comments) string
 	RenderComment(trello.Comment) string
+	RenderJournal(trello.JournalEntries) string
+	RenderActions(trello.Actions) string
+}
+
+// New creates the Renderer matching the given output: "json", "ndjson" or "table" (default)
+func New(output string, lr Labels, cdr Description, or Overdue) Renderer {
+	switch output {
+	case "json":
+		return NewInJSONRenderer()
+	case "ndjson":
+		return NewInNDJSONRenderer()
+	}
+	return NewInTableRenderer(lr, cdr, or)
 }
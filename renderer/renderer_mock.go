@@ -34,6 +34,20 @@ func (m *MockRenderer) EXPECT() *MockRendererMockRecorder {
 	return m.recorder
 }
 
+// RenderActions mocks base method.
+func (m *MockRenderer) RenderActions(arg0 trello.Actions) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderActions", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RenderActions indicates an expected call of RenderActions.
+func (mr *MockRendererMockRecorder) RenderActions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderActions", reflect.TypeOf((*MockRenderer)(nil).RenderActions), arg0)
+}
+
 // RenderBoard mocks base method.
 func (m *MockRenderer) RenderBoard(arg0 trello.Board) string {
 	m.ctrl.T.Helper()
@@ -118,6 +132,34 @@ func (mr *MockRendererMockRecorder) RenderComments(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderComments", reflect.TypeOf((*MockRenderer)(nil).RenderComments), arg0)
 }
 
+// RenderJournal mocks base method.
+func (m *MockRenderer) RenderJournal(arg0 trello.JournalEntries) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderJournal", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RenderJournal indicates an expected call of RenderJournal.
+func (mr *MockRendererMockRecorder) RenderJournal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderJournal", reflect.TypeOf((*MockRenderer)(nil).RenderJournal), arg0)
+}
+
+// RenderLabels mocks base method.
+func (m *MockRenderer) RenderLabels(arg0 trello.Labels) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderLabels", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RenderLabels indicates an expected call of RenderLabels.
+func (mr *MockRendererMockRecorder) RenderLabels(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderLabels", reflect.TypeOf((*MockRenderer)(nil).RenderLabels), arg0)
+}
+
 // RenderList mocks base method.
 func (m *MockRenderer) RenderList(arg0 trello.List) string {
 	m.ctrl.T.Helper()
@@ -0,0 +1,105 @@
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"github.com/yuin/goldmark"
+	"strings"
+)
+
+// maximum number of characters of the comment excerpts
+const commentExcerptLength = 100
+
+// Report of the lists and cards of a board, to paste in status emails
+type Report struct {
+	Board trello.Board
+	Lists []ReportList
+}
+
+type ReportList struct {
+	trello.List
+	Cards []ReportCard
+}
+
+type ReportCard struct {
+	trello.Card
+	// excerpts of the most recent comments, empty if the comments are not reported
+	Comments trello.Comments
+}
+
+// Markdown renders the lists as headings and the cards as bullets with their labels and links
+func (r Report) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", escapeMarkdown(r.Board.Name))
+	for _, list := range r.Lists {
+		fmt.Fprintf(&b, "\n## %s\n\n", escapeMarkdown(list.Name))
+		if len(list.Cards) == 0 {
+			b.WriteString("_no card_\n")
+			continue
+		}
+		for _, card := range list.Cards {
+			b.WriteString(markdownCard(card))
+		}
+	}
+	return b.String()
+}
+
+// HTML renders the Markdown report in HTML
+func (r Report) HTML() (string, error) {
+	var buf bytes.Buffer
+	if err := goldmark.Convert([]byte(r.Markdown()), &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func markdownCard(card ReportCard) string {
+	var b strings.Builder
+	name := escapeMarkdown(card.Name)
+	if card.ShortURL != "" {
+		fmt.Fprintf(&b, "- [%s](%s)", name, card.ShortURL)
+	} else {
+		fmt.Fprintf(&b, "- %s", name)
+	}
+	for _, label := range card.Labels {
+		text := label.Name
+		if text == "" {
+			text = label.Color
+		}
+		fmt.Fprintf(&b, " `%s`", strings.ReplaceAll(text, "`", "'"))
+	}
+	b.WriteString("\n")
+	for _, comment := range card.Comments {
+		fmt.Fprintf(&b, "  > **%s**: %s\n", escapeMarkdown(comment.MemberCreator.FullName), escapeMarkdown(excerpt(comment.Data.Text)))
+	}
+	return b.String()
+}
+
+// excerpt returns the first line of the text, truncated if it is too long
+func excerpt(text string) string {
+	text = strings.TrimSpace(text)
+	if i := strings.IndexByte(text, '\n'); i != -1 {
+		text = strings.TrimSpace(text[:i]) + "…"
+	}
+	if runes := []rune(text); len(runes) > commentExcerptLength {
+		text = string(runes[:commentExcerptLength]) + "…"
+	}
+	return text
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+	"#", `\#`,
+)
+
+// escapeMarkdown escapes the characters that would be interpreted as Markdown
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,88 @@
+package renderer
+
+import (
+	"github.com/l-lin/tcli/trello"
+	"strings"
+	"testing"
+)
+
+func TestReport_Markdown(t *testing.T) {
+	report := Report{
+		Board: trello.Board{Name: "board"},
+		Lists: []ReportList{
+			{
+				List: trello.List{Name: "doing"},
+				Cards: []ReportCard{
+					{
+						Card: trello.Card{
+							Name:     "fix *the* bug",
+							ShortURL: "https://trello.com/c/abc",
+							Labels:   trello.Labels{{Name: "bug", Color: "red"}, {Color: "green"}},
+						},
+						Comments: trello.Comments{
+							{Data: trello.CommentData{Text: "first line\nsecond line"}, MemberCreator: trello.CommentMemberCreator{FullName: "Member"}},
+						},
+					},
+					{Card: trello.Card{Name: "card without link"}},
+				},
+			},
+			{List: trello.List{Name: "done"}},
+		},
+	}
+	expected := "# board\n" +
+		"\n## doing\n\n" +
+		"- [fix \\*the\\* bug](https://trello.com/c/abc) `bug` `green`\n" +
+		"  > **Member**: first line…\n" +
+		"- card without link\n" +
+		"\n## done\n\n" +
+		"_no card_\n"
+	if actual := report.Markdown(); actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
+func TestReport_HTML(t *testing.T) {
+	report := Report{
+		Board: trello.Board{Name: "board"},
+		Lists: []ReportList{{
+			List:  trello.List{Name: "doing"},
+			Cards: []ReportCard{{Card: trello.Card{Name: "card", ShortURL: "https://trello.com/c/abc"}}},
+		}},
+	}
+	actual, err := report.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"<h1>board</h1>", "<h2>doing</h2>", `<li><a href="https://trello.com/c/abc">card</a></li>`} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("expected %v to contain %v", actual, expected)
+		}
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	var tests = map[string]struct {
+		given    string
+		expected string
+	}{
+		"short text": {
+			given:    " some text ",
+			expected: "some text",
+		},
+		"several lines": {
+			given:    "first line\nsecond line",
+			expected: "first line…",
+		},
+		"long text": {
+			given:    strings.Repeat("a", commentExcerptLength+1),
+			expected: strings.Repeat("a", commentExcerptLength) + "…",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := excerpt(tt.given); actual != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
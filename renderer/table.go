@@ -6,21 +6,25 @@ import (
 	"github.com/cheynewallace/tabby"
 	"github.com/l-lin/tcli/trello"
 	"github.com/rs/zerolog/log"
+	"strings"
 	"text/tabwriter"
+	"time"
 )
 
 type InTable struct {
 	lr                          Labels
 	cdr                         Description
+	or                          Overdue
 	minWidth, tabWidth, padding int
 	padChar                     byte
 	flags                       uint
 }
 
-func NewInTableRenderer(lr Labels, cdr Description) Renderer {
+func NewInTableRenderer(lr Labels, cdr Description, or Overdue) Renderer {
 	return InTable{
 		lr:       lr,
 		cdr:      cdr,
+		or:       or,
 		minWidth: 0,
 		tabWidth: 0,
 		padding:  4,
@@ -57,6 +61,18 @@ func (b InTable) RenderBoard(board trello.Board) string {
 	return buffer.String()
 }
 
+func (b InTable) RenderLabels(labels trello.Labels) string {
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, b.minWidth, b.tabWidth, b.padding, b.padChar, b.flags)
+	t := tabby.NewCustom(w)
+	t.AddHeader("ID", "Color", "Name")
+	for _, label := range labels {
+		t.AddLine(label.ID, label.Color, label.Name)
+	}
+	t.Print()
+	return buffer.String()
+}
+
 func (b InTable) RenderLists(lists trello.Lists) string {
 	var buffer bytes.Buffer
 	w := tabwriter.NewWriter(&buffer, b.minWidth, b.tabWidth, b.padding, b.padChar, b.flags)
@@ -75,6 +91,7 @@ func (b InTable) RenderList(list trello.List) string {
 	t := tabby.NewCustom(w)
 	t.AddLine("ID:", list.ID)
 	t.AddLine("Name:", list.Name)
+	t.AddLine("Position:", list.Pos)
 	t.Print()
 	return buffer.String()
 }
@@ -83,16 +100,52 @@ func (b InTable) RenderCards(cards trello.Cards) string {
 	var buffer bytes.Buffer
 	w := tabwriter.NewWriter(&buffer, b.minWidth, b.tabWidth, b.padding, b.padChar, b.flags)
 	t := tabby.NewCustom(w)
-	t.AddHeader("Name", "Position", "Labels")
-	for _, card := range cards.SortedByPos() {
-		line := make([]interface{}, 3)
+	customFieldNames := renderCustomFieldNames(cards)
+	header := []interface{}{"Name", "Position", "Due", "Labels"}
+	t.AddHeader(append(header, customFieldNames...)...)
+	sortedCards := cards.SortedByPos()
+	for _, card := range sortedCards {
+		line := make([]interface{}, 4, 4+len(customFieldNames))
 		line[0] = card.Name
 		line[1] = card.Pos
-		line[2] = b.lr.Render(card.Labels)
+		line[2] = renderDue(card)
+		line[3] = b.lr.Render(card.Labels)
+		for _, value := range card.CustomFields {
+			line = append(line, value.Value)
+		}
 		t.AddLine(line...)
 	}
 	t.Print()
-	return buffer.String()
+	return b.renderOverdueCards(buffer.String(), sortedCards)
+}
+
+// renderCustomFieldNames returns the names of the custom fields of the cards, which are the same for all the cards
+// of a board, to render them as extra columns
+func renderCustomFieldNames(cards trello.Cards) []interface{} {
+	var names []interface{}
+	for _, card := range cards {
+		if len(card.CustomFields) > 0 {
+			for _, value := range card.CustomFields {
+				names = append(names, value.Name)
+			}
+			return names
+		}
+	}
+	return names
+}
+
+// renderOverdueCards renders the lines of the overdue cards once the table is aligned,
+// so the color sequences are not taken into account in the width of the columns
+func (b InTable) renderOverdueCards(table string, sortedCards trello.Cards) string {
+	now := time.Now()
+	lines := strings.Split(table, "\n")
+	for i, card := range sortedCards {
+		// skipping the header and its separator
+		if card.IsOverdue(now) {
+			lines[i+2] = b.or.Render(lines[i+2])
+		}
+	}
+	return strings.Join(lines, "\n")
 }
 
 func (b InTable) RenderCard(card trello.Card) string {
@@ -102,9 +155,21 @@ func (b InTable) RenderCard(card trello.Card) string {
 	t.AddLine("ID:", card.ID)
 	t.AddLine("Name:", card.Name)
 	t.AddLine("Position:", card.Pos)
+	if card.Start != "" {
+		t.AddLine("Start:", trello.FormatDate(card.Start))
+	}
+	if card.Due != "" {
+		t.AddLine("Due:", renderDue(card))
+	}
 	t.AddLine("Short link:", card.ShortLink)
 	t.AddLine("Short URL:", card.ShortURL)
 	t.AddLine("Labels:", b.lr.Render(card.Labels))
+	if customFields := card.CustomFields.NotEmpty(); len(customFields) > 0 {
+		t.AddLine("Custom fields:", "")
+		for _, value := range customFields {
+			t.AddLine(fmt.Sprintf("  %s:", value.Name), value.Value)
+		}
+	}
 	t.AddLine("Description:", "")
 	renderedDescription, err := b.cdr.Render(card.Desc)
 	if err != nil {
@@ -115,10 +180,50 @@ func (b InTable) RenderCard(card trello.Card) string {
 	} else {
 		t.AddLine(renderedDescription)
 	}
+	if len(card.Checklists) > 0 {
+		t.AddLine("Checklists:", renderProgress(card.Checklists.Progress()))
+		for _, checklist := range card.Checklists.SortedByPos() {
+			t.AddLine(fmt.Sprintf("  %s (%s)", checklist.Name, renderProgress(checklist.Progress())))
+			for _, checkItem := range checklist.CheckItems.SortedByPos() {
+				t.AddLine(fmt.Sprintf("    %s %s", renderCheckItemState(checkItem), checkItem.Name))
+			}
+		}
+	}
+	if len(card.Attachments) > 0 {
+		t.AddLine("Attachments:", len(card.Attachments))
+		for _, attachment := range card.Attachments {
+			t.AddLine(fmt.Sprintf("  %s (%s)", attachment.Name, renderAttachmentSize(attachment)))
+		}
+	}
 	t.Print()
 	return buffer.String()
 }
 
+func renderDue(card trello.Card) string {
+	if card.Due != "" && card.DueComplete {
+		return fmt.Sprintf("%s (complete)", trello.FormatDate(card.Due))
+	}
+	return trello.FormatDate(card.Due)
+}
+
+func renderAttachmentSize(attachment trello.Attachment) string {
+	if !attachment.IsUpload {
+		return attachment.URL
+	}
+	return attachment.HumanReadableSize()
+}
+
+func renderProgress(checked, total int) string {
+	return fmt.Sprintf("%d/%d", checked, total)
+}
+
+func renderCheckItemState(checkItem trello.CheckItem) string {
+	if checkItem.IsChecked() {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
 func (b InTable) RenderComments(comments trello.Comments) string {
 	var buffer bytes.Buffer
 	w := tabwriter.NewWriter(&buffer, b.minWidth, b.tabWidth, b.padding, b.padChar, b.flags)
@@ -159,6 +264,34 @@ func (b InTable) RenderComment(comment trello.Comment) string {
 	return buffer.String()
 }
 
+func (b InTable) RenderJournal(entries trello.JournalEntries) string {
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, b.minWidth, b.tabWidth, b.padding, b.padChar, b.flags)
+	t := tabby.NewCustom(w)
+	t.AddHeader("ID", "Date", "Operation", "Resource", "Undone")
+	for _, entry := range entries {
+		undone := ""
+		if entry.Undone {
+			undone = "yes"
+		}
+		t.AddLine(entry.ID, entry.Date.Local().Format("2006-01-02 15:04:05"), entry.Operation, entry.Resource(), undone)
+	}
+	t.Print()
+	return buffer.String()
+}
+
+func (b InTable) RenderActions(actions trello.Actions) string {
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, b.minWidth, b.tabWidth, b.padding, b.padChar, b.flags)
+	t := tabby.NewCustom(w)
+	t.AddHeader("Date", "Member", "Action")
+	for _, action := range actions {
+		t.AddLine(trello.FormatDate(action.Date), action.MemberCreator.FullName, action.Description())
+	}
+	t.Print()
+	return buffer.String()
+}
+
 func renderCommentHeader(comment trello.Comment) string {
 	return fmt.Sprintf("%s @ %s [%s]", comment.MemberCreator.Username, comment.Date, comment.ID)
 }
@@ -1,8 +1,10 @@
 package renderer
 
 import (
+	"fmt"
 	"github.com/l-lin/tcli/trello"
 	"testing"
+	"time"
 )
 
 func TestInTable_RenderBoards(t *testing.T) {
@@ -40,7 +42,7 @@ Board 2    2021-02-08T21:02:58.117Z
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderBoards(tt.given)
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
@@ -81,7 +83,7 @@ Last activity date:
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderBoard(tt.given)
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
@@ -90,6 +92,103 @@ Last activity date:
 	}
 }
 
+func TestInTable_RenderLabels(t *testing.T) {
+	var tests = map[string]struct {
+		given    trello.Labels
+		expected string
+	}{
+		"two labels": {
+			given: trello.Labels{
+				{ID: "label 1", Color: "red", Name: "bug"},
+				{ID: "label 2", Color: "green"},
+			},
+			expected: `ID         Color    Name
+--         -----    ----
+label 1    red      bug
+label 2    green    
+`,
+		},
+		"no label": {
+			given: trello.Labels{},
+			expected: `ID    Color    Name
+--    -----    ----
+`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
+			actual := r.RenderLabels(tt.given)
+			if actual != tt.expected {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInTable_RenderJournal(t *testing.T) {
+	date := time.Date(2026, 10, 18, 12, 30, 0, 0, time.Local)
+	var tests = map[string]struct {
+		given    trello.JournalEntries
+		expected string
+	}{
+		"two entries": {
+			given: trello.JournalEntries{
+				{ID: 1, Date: date, Operation: "create card", After: &trello.JournalSnapshot{Card: &trello.Card{Name: "card"}}},
+				{ID: 2, Date: date, Operation: "archive list", Before: &trello.JournalSnapshot{List: &trello.List{Name: "list"}}, Undone: true},
+			},
+			expected: `ID    Date                   Operation       Resource    Undone
+--    ----                   ---------       --------    ------
+1     2026-10-18 12:30:00    create card     'card'      
+2     2026-10-18 12:30:00    archive list    'list'      yes
+`,
+		},
+		"no entry": {
+			given: trello.JournalEntries{},
+			expected: `ID    Date    Operation    Resource    Undone
+--    ----    ---------    --------    ------
+`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
+			actual := r.RenderJournal(tt.given)
+			if actual != tt.expected {
+				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInTable_RenderActions(t *testing.T) {
+	date := "2026-10-18T10:30:00.000Z"
+	given := trello.Actions{
+		{
+			Type:          "addLabelToCard",
+			Date:          date,
+			Data:          trello.ActionData{Card: &trello.ActionCard{Name: "card"}, Label: &trello.Label{Name: "bug"}},
+			MemberCreator: trello.ActionMember{FullName: "John Doe"},
+		},
+		{
+			Type:          "createCard",
+			Date:          date,
+			Data:          trello.ActionData{Card: &trello.ActionCard{Name: "card"}, List: &trello.ActionResource{Name: "todo"}},
+			MemberCreator: trello.ActionMember{FullName: "Jane"},
+		},
+	}
+	expected := `Date                Member      Action
+----                ------      ------
+` + trello.FormatDate(date) + `    John Doe    added label 'bug' to card 'card'
+` + trello.FormatDate(date) + `    Jane        created card 'card' in list 'todo'
+`
+	r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
+	actual := r.RenderActions(given)
+	if actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
 func TestInTable_RenderLists(t *testing.T) {
 	var tests = map[string]struct {
 		given    trello.Lists
@@ -115,7 +214,7 @@ List 2
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderLists(tt.given)
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
@@ -130,21 +229,23 @@ func TestInTable_RenderList(t *testing.T) {
 		expected string
 	}{
 		"existing list": {
-			given: trello.List{ID: "1", Name: "List 1"},
-			expected: `ID:      1
-Name:    List 1
+			given: trello.List{ID: "1", Name: "List 1", Pos: 16384},
+			expected: `ID:          1
+Name:        List 1
+Position:    16384
 `,
 		},
 		"no list": {
 			given: trello.List{},
-			expected: `ID:      
-Name:    
+			expected: `ID:          
+Name:        
+Position:    0
 `,
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderList(tt.given)
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
@@ -199,10 +300,10 @@ func TestInTable_RenderCards(t *testing.T) {
 					},
 				}
 			},
-			expected: `Name      Position    Labels
-----      --------    ------
-Card 1    10          Label 10 Label 11 
-Card 2    20          Label 20 sky 
+			expected: `Name      Position    Due    Labels
+----      --------    ---    ------
+Card 1    10                 Label 10 Label 11 
+Card 2    20                 Label 20 sky 
 `,
 		},
 		"two cards without label": {
@@ -224,10 +325,10 @@ Card 2    20          Label 20 sky
 					},
 				}
 			},
-			expected: `Name      Position    Labels
-----      --------    ------
-Card 1    10          
-Card 2    20          
+			expected: `Name      Position    Due    Labels
+----      --------    ---    ------
+Card 1    10                 
+Card 2    20                 
 `,
 		},
 		"display cards by position order": {
@@ -249,24 +350,55 @@ Card 2    20
 					},
 				}
 			},
-			expected: `Name      Position    Labels
-----      --------    ------
-Card 2    1           
-Card 1    10          
+			expected: `Name      Position    Due    Labels
+----      --------    ---    ------
+Card 2    1                  
+Card 1    10                 
+`,
+		},
+		"cards with custom fields": {
+			given: func() trello.Cards {
+				return trello.Cards{
+					trello.Card{
+						ID:     "1",
+						Name:   "Card 1",
+						Pos:    10,
+						Labels: trello.Labels{},
+						CustomFields: trello.CustomFieldValues{
+							{Name: "Priority", Type: trello.CustomFieldTypeList, Value: "High"},
+							{Name: "Points", Type: trello.CustomFieldTypeNumber, Value: "3"},
+						},
+					},
+					trello.Card{
+						ID:     "2",
+						Name:   "Card 2",
+						Pos:    20,
+						Labels: trello.Labels{},
+						CustomFields: trello.CustomFieldValues{
+							{Name: "Priority", Type: trello.CustomFieldTypeList, Value: ""},
+							{Name: "Points", Type: trello.CustomFieldTypeNumber, Value: "13"},
+						},
+					},
+				}
+			},
+			expected: `Name      Position    Due    Labels    Priority    Points
+----      --------    ---    ------    --------    ------
+Card 1    10                           High        3
+Card 2    20                                       13
 `,
 		},
 		"no card": {
 			given: func() trello.Cards {
 				return trello.Cards{}
 			},
-			expected: `Name    Position    Labels
-----    --------    ------
+			expected: `Name    Position    Due    Labels
+----    --------    ---    ------
 `,
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderCards(tt.given())
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
@@ -275,6 +407,36 @@ Card 1    10
 	}
 }
 
+type bracketOverdue struct{}
+
+func (b bracketOverdue) Render(line string) string {
+	return fmt.Sprintf("[%s]", line)
+}
+
+func TestInTable_RenderCardsWithDueDates(t *testing.T) {
+	pastDue := "2000-01-01T12:00:00.000Z"
+	futureDue := "2999-01-01T12:00:00.000Z"
+	cards := trello.Cards{
+		trello.Card{ID: "1", Name: "Card 1", Pos: 1, Due: pastDue},
+		trello.Card{ID: "2", Name: "Card 2", Pos: 2, Due: pastDue, DueComplete: true},
+		trello.Card{ID: "3", Name: "Card 3", Pos: 3, Due: futureDue},
+		trello.Card{ID: "4", Name: "Card 4", Pos: 4},
+	}
+	expected := fmt.Sprintf(`Name      Position    Due                            Labels
+----      --------    ---                            ------
+[Card 1    1           %s               ]
+Card 2    2           %s (complete)    
+Card 3    3           %s               
+Card 4    4                                          
+`, trello.FormatDate(pastDue), trello.FormatDate(pastDue), trello.FormatDate(futureDue))
+
+	r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, bracketOverdue{})
+	actual := r.RenderCards(cards)
+	if actual != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, actual)
+	}
+}
+
 func TestInTable_RenderCard(t *testing.T) {
 	var tests = map[string]struct {
 		given    trello.Card
@@ -345,12 +507,112 @@ Description:
 > some context
 
 Here are some markdown contents
+`,
+		},
+		"card with checklists": {
+			given: trello.Card{
+				ID:        "3",
+				Name:      "Card 3",
+				Pos:       1234,
+				ShortLink: "abcd1234",
+				ShortURL:  "https://trello.com/c/abcd1234",
+				Desc:      "description",
+				Labels:    trello.Labels{},
+				Checklists: trello.Checklists{
+					{
+						ID:   "checklist 2",
+						Name: "Definition of done",
+						Pos:  2,
+						CheckItems: trello.CheckItems{
+							{ID: "item 3", Name: "documentation updated", State: "incomplete", Pos: 1},
+						},
+					},
+					{
+						ID:   "checklist 1",
+						Name: "Acceptance criteria",
+						Pos:  1,
+						CheckItems: trello.CheckItems{
+							{ID: "item 2", Name: "second criterion", State: "incomplete", Pos: 2},
+							{ID: "item 1", Name: "first criterion", State: "complete", Pos: 1},
+						},
+					},
+				},
+			},
+			expected: `ID:             3
+Name:           Card 3
+Position:       1234
+Short link:     abcd1234
+Short URL:      https://trello.com/c/abcd1234
+Labels:         
+Description:    
+description
+Checklists:    1/3
+  Acceptance criteria (1/2)
+    [x] first criterion
+    [ ] second criterion
+  Definition of done (0/1)
+    [ ] documentation updated
+`,
+		},
+		"card with custom fields": {
+			given: trello.Card{
+				ID:        "5",
+				Name:      "Card 5",
+				Pos:       1234,
+				ShortLink: "abcd1234",
+				ShortURL:  "https://trello.com/c/abcd1234",
+				Desc:      "description",
+				Labels:    trello.Labels{},
+				CustomFields: trello.CustomFieldValues{
+					{Name: "Priority", Type: trello.CustomFieldTypeList, Value: "High"},
+					{Name: "Reviewed", Type: trello.CustomFieldTypeCheckbox, Value: ""},
+					{Name: "Points", Type: trello.CustomFieldTypeNumber, Value: "3"},
+				},
+			},
+			expected: `ID:               5
+Name:             Card 5
+Position:         1234
+Short link:       abcd1234
+Short URL:        https://trello.com/c/abcd1234
+Labels:           
+Custom fields:    
+  Priority:       High
+  Points:         3
+Description:      
+description
+`,
+		},
+		"card with attachments": {
+			given: trello.Card{
+				ID:        "4",
+				Name:      "Card 4",
+				Pos:       1234,
+				ShortLink: "abcd1234",
+				ShortURL:  "https://trello.com/c/abcd1234",
+				Desc:      "description",
+				Labels:    trello.Labels{},
+				Attachments: trello.Attachments{
+					{ID: "attachment 1", Name: "specs.pdf", Bytes: 1536, IsUpload: true},
+					{ID: "attachment 2", Name: "design", URL: "https://example.com/design", IsUpload: false},
+				},
+			},
+			expected: `ID:             4
+Name:           Card 4
+Position:       1234
+Short link:     abcd1234
+Short URL:      https://trello.com/c/abcd1234
+Labels:         
+Description:    
+description
+Attachments:    2
+  specs.pdf (1.5 KB)
+  design (https://example.com/design)
 `,
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderCard(tt.given)
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
@@ -426,7 +688,7 @@ Here are some markdown content.
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderComments(tt.given)
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
@@ -468,7 +730,7 @@ Here are some markdown content.
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			r := NewInTableRenderer(PlainLabel{}, PlainDescription{})
+			r := NewInTableRenderer(PlainLabel{}, PlainDescription{}, PlainOverdue{})
 			actual := r.RenderComment(tt.given)
 			if actual != tt.expected {
 				t.Errorf("expected:\n%v\nactual:\n%v", tt.expected, actual)
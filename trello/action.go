@@ -0,0 +1,303 @@
+package trello
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// types of the actions returned in the activity of the cards
+// See https://developer.atlassian.com/cloud/trello/guides/rest-api/action-types/ for more info
+const (
+	createCardActionType           = "createCard"
+	copyCardActionType             = "copyCard"
+	updateCardActionType           = "updateCard"
+	deleteCardActionType           = "deleteCard"
+	commentCardActionType          = "commentCard"
+	addLabelToCardActionType       = "addLabelToCard"
+	removeLabelFromCardActionType  = "removeLabelFromCard"
+	addMemberToCardActionType      = "addMemberToCard"
+	removeMemberFromCardActionType = "removeMemberFromCard"
+	moveCardToBoardActionType      = "moveCardToBoard"
+	moveCardFromBoardActionType    = "moveCardFromBoard"
+)
+
+// types of the other actions on a board, only received by the webhooks
+const (
+	updateBoardActionType           = "updateBoard"
+	createListActionType            = "createList"
+	updateListActionType            = "updateList"
+	moveListToBoardActionType       = "moveListToBoard"
+	moveListFromBoardActionType     = "moveListFromBoard"
+	createLabelActionType           = "createLabel"
+	updateLabelActionType           = "updateLabel"
+	deleteLabelActionType           = "deleteLabel"
+	addMemberToBoardActionType      = "addMemberToBoard"
+	removeMemberFromBoardActionType = "removeMemberFromBoard"
+	createCustomFieldActionType     = "createCustomField"
+	updateCustomFieldActionType     = "updateCustomField"
+	deleteCustomFieldActionType     = "deleteCustomField"
+)
+
+// cardActionTypes are the types of actions fetched to show the activity of the cards
+var cardActionTypes = []string{
+	createCardActionType,
+	copyCardActionType,
+	updateCardActionType,
+	deleteCardActionType,
+	commentCardActionType,
+	addLabelToCardActionType,
+	removeLabelFromCardActionType,
+	addMemberToCardActionType,
+	removeMemberFromCardActionType,
+	moveCardToBoardActionType,
+	moveCardFromBoardActionType,
+}
+
+// Actions are sorted from the most recent to the oldest
+type Actions []Action
+
+// FilterByList keeps the actions on the cards that are, or were, in the given list
+func (a Actions) FilterByList(idList string) Actions {
+	filtered := Actions{}
+	for _, action := range a {
+		if action.Data.involvesList(idList) {
+			filtered = append(filtered, action)
+		}
+	}
+	return filtered
+}
+
+// Latest returns the date of the most recent action, or the given date if no action is more recent
+func (a Actions) Latest(since time.Time) time.Time {
+	latest := since
+	for _, action := range a {
+		if date, err := time.Parse(time.RFC3339, action.Date); err == nil && date.After(latest) {
+			latest = date
+		}
+	}
+	return latest
+}
+
+// Reversed returns the actions from the oldest to the most recent
+func (a Actions) Reversed() Actions {
+	reversed := make(Actions, len(a))
+	for i, action := range a {
+		reversed[len(a)-1-i] = action
+	}
+	return reversed
+}
+
+// Action performed by a member on a Trello resource
+type Action struct {
+	ID            string       `json:"id"`
+	Type          string       `json:"type"`
+	Date          string       `json:"date"`
+	Data          ActionData   `json:"data"`
+	MemberCreator ActionMember `json:"memberCreator"`
+	// member added to or removed from a card
+	Member *ActionMember `json:"member,omitempty"`
+}
+
+type ActionData struct {
+	Card        *ActionCard     `json:"card,omitempty"`
+	List        *ActionResource `json:"list,omitempty"`
+	ListBefore  *ActionResource `json:"listBefore,omitempty"`
+	ListAfter   *ActionResource `json:"listAfter,omitempty"`
+	Board       *ActionResource `json:"board,omitempty"`
+	BoardSource *ActionResource `json:"boardSource,omitempty"`
+	BoardTarget *ActionResource `json:"boardTarget,omitempty"`
+	Label       *Label          `json:"label,omitempty"`
+	// values of the card fields before an update
+	Old  map[string]interface{} `json:"old,omitempty"`
+	Text string                 `json:"text,omitempty"`
+}
+
+func (d ActionData) involvesList(idList string) bool {
+	for _, id := range d.idLists() {
+		if id == idList {
+			return true
+		}
+	}
+	return false
+}
+
+// idLists returns the IDs of the lists whose cards were changed by the action
+func (d ActionData) idLists() []string {
+	var idLists []string
+	for _, list := range []*ActionResource{d.List, d.ListBefore, d.ListAfter} {
+		if list != nil {
+			idLists = append(idLists, list.ID)
+		}
+	}
+	if d.Card != nil && d.Card.IDList != "" {
+		idLists = append(idLists, d.Card.IDList)
+	}
+	return idLists
+}
+
+// staleResources are the resources changed by an action, that must be fetched again from Trello
+type staleResources struct {
+	idBoard string
+	// whether the board itself, or its lists, labels, members or custom fields were changed
+	board, lists, labels, members, customFields bool
+	// lists whose cards were changed
+	idLists []string
+	// card whose comments, checklists or attachments may have been changed
+	idCard string
+}
+
+// staleResources returns the resources changed by the action
+func (a Action) staleResources() staleResources {
+	s := staleResources{idLists: a.Data.idLists()}
+	if a.Data.Card != nil {
+		s.idCard = a.Data.Card.ID
+	}
+	if a.Data.Board == nil {
+		return s
+	}
+	s.idBoard = a.Data.Board.ID
+	switch a.Type {
+	case updateBoardActionType:
+		s.board = true
+	case createListActionType, updateListActionType, moveListToBoardActionType, moveListFromBoardActionType:
+		s.lists = true
+	case createLabelActionType, updateLabelActionType, deleteLabelActionType:
+		s.labels = true
+	case addMemberToBoardActionType, removeMemberFromBoardActionType:
+		s.members = true
+	case createCustomFieldActionType, updateCustomFieldActionType, deleteCustomFieldActionType:
+		s.customFields = true
+	}
+	return s
+}
+
+type ActionCard struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	IDShort int    `json:"idShort"`
+	IDList  string `json:"idList,omitempty"`
+	Closed  bool   `json:"closed"`
+}
+
+type ActionResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ActionMember struct {
+	ID       string `json:"id"`
+	FullName string `json:"fullName"`
+	Username string `json:"username"`
+}
+
+// Description of the action in a human friendly way, e.g. "moved card 'foo' from list 'todo' to list 'doing'"
+func (a Action) Description() string {
+	card := a.cardName()
+	switch a.Type {
+	case createCardActionType:
+		return fmt.Sprintf("created card %s%s", card, a.inList())
+	case copyCardActionType:
+		return fmt.Sprintf("copied card %s%s", card, a.inList())
+	case deleteCardActionType:
+		return fmt.Sprintf("deleted card %s%s", card, a.inList())
+	case updateCardActionType:
+		return a.updateDescription(card)
+	case commentCardActionType:
+		return fmt.Sprintf("commented card %s: %s", card, firstLine(a.Data.Text))
+	case addLabelToCardActionType:
+		return fmt.Sprintf("added label %s to card %s", a.labelName(), card)
+	case removeLabelFromCardActionType:
+		return fmt.Sprintf("removed label %s from card %s", a.labelName(), card)
+	case addMemberToCardActionType:
+		return fmt.Sprintf("added member %s to card %s", a.memberName(), card)
+	case removeMemberFromCardActionType:
+		return fmt.Sprintf("removed member %s from card %s", a.memberName(), card)
+	case moveCardToBoardActionType:
+		return fmt.Sprintf("moved card %s from board %s", card, resourceName(a.Data.BoardSource))
+	case moveCardFromBoardActionType:
+		return fmt.Sprintf("moved card %s to board %s", card, resourceName(a.Data.BoardTarget))
+	}
+	return fmt.Sprintf("%s %s", a.Type, card)
+}
+
+func (a Action) updateDescription(card string) string {
+	if a.Data.ListBefore != nil && a.Data.ListAfter != nil {
+		return fmt.Sprintf("moved card %s from list %s to list %s", card, resourceName(a.Data.ListBefore), resourceName(a.Data.ListAfter))
+	}
+	if oldName, ok := a.Data.Old["name"]; ok {
+		return fmt.Sprintf("renamed card '%v' to %s", oldName, card)
+	}
+	if _, ok := a.Data.Old["closed"]; ok {
+		if a.Data.Card != nil && a.Data.Card.Closed {
+			return fmt.Sprintf("archived card %s", card)
+		}
+		return fmt.Sprintf("unarchived card %s", card)
+	}
+	if _, ok := a.Data.Old["desc"]; ok {
+		return fmt.Sprintf("changed the description of card %s", card)
+	}
+	if _, ok := a.Data.Old["due"]; ok {
+		return fmt.Sprintf("changed the due date of card %s", card)
+	}
+	if _, ok := a.Data.Old["start"]; ok {
+		return fmt.Sprintf("changed the start date of card %s", card)
+	}
+	if _, ok := a.Data.Old["dueComplete"]; ok {
+		return fmt.Sprintf("changed the due date completion of card %s", card)
+	}
+	if _, ok := a.Data.Old["pos"]; ok {
+		return fmt.Sprintf("changed the position of card %s", card)
+	}
+	return fmt.Sprintf("updated card %s", card)
+}
+
+func (a Action) cardName() string {
+	if a.Data.Card == nil {
+		return "''"
+	}
+	if a.Data.Card.Name == "" {
+		// deleted cards only keep their short ID
+		return fmt.Sprintf("#%d", a.Data.Card.IDShort)
+	}
+	return fmt.Sprintf("'%s'", a.Data.Card.Name)
+}
+
+func (a Action) inList() string {
+	if a.Data.List == nil {
+		return ""
+	}
+	return fmt.Sprintf(" in list %s", resourceName(a.Data.List))
+}
+
+func (a Action) labelName() string {
+	if a.Data.Label == nil {
+		return "''"
+	}
+	if a.Data.Label.Name == "" {
+		return fmt.Sprintf("'%s'", a.Data.Label.Color)
+	}
+	return fmt.Sprintf("'%s'", a.Data.Label.Name)
+}
+
+func (a Action) memberName() string {
+	if a.Member == nil {
+		return "''"
+	}
+	return fmt.Sprintf("'%s'", a.Member.FullName)
+}
+
+func resourceName(r *ActionResource) string {
+	if r == nil {
+		return "''"
+	}
+	return fmt.Sprintf("'%s'", r.Name)
+}
+
+func firstLine(text string) string {
+	text = strings.TrimSpace(text)
+	if i := strings.IndexByte(text, '\n'); i != -1 {
+		return text[:i] + "…"
+	}
+	return text
+}
@@ -0,0 +1,135 @@
+package trello
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAction_Description(t *testing.T) {
+	card := &ActionCard{ID: "card 1", Name: "card"}
+	var tests = map[string]struct {
+		given    Action
+		expected string
+	}{
+		"created": {
+			given:    Action{Type: "createCard", Data: ActionData{Card: card, List: &ActionResource{Name: "todo"}}},
+			expected: "created card 'card' in list 'todo'",
+		},
+		"moved between lists": {
+			given: Action{Type: "updateCard", Data: ActionData{
+				Card:       card,
+				ListBefore: &ActionResource{Name: "todo"},
+				ListAfter:  &ActionResource{Name: "doing"},
+				Old:        map[string]interface{}{"idList": "list 1"},
+			}},
+			expected: "moved card 'card' from list 'todo' to list 'doing'",
+		},
+		"renamed": {
+			given:    Action{Type: "updateCard", Data: ActionData{Card: card, Old: map[string]interface{}{"name": "old card"}}},
+			expected: "renamed card 'old card' to 'card'",
+		},
+		"archived": {
+			given:    Action{Type: "updateCard", Data: ActionData{Card: &ActionCard{Name: "card", Closed: true}, Old: map[string]interface{}{"closed": false}}},
+			expected: "archived card 'card'",
+		},
+		"unarchived": {
+			given:    Action{Type: "updateCard", Data: ActionData{Card: card, Old: map[string]interface{}{"closed": true}}},
+			expected: "unarchived card 'card'",
+		},
+		"label added": {
+			given:    Action{Type: "addLabelToCard", Data: ActionData{Card: card, Label: &Label{Name: "bug", Color: "red"}}},
+			expected: "added label 'bug' to card 'card'",
+		},
+		"label without name removed": {
+			given:    Action{Type: "removeLabelFromCard", Data: ActionData{Card: card, Label: &Label{Color: "red"}}},
+			expected: "removed label 'red' from card 'card'",
+		},
+		"member added": {
+			given:    Action{Type: "addMemberToCard", Data: ActionData{Card: card}, Member: &ActionMember{FullName: "John Doe"}},
+			expected: "added member 'John Doe' to card 'card'",
+		},
+		"member removed": {
+			given:    Action{Type: "removeMemberFromCard", Data: ActionData{Card: card}, Member: &ActionMember{FullName: "John Doe"}},
+			expected: "removed member 'John Doe' from card 'card'",
+		},
+		"commented on several lines": {
+			given:    Action{Type: "commentCard", Data: ActionData{Card: card, Text: "first line\nsecond line"}},
+			expected: "commented card 'card': first line…",
+		},
+		"deleted card": {
+			given:    Action{Type: "deleteCard", Data: ActionData{Card: &ActionCard{IDShort: 42}, List: &ActionResource{Name: "todo"}}},
+			expected: "deleted card #42 in list 'todo'",
+		},
+		"unknown type": {
+			given:    Action{Type: "foobar", Data: ActionData{Card: card}},
+			expected: "foobar 'card'",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tt.given.Description()
+			if actual != tt.expected {
+				t.Errorf("expected %s, actual %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestActions_FilterByList(t *testing.T) {
+	given := Actions{
+		{ID: "created in list", Data: ActionData{List: &ActionResource{ID: "list 1"}}},
+		{ID: "moved to list", Data: ActionData{ListBefore: &ActionResource{ID: "list 2"}, ListAfter: &ActionResource{ID: "list 1"}}},
+		{ID: "card in list", Data: ActionData{Card: &ActionCard{IDList: "list 1"}}},
+		{ID: "other list", Data: ActionData{List: &ActionResource{ID: "list 2"}}},
+	}
+	actual := given.FilterByList("list 1")
+	expected := Actions{given[0], given[1], given[2]}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
+func TestActions_Latest(t *testing.T) {
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	var tests = map[string]struct {
+		given    Actions
+		expected time.Time
+	}{
+		"no action": {
+			given:    Actions{},
+			expected: since,
+		},
+		"more recent actions": {
+			given: Actions{
+				{ID: "action 2", Date: "2026-10-02T10:00:00.000Z"},
+				{ID: "action 1", Date: "2026-10-01T10:00:00.000Z"},
+			},
+			expected: time.Date(2026, 10, 2, 10, 0, 0, 0, time.UTC),
+		},
+		"older or invalid dates": {
+			given: Actions{
+				{ID: "action 2", Date: "someday"},
+				{ID: "action 1", Date: "2026-09-30T10:00:00.000Z"},
+			},
+			expected: since,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tt.given.Latest(since)
+			if !actual.Equal(tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestActions_Reversed(t *testing.T) {
+	given := Actions{{ID: "action 3"}, {ID: "action 2"}, {ID: "action 1"}}
+	actual := given.Reversed()
+	expected := Actions{{ID: "action 1"}, {ID: "action 2"}, {ID: "action 3"}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
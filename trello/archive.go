@@ -0,0 +1,239 @@
+package trello
+
+import (
+	"fmt"
+	"sort"
+)
+
+// archiveVersion is the version of the format of the archives, to increment when it changes in an incompatible way
+const archiveVersion = 1
+
+// BoardArchive is a board with its labels, lists, cards and comments, without their Trello IDs,
+// so it can be saved in a JSON or YAML file, then imported as a new board
+type BoardArchive struct {
+	Version int             `json:"version"        yaml:"version"`
+	Name    string          `json:"name"           yaml:"name"`
+	Desc    string          `json:"desc,omitempty" yaml:"desc,omitempty"`
+	Labels  []ArchivedLabel `json:"labels"         yaml:"labels"`
+	Lists   []ArchivedList  `json:"lists"          yaml:"lists"`
+}
+
+type ArchivedLabel struct {
+	Name  string `json:"name,omitempty"  yaml:"name,omitempty"`
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+}
+
+// key used by the cards to reference their labels
+func (l ArchivedLabel) key() string {
+	return Label{Name: l.Name, Color: l.Color}.ToTCliColor()
+}
+
+type ArchivedList struct {
+	Name  string         `json:"name"  yaml:"name"`
+	Pos   float64        `json:"pos"   yaml:"pos"`
+	Cards []ArchivedCard `json:"cards" yaml:"cards"`
+}
+
+type ArchivedCard struct {
+	Name string  `json:"name"           yaml:"name"`
+	Desc string  `json:"desc,omitempty" yaml:"desc,omitempty"`
+	Pos  float64 `json:"pos"            yaml:"pos"`
+	// the labels are written like in the card templates, i.e. "color" or "color [name]"
+	Labels     []string            `json:"labels,omitempty"     yaml:"labels,omitempty"`
+	Due        string              `json:"due,omitempty"        yaml:"due,omitempty"`
+	Start      string              `json:"start,omitempty"      yaml:"start,omitempty"`
+	Checklists []ArchivedChecklist `json:"checklists,omitempty" yaml:"checklists,omitempty"`
+	// sorted from the oldest to the most recent
+	Comments []ArchivedComment `json:"comments,omitempty" yaml:"comments,omitempty"`
+}
+
+type ArchivedChecklist struct {
+	Name  string              `json:"name"  yaml:"name"`
+	Items []ArchivedCheckItem `json:"items" yaml:"items"`
+}
+
+type ArchivedCheckItem struct {
+	Name    string `json:"name"    yaml:"name"`
+	Checked bool   `json:"checked" yaml:"checked"`
+}
+
+type ArchivedComment struct {
+	// the author and the date are only informative, the imported comments are written by the current member
+	Author string `json:"author,omitempty" yaml:"author,omitempty"`
+	Date   string `json:"date,omitempty"   yaml:"date,omitempty"`
+	Text   string `json:"text"             yaml:"text"`
+}
+
+// ExportBoard fetches the labels, lists, cards and comments of the board
+func ExportBoard(tr Repository, board Board) (*BoardArchive, error) {
+	archive := &BoardArchive{
+		Version: archiveVersion,
+		Name:    board.Name,
+		Desc:    board.Desc,
+		Labels:  []ArchivedLabel{},
+		Lists:   []ArchivedList{},
+	}
+	labels, err := tr.FindLabels(board.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch labels of board '%s': %w", board.Name, err)
+	}
+	for _, label := range labels {
+		archive.Labels = append(archive.Labels, ArchivedLabel{Name: label.Name, Color: label.Color})
+	}
+
+	lists, err := tr.FindLists(board.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch lists of board '%s': %w", board.Name, err)
+	}
+	for _, list := range lists {
+		archivedList, err := exportList(tr, list)
+		if err != nil {
+			return nil, err
+		}
+		archive.Lists = append(archive.Lists, *archivedList)
+	}
+	return archive, nil
+}
+
+func exportList(tr Repository, list List) (*ArchivedList, error) {
+	cards, err := tr.FindCards(list.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch cards of list '%s': %w", list.Name, err)
+	}
+	archivedList := &ArchivedList{Name: list.Name, Pos: list.Pos, Cards: []ArchivedCard{}}
+	for _, card := range cards {
+		archivedCard, err := exportCard(tr, card)
+		if err != nil {
+			return nil, err
+		}
+		archivedList.Cards = append(archivedList.Cards, *archivedCard)
+	}
+	return archivedList, nil
+}
+
+func exportCard(tr Repository, card Card) (*ArchivedCard, error) {
+	archivedCard := &ArchivedCard{
+		Name:  card.Name,
+		Desc:  card.Desc,
+		Pos:   card.Pos,
+		Due:   card.Due,
+		Start: card.Start,
+	}
+	for _, label := range card.Labels {
+		archivedCard.Labels = append(archivedCard.Labels, label.ToTCliColor())
+	}
+
+	checklists, err := tr.FindChecklists(card.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch checklists of card '%s': %w", card.Name, err)
+	}
+	sort.SliceStable(checklists, func(i, j int) bool {
+		return checklists[i].Pos < checklists[j].Pos
+	})
+	for _, checklist := range checklists {
+		archivedChecklist := ArchivedChecklist{Name: checklist.Name, Items: []ArchivedCheckItem{}}
+		for _, checkItem := range checklist.CheckItems.SortedByPos() {
+			archivedChecklist.Items = append(archivedChecklist.Items, ArchivedCheckItem{Name: checkItem.Name, Checked: checkItem.IsChecked()})
+		}
+		archivedCard.Checklists = append(archivedCard.Checklists, archivedChecklist)
+	}
+
+	comments, err := tr.FindComments(card.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch comments of card '%s': %w", card.Name, err)
+	}
+	// Trello returns the most recent comments first
+	for i := len(comments) - 1; i >= 0; i-- {
+		archivedCard.Comments = append(archivedCard.Comments, ArchivedComment{
+			Author: comments[i].MemberCreator.FullName,
+			Date:   comments[i].Date,
+			Text:   comments[i].Data.Text,
+		})
+	}
+	return archivedCard, nil
+}
+
+// ImportBoard creates a new board with the given name from the archive,
+// the labels, lists, cards, checklists and comments getting new IDs
+func ImportBoard(tr Repository, archive BoardArchive, name string) (*Board, error) {
+	if archive.Version > archiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d, only versions up to %d are supported", archive.Version, archiveVersion)
+	}
+	noDefault := false
+	board, err := tr.CreateBoard(CreateBoard{
+		Name:          name,
+		Desc:          archive.Desc,
+		DefaultLabels: &noDefault,
+		DefaultLists:  &noDefault,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create board '%s': %w", name, err)
+	}
+
+	idLabels := map[string]string{} // <label key, idLabel>
+	for _, archivedLabel := range archive.Labels {
+		label, err := tr.CreateLabel(CreateLabel{IDBoard: board.ID, Name: archivedLabel.Name, Color: toNullableString(archivedLabel.Color)})
+		if err != nil {
+			return nil, fmt.Errorf("could not create label '%s': %w", archivedLabel.key(), err)
+		}
+		idLabels[archivedLabel.key()] = label.ID
+	}
+
+	for _, archivedList := range archive.Lists {
+		list, err := tr.CreateList(CreateList{Name: archivedList.Name, IDBoard: board.ID, Pos: archivedList.Pos})
+		if err != nil {
+			return nil, fmt.Errorf("could not create list '%s': %w", archivedList.Name, err)
+		}
+		for _, archivedCard := range archivedList.Cards {
+			if err = importCard(tr, archivedCard, list.ID, idLabels); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return board, nil
+}
+
+func importCard(tr Repository, archivedCard ArchivedCard, idList string, idLabels map[string]string) error {
+	createCard := CreateCard{
+		Name:   archivedCard.Name,
+		Desc:   archivedCard.Desc,
+		IDList: idList,
+		Pos:    archivedCard.Pos,
+		Due:    toNullableString(archivedCard.Due),
+		Start:  toNullableString(archivedCard.Start),
+	}
+	for _, label := range archivedCard.Labels {
+		idLabel, ok := idLabels[label]
+		if !ok {
+			return fmt.Errorf("unknown label '%s' in card '%s'", label, archivedCard.Name)
+		}
+		if createCard.IDLabels != "" {
+			createCard.IDLabels += ","
+		}
+		createCard.IDLabels += idLabel
+	}
+	card, err := tr.CreateCard(createCard)
+	if err != nil {
+		return fmt.Errorf("could not create card '%s': %w", archivedCard.Name, err)
+	}
+
+	for _, archivedChecklist := range archivedCard.Checklists {
+		checklist, err := tr.CreateChecklist(CreateChecklist{IDCard: card.ID, Name: archivedChecklist.Name, Pos: "bottom"})
+		if err != nil {
+			return fmt.Errorf("could not create checklist '%s' in card '%s': %w", archivedChecklist.Name, card.Name, err)
+		}
+		for _, item := range archivedChecklist.Items {
+			createCheckItem := CreateCheckItem{IDCard: card.ID, IDChecklist: checklist.ID, Name: item.Name, Checked: item.Checked, Pos: "bottom"}
+			if _, err = tr.CreateCheckItem(createCheckItem); err != nil {
+				return fmt.Errorf("could not create checklist item '%s' in card '%s': %w", item.Name, card.Name, err)
+			}
+		}
+	}
+
+	for _, archivedComment := range archivedCard.Comments {
+		if _, err = tr.CreateComment(CreateComment{IDCard: card.ID, Text: archivedComment.Text}); err != nil {
+			return fmt.Errorf("could not create comment in card '%s': %w", card.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package trello
+
+import (
+	"github.com/golang/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestExportBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := Board{ID: "board 1", Name: "board", Desc: "description"}
+	bug := Label{ID: "label 1", IDBoard: board.ID, Name: "bug", Color: "red"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindLabels(board.ID).
+		Return(Labels{bug}, nil)
+	tr.EXPECT().
+		FindLists(board.ID).
+		Return(Lists{{ID: "list 1", Name: "todo", Pos: 1024}}, nil)
+	tr.EXPECT().
+		FindCards("list 1").
+		Return(Cards{{ID: "card 1", Name: "card", Desc: "card description", Pos: 2048, Due: "2026-11-01T17:00:00.000Z", Labels: Labels{bug}}}, nil)
+	tr.EXPECT().
+		FindChecklists("card 1").
+		Return(Checklists{{ID: "checklist 1", Name: "checklist", CheckItems: CheckItems{
+			{ID: "item 2", Name: "item 2", State: "incomplete", Pos: 2},
+			{ID: "item 1", Name: "item 1", State: "complete", Pos: 1},
+		}}}, nil)
+	tr.EXPECT().
+		FindComments("card 1").
+		Return(Comments{
+			{ID: "comment 2", Date: "2026-10-02", Data: CommentData{Text: "second"}, MemberCreator: CommentMemberCreator{FullName: "Member"}},
+			{ID: "comment 1", Date: "2026-10-01", Data: CommentData{Text: "first"}, MemberCreator: CommentMemberCreator{FullName: "Member"}},
+		}, nil)
+
+	actual, err := ExportBoard(tr, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &BoardArchive{
+		Version: archiveVersion,
+		Name:    "board",
+		Desc:    "description",
+		Labels:  []ArchivedLabel{{Name: "bug", Color: "red"}},
+		Lists: []ArchivedList{{
+			Name: "todo",
+			Pos:  1024,
+			Cards: []ArchivedCard{{
+				Name:   "card",
+				Desc:   "card description",
+				Pos:    2048,
+				Labels: []string{"red [bug]"},
+				Due:    "2026-11-01T17:00:00.000Z",
+				Checklists: []ArchivedChecklist{{
+					Name:  "checklist",
+					Items: []ArchivedCheckItem{{Name: "item 1", Checked: true}, {Name: "item 2"}},
+				}},
+				Comments: []ArchivedComment{
+					{Author: "Member", Date: "2026-10-01", Text: "first"},
+					{Author: "Member", Date: "2026-10-02", Text: "second"},
+				},
+			}},
+		}},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, actual %+v", expected, actual)
+	}
+}
+
+func TestImportBoard(t *testing.T) {
+	noDefault := false
+	archive := BoardArchive{
+		Version: archiveVersion,
+		Name:    "board",
+		Labels:  []ArchivedLabel{{Name: "bug", Color: "red"}, {Color: "green"}},
+		Lists: []ArchivedList{{
+			Name: "todo",
+			Pos:  1024,
+			Cards: []ArchivedCard{{
+				Name:       "card",
+				Pos:        2048,
+				Labels:     []string{"red [bug]", "green"},
+				Checklists: []ArchivedChecklist{{Name: "checklist", Items: []ArchivedCheckItem{{Name: "item", Checked: true}}}},
+				Comments:   []ArchivedComment{{Text: "first"}, {Text: "second"}},
+			}},
+		}},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		red, green := "red", "green"
+		tr := NewMockRepository(ctrl)
+		gomock.InOrder(
+			tr.EXPECT().
+				CreateBoard(CreateBoard{Name: "new board", DefaultLabels: &noDefault, DefaultLists: &noDefault}).
+				Return(&Board{ID: "board 2", Name: "new board"}, nil),
+			tr.EXPECT().
+				CreateLabel(CreateLabel{IDBoard: "board 2", Name: "bug", Color: &red}).
+				Return(&Label{ID: "label 3"}, nil),
+			tr.EXPECT().
+				CreateLabel(CreateLabel{IDBoard: "board 2", Color: &green}).
+				Return(&Label{ID: "label 4"}, nil),
+			tr.EXPECT().
+				CreateList(CreateList{Name: "todo", IDBoard: "board 2", Pos: float64(1024)}).
+				Return(&List{ID: "list 2"}, nil),
+			tr.EXPECT().
+				CreateCard(CreateCard{Name: "card", IDList: "list 2", IDLabels: "label 3,label 4", Pos: float64(2048)}).
+				Return(&Card{ID: "card 2", Name: "card"}, nil),
+			tr.EXPECT().
+				CreateChecklist(CreateChecklist{IDCard: "card 2", Name: "checklist", Pos: "bottom"}).
+				Return(&Checklist{ID: "checklist 2"}, nil),
+			tr.EXPECT().
+				CreateCheckItem(CreateCheckItem{IDCard: "card 2", IDChecklist: "checklist 2", Name: "item", Checked: true, Pos: "bottom"}).
+				Return(&CheckItem{}, nil),
+			tr.EXPECT().
+				CreateComment(CreateComment{IDCard: "card 2", Text: "first"}).
+				Return(&Comment{}, nil),
+			tr.EXPECT().
+				CreateComment(CreateComment{IDCard: "card 2", Text: "second"}).
+				Return(&Comment{}, nil),
+		)
+
+		actual, err := ImportBoard(tr, archive, "new board")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual.ID != "board 2" {
+			t.Errorf("unexpected board %v", actual)
+		}
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		newerArchive := archive
+		newerArchive.Version = archiveVersion + 1
+		if _, err := ImportBoard(NewMockRepository(ctrl), newerArchive, "new board"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package trello
+
+import (
+	"fmt"
+	"io"
+)
+
+// FindAttachment finds the attachment by its ID or its name
+func FindAttachment(attachments Attachments, query string) *Attachment {
+	for _, attachment := range attachments {
+		if query == attachment.ID || query == attachment.Name {
+			return &attachment
+		}
+	}
+	return nil
+}
+
+type Attachments []Attachment
+
+type Attachment struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Bytes    int64  `json:"bytes"`
+	MimeType string `json:"mimeType"`
+	Date     string `json:"date"`
+	// IsUpload is false for the attachments that are links to external resources
+	IsUpload bool `json:"isUpload"`
+}
+
+// HumanReadableSize returns the size of the attachment in a human friendly way, e.g. "1.2 MB"
+func (a Attachment) HumanReadableSize() string {
+	const unit = 1024
+	if a.Bytes < unit {
+		return fmt.Sprintf("%d B", a.Bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := a.Bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(a.Bytes)/float64(div), "KMGTPE"[exp])
+}
+
+// CreateAttachment represents the file to upload as a new attachment of a card
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-cards/#api-cards-id-attachments-post for more info
+type CreateAttachment struct {
+	IDCard string
+	Name   string
+	File   io.Reader
+}
@@ -0,0 +1,57 @@
+package trello
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindAttachment(t *testing.T) {
+	attachments := Attachments{
+		{ID: "attachment 1", Name: "specs.pdf"},
+		{ID: "attachment 2", Name: "design.png"},
+	}
+	var tests = map[string]struct {
+		given    string
+		expected *Attachment
+	}{
+		"by name": {
+			given:    "design.png",
+			expected: &attachments[1],
+		},
+		"by ID": {
+			given:    "attachment 1",
+			expected: &attachments[0],
+		},
+		"unknown attachment": {
+			given:    "unknown.pdf",
+			expected: nil,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := FindAttachment(attachments, tt.given)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAttachment_HumanReadableSize(t *testing.T) {
+	var tests = map[string]struct {
+		given    int64
+		expected string
+	}{
+		"bytes":     {given: 512, expected: "512 B"},
+		"kilobytes": {given: 1536, expected: "1.5 KB"},
+		"megabytes": {given: 5 * 1024 * 1024, expected: "5.0 MB"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := Attachment{Bytes: tt.given}.HumanReadableSize()
+			if actual != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
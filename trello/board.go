@@ -18,6 +18,8 @@ type Boards []Board
 type Board struct {
 	ID               string `json:"id"`
 	Name             string `json:"name"`
+	Desc             string `json:"desc"`
+	Closed           bool   `json:"closed"`
 	ShortLink        string `json:"shortLink"`
 	ShortURL         string `json:"shortUrl"`
 	DateLastActivity string `json:"dateLastActivity"`
@@ -30,3 +32,52 @@ func (b Board) TCliID() string {
 func (b Board) SanitizedName() string {
 	return sanitize(b.Name)
 }
+
+// BOARD CREATION ---------------------------------------------------------------------------------------
+
+// CreateBoard represents the resources used to create a new board
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-boards/#api-boards-post for more info
+type CreateBoard struct {
+	Name string `json:"name"`
+	Desc string `json:"desc,omitempty"`
+	// nil to create the default labels and lists of Trello
+	DefaultLabels *bool `json:"defaultLabels,omitempty"`
+	DefaultLists  *bool `json:"defaultLists,omitempty"`
+}
+
+// BOARD UPDATE ---------------------------------------------------------------------------------------
+
+// UpdateBoard represents the resources used to update a board
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-boards/#api-boards-id-put for more info
+type UpdateBoard struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Desc   string `json:"desc"`
+	Closed bool   `json:"closed"`
+}
+
+func NewUpdateBoard(board Board) UpdateBoard {
+	return UpdateBoard{
+		ID:     board.ID,
+		Name:   board.Name,
+		Desc:   board.Desc,
+		Closed: board.Closed,
+	}
+}
+
+func NewBoardToEdit(board Board) BoardToEdit {
+	return BoardToEdit{
+		Name:   board.Name,
+		Desc:   board.Desc,
+		Closed: board.Closed,
+	}
+}
+
+// BoardToEdit is the representation used in the board edition
+// it's different from the other board representation because we do not want to expose everything to the user
+// like for instance, the board ID
+type BoardToEdit struct {
+	Name   string `yaml:"name"   toml:"name"`
+	Desc   string `yaml:"desc"   toml:"desc"`
+	Closed bool   `yaml:"closed" toml:"closed"`
+}
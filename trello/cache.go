@@ -3,6 +3,8 @@ package trello
 import (
 	"fmt"
 	"github.com/rs/zerolog/log"
+	"io"
+	"time"
 )
 
 // CacheInMemory is a decorator that caches the results of the proxified Repository
@@ -10,27 +12,63 @@ import (
 type CacheInMemory struct {
 	r Repository
 	*Boards
-	mapLabelsByIDBoard  map[string]Labels   // <idBoard, Labels>
-	mapListsByIDBoard   map[string]Lists    // <idBoard, Lists>
-	mapCardsByIDList    map[string]Cards    // <idList, Cards>
-	mapCommentsByIDCard map[string]Comments // <idCard, Comments>
+	mapLabelsByIDBoard       map[string]Labels  // <idBoard, Labels>
+	mapMembersByIDBoard      map[string]Members // <idBoard, Members>
+	currentMember            *Member
+	mapListsByIDBoard        map[string]Lists        // <idBoard, Lists>
+	mapCardsByIDList         map[string]Cards        // <idList, Cards>
+	mapChecklistsByIDCard    map[string]Checklists   // <idCard, Checklists>
+	mapAttachmentsByIDCard   map[string]Attachments  // <idCard, Attachments>
+	mapCommentsByIDCard      map[string]Comments     // <idCard, Comments>
+	mapCustomFieldsByIDBoard map[string]CustomFields // <idBoard, CustomFields>
 }
 
 func NewCacheInMemory(r Repository) Repository {
 	return &CacheInMemory{
-		r:                   r,
-		mapLabelsByIDBoard:  map[string]Labels{},
-		mapListsByIDBoard:   map[string]Lists{},
-		mapCardsByIDList:    map[string]Cards{},
-		mapCommentsByIDCard: map[string]Comments{},
+		r:                        r,
+		mapLabelsByIDBoard:       map[string]Labels{},
+		mapMembersByIDBoard:      map[string]Members{},
+		mapListsByIDBoard:        map[string]Lists{},
+		mapCardsByIDList:         map[string]Cards{},
+		mapChecklistsByIDCard:    map[string]Checklists{},
+		mapAttachmentsByIDCard:   map[string]Attachments{},
+		mapCommentsByIDCard:      map[string]Comments{},
+		mapCustomFieldsByIDBoard: map[string]CustomFields{},
 	}
 }
 
 func (c *CacheInMemory) Refresh() {
+	c.Boards = nil
 	c.mapLabelsByIDBoard = map[string]Labels{}
+	c.mapMembersByIDBoard = map[string]Members{}
+	c.currentMember = nil
 	c.mapListsByIDBoard = map[string]Lists{}
 	c.mapCardsByIDList = map[string]Cards{}
+	c.mapChecklistsByIDCard = map[string]Checklists{}
+	c.mapAttachmentsByIDCard = map[string]Attachments{}
 	c.mapCommentsByIDCard = map[string]Comments{}
+	c.mapCustomFieldsByIDBoard = map[string]CustomFields{}
+	c.r.Refresh()
+}
+
+// Prefetch fetches the content of the board from the proxified Repository, then caches it all at once,
+// so the cache is never partially filled
+func (c *CacheInMemory) Prefetch(idBoard string) error {
+	if c.mapListsByIDBoard[idBoard] != nil {
+		log.Debug().Str("idBoard", idBoard).Msg("board already fetched")
+		return nil
+	}
+	log.Debug().Str("idBoard", idBoard).Msg("prefetching board from remote")
+	content, err := fetchBoardContent(c.r, idBoard)
+	if err != nil {
+		return err
+	}
+	c.mapLabelsByIDBoard[idBoard] = content.labels
+	c.mapListsByIDBoard[idBoard] = content.lists
+	for idList, cards := range content.cardsByIDList {
+		c.mapCardsByIDList[idList] = cards
+	}
+	return nil
 }
 
 func (c *CacheInMemory) FindBoards() (Boards, error) {
@@ -55,6 +93,46 @@ func (c *CacheInMemory) FindBoard(query string) (*Board, error) {
 	return nil, fmt.Errorf("no board found with query %s", query)
 }
 
+func (c *CacheInMemory) CreateBoard(createBoard CreateBoard) (*Board, error) {
+	board, err := c.r.CreateBoard(createBoard)
+	if err != nil {
+		return nil, err
+	}
+
+	// add board to cache
+	if c.Boards != nil {
+		boards := append(*c.Boards, *board)
+		c.Boards = &boards
+	}
+	return board, nil
+}
+
+func (c *CacheInMemory) UpdateBoard(updateBoard UpdateBoard) (*Board, error) {
+	board, err := c.r.UpdateBoard(updateBoard)
+	if err != nil {
+		return nil, err
+	}
+
+	boardIndex := c.findBoardIndex(updateBoard.ID)
+	if board.Closed {
+		c.removeBoard(boardIndex)
+	} else if boardIndex != -1 {
+		(*c.Boards)[boardIndex] = *board
+	}
+	return board, nil
+}
+
+func (c *CacheInMemory) CloseBoard(idBoard string) error {
+	if err := c.r.CloseBoard(idBoard); err != nil {
+		return err
+	}
+
+	c.removeBoard(c.findBoardIndex(idBoard))
+	delete(c.mapLabelsByIDBoard, idBoard)
+	delete(c.mapListsByIDBoard, idBoard)
+	return nil
+}
+
 func (c *CacheInMemory) FindLabels(idBoard string) (Labels, error) {
 	if c.mapLabelsByIDBoard[idBoard] != nil {
 		log.Debug().Msg("fetching labels from cache")
@@ -66,6 +144,58 @@ func (c *CacheInMemory) FindLabels(idBoard string) (Labels, error) {
 	return labels, err
 }
 
+// the labels are embedded in the cards, so the cards are also evicted when a label is modified
+
+func (c *CacheInMemory) CreateLabel(createLabel CreateLabel) (*Label, error) {
+	label, err := c.r.CreateLabel(createLabel)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.mapLabelsByIDBoard, createLabel.IDBoard)
+	return label, nil
+}
+
+func (c *CacheInMemory) UpdateLabel(updateLabel UpdateLabel) (*Label, error) {
+	label, err := c.r.UpdateLabel(updateLabel)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.mapLabelsByIDBoard, updateLabel.IDBoard)
+	c.mapCardsByIDList = map[string]Cards{}
+	return label, nil
+}
+
+func (c *CacheInMemory) DeleteLabel(idBoard, idLabel string) error {
+	if err := c.r.DeleteLabel(idBoard, idLabel); err != nil {
+		return err
+	}
+	delete(c.mapLabelsByIDBoard, idBoard)
+	c.mapCardsByIDList = map[string]Cards{}
+	return nil
+}
+
+func (c *CacheInMemory) FindMembers(idBoard string) (Members, error) {
+	if c.mapMembersByIDBoard[idBoard] != nil {
+		log.Debug().Str("idBoard", idBoard).Msg("fetching members from cache")
+		return c.mapMembersByIDBoard[idBoard], nil
+	}
+	log.Debug().Str("idBoard", idBoard).Msg("fetching members from remote")
+	members, err := c.r.FindMembers(idBoard)
+	c.mapMembersByIDBoard[idBoard] = members
+	return members, err
+}
+
+func (c *CacheInMemory) FindCurrentMember() (*Member, error) {
+	if c.currentMember != nil {
+		log.Debug().Msg("fetching current member from cache")
+		return c.currentMember, nil
+	}
+	log.Debug().Msg("fetching current member from remote")
+	member, err := c.r.FindCurrentMember()
+	c.currentMember = member
+	return member, err
+}
+
 func (c *CacheInMemory) FindLists(idBoard string) (Lists, error) {
 	if c.mapListsByIDBoard[idBoard] != nil {
 		log.Debug().Str("idBoard", idBoard).Msg("fetching lists from cache")
@@ -88,6 +218,58 @@ func (c *CacheInMemory) FindList(idBoard string, query string) (*List, error) {
 	return nil, fmt.Errorf("no list found with query %s", query)
 }
 
+func (c *CacheInMemory) CreateList(createList CreateList) (*List, error) {
+	list, err := c.r.CreateList(createList)
+	if err != nil {
+		return nil, err
+	}
+
+	// add list to cache
+	c.mapListsByIDBoard[createList.IDBoard] = append(c.mapListsByIDBoard[createList.IDBoard], *list)
+	return list, nil
+}
+
+func (c *CacheInMemory) UpdateList(updateList UpdateList) (*List, error) {
+	list, err := c.r.UpdateList(updateList)
+	if err != nil {
+		return nil, err
+	}
+
+	listIndex := c.findListIndex(updateList.IDBoard, updateList.ID)
+	if listIndex == -1 {
+		// list may have been moved to another board, so clear cache completely
+		c.mapListsByIDBoard = map[string]Lists{}
+	} else {
+		if list.Closed {
+			c.removeList(updateList.IDBoard, listIndex)
+			delete(c.mapCardsByIDList, updateList.ID)
+		} else {
+			c.mapListsByIDBoard[updateList.IDBoard][listIndex] = *list
+		}
+	}
+	return list, nil
+}
+
+func (c *CacheInMemory) ArchiveList(idBoard, idList string) error {
+	if err := c.r.ArchiveList(idBoard, idList); err != nil {
+		return err
+	}
+
+	c.removeList(idBoard, c.findListIndex(idBoard, idList))
+	delete(c.mapCardsByIDList, idList)
+	return nil
+}
+
+// the archived lists and cards are not cached, as they are only fetched to be listed or restored
+
+func (c *CacheInMemory) FindArchivedLists(idBoard string) (Lists, error) {
+	return c.r.FindArchivedLists(idBoard)
+}
+
+func (c *CacheInMemory) FindArchivedCards(idList string) (Cards, error) {
+	return c.r.FindArchivedCards(idList)
+}
+
 func (c *CacheInMemory) FindCards(idList string) (Cards, error) {
 	if c.mapCardsByIDList[idList] != nil {
 		log.Debug().Str("idList", idList).Msg("fetching cards from cache")
@@ -144,12 +326,223 @@ func (c *CacheInMemory) UpdateCard(updateCard UpdateCard) (*Card, error) {
 		if card.Closed {
 			c.removeCard(updateCard.IDList, cardIndex)
 		} else {
-			c.mapCardsByIDList[updateCard.IDList][cardIndex] = *card
+			cachedCard := c.mapCardsByIDList[updateCard.IDList][cardIndex]
+			updatedCard := *card
+			if updatedCard.CustomFieldItems == nil {
+				// the values of the custom fields are not returned by Trello when updating the card
+				updatedCard.CustomFieldItems = cachedCard.CustomFieldItems
+			}
+			c.mapCardsByIDList[updateCard.IDList][cardIndex] = updatedCard
 		}
 	}
 	return card, nil
 }
 
+func (c *CacheInMemory) SearchCards(query string, idBoards ...string) (Cards, error) {
+	// search results are not cached as they depend on the query
+	return c.r.SearchCards(query, idBoards...)
+}
+
+func (c *CacheInMemory) FindChecklists(idCard string) (Checklists, error) {
+	if c.mapChecklistsByIDCard[idCard] != nil {
+		log.Debug().Str("idCard", idCard).Msg("fetching checklists from cache")
+		return c.mapChecklistsByIDCard[idCard], nil
+	}
+	log.Debug().Str("idCard", idCard).Msg("fetching checklists from remote")
+	checklists, err := c.r.FindChecklists(idCard)
+	c.mapChecklistsByIDCard[idCard] = checklists
+	return checklists, err
+}
+
+// the checklists are nested resources, so the cache of the card checklists is simply
+// evicted when one of them is modified instead of being updated
+
+func (c *CacheInMemory) CreateChecklist(createChecklist CreateChecklist) (*Checklist, error) {
+	checklist, err := c.r.CreateChecklist(createChecklist)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.mapChecklistsByIDCard, createChecklist.IDCard)
+	return checklist, nil
+}
+
+func (c *CacheInMemory) UpdateChecklist(updateChecklist UpdateChecklist) (*Checklist, error) {
+	checklist, err := c.r.UpdateChecklist(updateChecklist)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.mapChecklistsByIDCard, updateChecklist.IDCard)
+	return checklist, nil
+}
+
+func (c *CacheInMemory) DeleteChecklist(idCard, idChecklist string) error {
+	if err := c.r.DeleteChecklist(idCard, idChecklist); err != nil {
+		return err
+	}
+	delete(c.mapChecklistsByIDCard, idCard)
+	return nil
+}
+
+func (c *CacheInMemory) CreateCheckItem(createCheckItem CreateCheckItem) (*CheckItem, error) {
+	checkItem, err := c.r.CreateCheckItem(createCheckItem)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.mapChecklistsByIDCard, createCheckItem.IDCard)
+	return checkItem, nil
+}
+
+func (c *CacheInMemory) UpdateCheckItem(updateCheckItem UpdateCheckItem) (*CheckItem, error) {
+	checkItem, err := c.r.UpdateCheckItem(updateCheckItem)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.mapChecklistsByIDCard, updateCheckItem.IDCard)
+	return checkItem, nil
+}
+
+func (c *CacheInMemory) DeleteCheckItem(idCard, idCheckItem string) error {
+	if err := c.r.DeleteCheckItem(idCard, idCheckItem); err != nil {
+		return err
+	}
+	delete(c.mapChecklistsByIDCard, idCard)
+	return nil
+}
+
+func (c *CacheInMemory) FindCustomFields(idBoard string) (CustomFields, error) {
+	if c.mapCustomFieldsByIDBoard[idBoard] != nil {
+		log.Debug().Str("idBoard", idBoard).Msg("fetching custom fields from cache")
+		return c.mapCustomFieldsByIDBoard[idBoard], nil
+	}
+	log.Debug().Str("idBoard", idBoard).Msg("fetching custom fields from remote")
+	customFields, err := c.r.FindCustomFields(idBoard)
+	c.mapCustomFieldsByIDBoard[idBoard] = customFields
+	return customFields, err
+}
+
+// the values of the custom fields are embedded in the cards, so the cached card is updated with the new value
+
+func (c *CacheInMemory) UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	customFieldItem, err := c.r.UpdateCustomFieldItem(updateCustomFieldItem)
+	if err != nil {
+		return nil, err
+	}
+	item := *customFieldItem
+	// Trello returns an empty item when the custom field is cleared
+	item.IDCustomField = updateCustomFieldItem.IDCustomField
+	for _, cards := range c.mapCardsByIDList {
+		for i := range cards {
+			if cards[i].ID == updateCustomFieldItem.IDCard {
+				cards[i].CustomFieldItems = cards[i].CustomFieldItems.with(item)
+			}
+		}
+	}
+	return customFieldItem, nil
+}
+
+func (c *CacheInMemory) FindAttachments(idCard string) (Attachments, error) {
+	if c.mapAttachmentsByIDCard[idCard] != nil {
+		log.Debug().Str("idCard", idCard).Msg("fetching attachments from cache")
+		return c.mapAttachmentsByIDCard[idCard], nil
+	}
+	log.Debug().Str("idCard", idCard).Msg("fetching attachments from remote")
+	attachments, err := c.r.FindAttachments(idCard)
+	c.mapAttachmentsByIDCard[idCard] = attachments
+	return attachments, err
+}
+
+func (c *CacheInMemory) CreateAttachment(createAttachment CreateAttachment) (*Attachment, error) {
+	attachment, err := c.r.CreateAttachment(createAttachment)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.mapAttachmentsByIDCard, createAttachment.IDCard)
+	return attachment, nil
+}
+
+func (c *CacheInMemory) DownloadAttachment(attachment Attachment, w io.Writer) error {
+	// the content of the attachments is not cached
+	return c.r.DownloadAttachment(attachment, w)
+}
+
+func (c *CacheInMemory) DeleteAttachment(idCard, idAttachment string) error {
+	if err := c.r.DeleteAttachment(idCard, idAttachment); err != nil {
+		return err
+	}
+	delete(c.mapAttachmentsByIDCard, idCard)
+	return nil
+}
+
+// the activity is never cached, so it is always up to date
+
+func (c *CacheInMemory) FindWebhooks() (Webhooks, error) {
+	return c.r.FindWebhooks()
+}
+
+func (c *CacheInMemory) CreateWebhook(createWebhook CreateWebhook) (*Webhook, error) {
+	return c.r.CreateWebhook(createWebhook)
+}
+
+func (c *CacheInMemory) DeleteWebhook(idWebhook string) error {
+	return c.r.DeleteWebhook(idWebhook)
+}
+
+func (c *CacheInMemory) FindCardActions(idCard string, since time.Time) (Actions, error) {
+	return c.r.FindCardActions(idCard, since)
+}
+
+func (c *CacheInMemory) FindBoardActions(idBoard string, since time.Time) (Actions, error) {
+	actions, err := c.r.FindBoardActions(idBoard, since)
+	if err == nil {
+		c.evict(actions)
+	}
+	return actions, err
+}
+
+// Forget removes from the cache the resources changed by the given actions, performed outside tcli
+func (c *CacheInMemory) Forget(actions Actions) {
+	c.evict(actions)
+	c.r.Forget(actions)
+}
+
+// evict removes from the cache the resources changed by the given actions,
+// as they may have been performed outside tcli
+func (c *CacheInMemory) evict(actions Actions) {
+	for _, action := range actions {
+		stale := action.staleResources()
+		if stale.board {
+			c.Boards = nil
+		}
+		if stale.lists {
+			delete(c.mapListsByIDBoard, stale.idBoard)
+		}
+		if stale.labels {
+			delete(c.mapLabelsByIDBoard, stale.idBoard)
+		}
+		if stale.members {
+			delete(c.mapMembersByIDBoard, stale.idBoard)
+		}
+		if stale.customFields {
+			delete(c.mapCustomFieldsByIDBoard, stale.idBoard)
+		}
+		for _, idList := range stale.idLists {
+			delete(c.mapCardsByIDList, idList)
+		}
+		if stale.idCard == "" {
+			continue
+		}
+		// the actions do not always tell the list of the card, e.g. when its custom fields are changed
+		for idList := range c.mapCardsByIDList {
+			if c.findCardIndex(idList, stale.idCard) != -1 {
+				delete(c.mapCardsByIDList, idList)
+			}
+		}
+		delete(c.mapCommentsByIDCard, stale.idCard)
+		delete(c.mapChecklistsByIDCard, stale.idCard)
+		delete(c.mapAttachmentsByIDCard, stale.idCard)
+	}
+}
+
 func (c *CacheInMemory) FindComments(idCard string) (Comments, error) {
 	if c.mapCommentsByIDCard[idCard] != nil {
 		log.Debug().Str("idCard", idCard).Msg("fetching comments from cache")
@@ -208,6 +601,27 @@ func (c *CacheInMemory) DeleteComment(idCard, idComment string) error {
 	return nil
 }
 
+func (c *CacheInMemory) findBoardIndex(idBoard string) int {
+	if c.Boards == nil {
+		return -1
+	}
+	for i, cachedBoard := range *c.Boards {
+		if cachedBoard.ID == idBoard {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *CacheInMemory) findListIndex(idBoard, idList string) int {
+	for i, cachedList := range c.mapListsByIDBoard[idBoard] {
+		if cachedList.ID == idList {
+			return i
+		}
+	}
+	return -1
+}
+
 func (c *CacheInMemory) findCardIndex(idList, idCard string) int {
 	for i, cachedCard := range c.mapCardsByIDList[idList] {
 		if cachedCard.ID == idCard {
@@ -226,6 +640,32 @@ func (c *CacheInMemory) findCommentIndex(idCard, idComment string) int {
 	return -1
 }
 
+func (c *CacheInMemory) removeBoard(boardIndex int) {
+	if boardIndex == -1 || c.Boards == nil {
+		return
+	}
+	boards := *c.Boards
+	if boardIndex >= len(boards) {
+		return
+	}
+	boards = append(boards[:boardIndex], boards[boardIndex+1:]...)
+	c.Boards = &boards
+}
+
+func (c *CacheInMemory) removeList(idBoard string, listIndex int) {
+	if listIndex == -1 {
+		return
+	}
+	lists, found := c.mapListsByIDBoard[idBoard]
+	if !found {
+		return
+	}
+	if listIndex >= len(lists) {
+		return
+	}
+	c.mapListsByIDBoard[idBoard] = append(lists[:listIndex], lists[listIndex+1:]...)
+}
+
 func (c *CacheInMemory) removeCard(idList string, cardIndex int) {
 	if cardIndex == -1 {
 		return
@@ -0,0 +1,686 @@
+package trello
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/l-lin/tcli/conf"
+	"github.com/rs/zerolog/log"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	boardsCacheKind       = "boards"
+	labelsCacheKind       = "labels"
+	membersCacheKind      = "members"
+	listsCacheKind        = "lists"
+	cardsCacheKind        = "cards"
+	checklistsCacheKind   = "checklists"
+	attachmentsCacheKind  = "attachments"
+	commentsCacheKind     = "comments"
+	customFieldsCacheKind = "customFields"
+)
+
+// currentMemberCacheID is the ID under which the current member is cached, along with the members of the boards
+const currentMemberCacheID = "me"
+
+// CacheOnDisk is a decorator that caches the results of the proxified Repository
+// on the file system, so they are kept between executions until their TTL expires
+type CacheOnDisk struct {
+	r   Repository
+	dir string
+	ttl map[string]time.Duration // <cache kind, TTL>
+	now func() time.Time
+}
+
+// cacheEntry is the content of a cache file
+type cacheEntry struct {
+	CachedAt time.Time       `json:"cachedAt"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// NewCacheOnDisk creates a CacheOnDisk storing its files in the configured cache directory
+// (or "$XDG_CACHE_HOME/tcli" by default) in a sub-directory dedicated to the Trello account
+func NewCacheOnDisk(r Repository, c conf.Conf) Repository {
+	defaultTTL := conf.NewCacheTTL()
+	return &CacheOnDisk{
+		r:   r,
+		dir: filepath.Join(cacheDir(c), account(c)),
+		ttl: map[string]time.Duration{
+			boardsCacheKind:       parseTTL(boardsCacheKind, c.Cache.TTL.Boards, defaultTTL.Boards),
+			labelsCacheKind:       parseTTL(labelsCacheKind, c.Cache.TTL.Labels, defaultTTL.Labels),
+			membersCacheKind:      parseTTL(membersCacheKind, c.Cache.TTL.Members, defaultTTL.Members),
+			listsCacheKind:        parseTTL(listsCacheKind, c.Cache.TTL.Lists, defaultTTL.Lists),
+			cardsCacheKind:        parseTTL(cardsCacheKind, c.Cache.TTL.Cards, defaultTTL.Cards),
+			checklistsCacheKind:   parseTTL(checklistsCacheKind, c.Cache.TTL.Checklists, defaultTTL.Checklists),
+			attachmentsCacheKind:  parseTTL(attachmentsCacheKind, c.Cache.TTL.Attachments, defaultTTL.Attachments),
+			commentsCacheKind:     parseTTL(commentsCacheKind, c.Cache.TTL.Comments, defaultTTL.Comments),
+			customFieldsCacheKind: parseTTL(customFieldsCacheKind, c.Cache.TTL.CustomFields, defaultTTL.CustomFields),
+		},
+		now: time.Now,
+	}
+}
+
+// cacheDir returns the configured cache directory, or "$XDG_CACHE_HOME/tcli" by default
+func cacheDir(c conf.Conf) string {
+	if c.Cache.Dir != "" {
+		return c.Cache.Dir
+	}
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		log.Warn().Err(err).Msg("could not find user cache directory, using temporary directory instead")
+		userCacheDir = os.TempDir()
+	}
+	return filepath.Join(userCacheDir, "tcli")
+}
+
+// account identifies the Trello account, so the files of different accounts are not mixed up
+func account(c conf.Conf) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(c.Trello.BaseURL+c.Trello.AccessToken)))[:16]
+}
+
+func parseTTL(kind, ttl, defaultTTL string) time.Duration {
+	if ttl == "" {
+		ttl = defaultTTL
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		log.Warn().
+			Str("kind", kind).
+			Str("ttl", ttl).
+			Msg("invalid cache TTL, using default one instead")
+		d, _ = time.ParseDuration(defaultTTL)
+	}
+	return d
+}
+
+func (c *CacheOnDisk) Refresh() {
+	if err := os.RemoveAll(c.dir); err != nil {
+		log.Warn().Err(err).Str("dir", c.dir).Msg("could not purge the cache")
+	}
+	c.r.Refresh()
+}
+
+func (c *CacheOnDisk) Prefetch(idBoard string) error {
+	_, err := fetchBoardContent(c, idBoard)
+	return err
+}
+
+func (c *CacheOnDisk) FindBoards() (Boards, error) {
+	var boards Boards
+	if c.read(boardsCacheKind, "", &boards) {
+		log.Debug().Msg("fetching boards from disk cache")
+		return boards, nil
+	}
+	log.Debug().Msg("fetching boards from remote")
+	boards, err := c.r.FindBoards()
+	if err == nil {
+		c.write(boardsCacheKind, "", boards)
+	}
+	return boards, err
+}
+
+func (c *CacheOnDisk) FindBoard(query string) (*Board, error) {
+	boards, err := c.FindBoards()
+	if err != nil {
+		return nil, err
+	}
+	if board := FindBoard(boards, query); board != nil {
+		return board, nil
+	}
+	return nil, fmt.Errorf("no board found with query %s", query)
+}
+
+func (c *CacheOnDisk) CreateBoard(createBoard CreateBoard) (*Board, error) {
+	board, err := c.r.CreateBoard(createBoard)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(boardsCacheKind, "")
+	return board, nil
+}
+
+func (c *CacheOnDisk) UpdateBoard(updateBoard UpdateBoard) (*Board, error) {
+	board, err := c.r.UpdateBoard(updateBoard)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(boardsCacheKind, "")
+	if board.Closed {
+		c.invalidate(labelsCacheKind, updateBoard.ID)
+		c.invalidate(listsCacheKind, updateBoard.ID)
+	}
+	return board, nil
+}
+
+func (c *CacheOnDisk) CloseBoard(idBoard string) error {
+	if err := c.r.CloseBoard(idBoard); err != nil {
+		return err
+	}
+	c.invalidate(boardsCacheKind, "")
+	c.invalidate(labelsCacheKind, idBoard)
+	c.invalidate(listsCacheKind, idBoard)
+	return nil
+}
+
+func (c *CacheOnDisk) FindLabels(idBoard string) (Labels, error) {
+	var labels Labels
+	if c.read(labelsCacheKind, idBoard, &labels) {
+		log.Debug().Str("idBoard", idBoard).Msg("fetching labels from disk cache")
+		return labels, nil
+	}
+	log.Debug().Str("idBoard", idBoard).Msg("fetching labels from remote")
+	labels, err := c.r.FindLabels(idBoard)
+	if err == nil {
+		c.write(labelsCacheKind, idBoard, labels)
+	}
+	return labels, err
+}
+
+func (c *CacheOnDisk) CreateLabel(createLabel CreateLabel) (*Label, error) {
+	label, err := c.r.CreateLabel(createLabel)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(labelsCacheKind, createLabel.IDBoard)
+	return label, nil
+}
+
+func (c *CacheOnDisk) UpdateLabel(updateLabel UpdateLabel) (*Label, error) {
+	label, err := c.r.UpdateLabel(updateLabel)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(labelsCacheKind, updateLabel.IDBoard)
+	// the labels are embedded in the cards
+	c.invalidateAll(cardsCacheKind)
+	return label, nil
+}
+
+func (c *CacheOnDisk) DeleteLabel(idBoard, idLabel string) error {
+	if err := c.r.DeleteLabel(idBoard, idLabel); err != nil {
+		return err
+	}
+	c.invalidate(labelsCacheKind, idBoard)
+	c.invalidateAll(cardsCacheKind)
+	return nil
+}
+
+func (c *CacheOnDisk) FindMembers(idBoard string) (Members, error) {
+	var members Members
+	if c.read(membersCacheKind, idBoard, &members) {
+		log.Debug().Str("idBoard", idBoard).Msg("fetching members from disk cache")
+		return members, nil
+	}
+	log.Debug().Str("idBoard", idBoard).Msg("fetching members from remote")
+	members, err := c.r.FindMembers(idBoard)
+	if err == nil {
+		c.write(membersCacheKind, idBoard, members)
+	}
+	return members, err
+}
+
+func (c *CacheOnDisk) FindCurrentMember() (*Member, error) {
+	var member Member
+	if c.read(membersCacheKind, currentMemberCacheID, &member) {
+		log.Debug().Msg("fetching current member from disk cache")
+		return &member, nil
+	}
+	log.Debug().Msg("fetching current member from remote")
+	currentMember, err := c.r.FindCurrentMember()
+	if err == nil {
+		c.write(membersCacheKind, currentMemberCacheID, currentMember)
+	}
+	return currentMember, err
+}
+
+func (c *CacheOnDisk) FindLists(idBoard string) (Lists, error) {
+	var lists Lists
+	if c.read(listsCacheKind, idBoard, &lists) {
+		log.Debug().Str("idBoard", idBoard).Msg("fetching lists from disk cache")
+		return lists, nil
+	}
+	log.Debug().Str("idBoard", idBoard).Msg("fetching lists from remote")
+	lists, err := c.r.FindLists(idBoard)
+	if err == nil {
+		c.write(listsCacheKind, idBoard, lists)
+	}
+	return lists, err
+}
+
+func (c *CacheOnDisk) FindList(idBoard string, query string) (*List, error) {
+	lists, err := c.FindLists(idBoard)
+	if err != nil {
+		return nil, err
+	}
+	if list := FindList(lists, query); list != nil {
+		return list, nil
+	}
+	return nil, fmt.Errorf("no list found with query %s", query)
+}
+
+func (c *CacheOnDisk) CreateList(createList CreateList) (*List, error) {
+	list, err := c.r.CreateList(createList)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(listsCacheKind, createList.IDBoard)
+	return list, nil
+}
+
+func (c *CacheOnDisk) UpdateList(updateList UpdateList) (*List, error) {
+	list, err := c.r.UpdateList(updateList)
+	if err != nil {
+		return nil, err
+	}
+	// list may have been moved to another board, so clear all lists
+	c.invalidateAll(listsCacheKind)
+	if list.Closed {
+		c.invalidate(cardsCacheKind, updateList.ID)
+	}
+	return list, nil
+}
+
+func (c *CacheOnDisk) ArchiveList(idBoard, idList string) error {
+	if err := c.r.ArchiveList(idBoard, idList); err != nil {
+		return err
+	}
+	c.invalidate(listsCacheKind, idBoard)
+	c.invalidate(cardsCacheKind, idList)
+	return nil
+}
+
+// the archived lists and cards are not cached, as they are only fetched to be listed or restored
+
+func (c *CacheOnDisk) FindArchivedLists(idBoard string) (Lists, error) {
+	return c.r.FindArchivedLists(idBoard)
+}
+
+func (c *CacheOnDisk) FindArchivedCards(idList string) (Cards, error) {
+	return c.r.FindArchivedCards(idList)
+}
+
+func (c *CacheOnDisk) FindCards(idList string) (Cards, error) {
+	var cards Cards
+	if c.read(cardsCacheKind, idList, &cards) {
+		log.Debug().Str("idList", idList).Msg("fetching cards from disk cache")
+		return cards, nil
+	}
+	log.Debug().Str("idList", idList).Msg("fetching cards from remote")
+	cards, err := c.r.FindCards(idList)
+	if err == nil {
+		c.write(cardsCacheKind, idList, cards)
+	}
+	return cards, err
+}
+
+func (c *CacheOnDisk) FindCard(idList string, query string) (*Card, error) {
+	cards, err := c.FindCards(idList)
+	if err != nil {
+		return nil, err
+	}
+	if card := FindCard(cards, query); card != nil {
+		return card, nil
+	}
+	return nil, fmt.Errorf("no card found with query %s", query)
+}
+
+func (c *CacheOnDisk) ArchiveAllCards(idList string) error {
+	if err := c.r.ArchiveAllCards(idList); err != nil {
+		return err
+	}
+	c.invalidate(cardsCacheKind, idList)
+	return nil
+}
+
+func (c *CacheOnDisk) CreateCard(createCard CreateCard) (*Card, error) {
+	card, err := c.r.CreateCard(createCard)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(cardsCacheKind, createCard.IDList)
+	return card, nil
+}
+
+func (c *CacheOnDisk) UpdateCard(updateCard UpdateCard) (*Card, error) {
+	card, err := c.r.UpdateCard(updateCard)
+	if err != nil {
+		return nil, err
+	}
+	// card may have been moved to another list, so clear all cards
+	c.invalidateAll(cardsCacheKind)
+	return card, nil
+}
+
+func (c *CacheOnDisk) SearchCards(query string, idBoards ...string) (Cards, error) {
+	// search results are not cached as they depend on the query
+	return c.r.SearchCards(query, idBoards...)
+}
+
+func (c *CacheOnDisk) FindChecklists(idCard string) (Checklists, error) {
+	var checklists Checklists
+	if c.read(checklistsCacheKind, idCard, &checklists) {
+		log.Debug().Str("idCard", idCard).Msg("fetching checklists from disk cache")
+		return checklists, nil
+	}
+	log.Debug().Str("idCard", idCard).Msg("fetching checklists from remote")
+	checklists, err := c.r.FindChecklists(idCard)
+	if err == nil {
+		c.write(checklistsCacheKind, idCard, checklists)
+	}
+	return checklists, err
+}
+
+func (c *CacheOnDisk) CreateChecklist(createChecklist CreateChecklist) (*Checklist, error) {
+	checklist, err := c.r.CreateChecklist(createChecklist)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(checklistsCacheKind, createChecklist.IDCard)
+	return checklist, nil
+}
+
+func (c *CacheOnDisk) UpdateChecklist(updateChecklist UpdateChecklist) (*Checklist, error) {
+	checklist, err := c.r.UpdateChecklist(updateChecklist)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(checklistsCacheKind, updateChecklist.IDCard)
+	return checklist, nil
+}
+
+func (c *CacheOnDisk) DeleteChecklist(idCard, idChecklist string) error {
+	if err := c.r.DeleteChecklist(idCard, idChecklist); err != nil {
+		return err
+	}
+	c.invalidate(checklistsCacheKind, idCard)
+	return nil
+}
+
+func (c *CacheOnDisk) CreateCheckItem(createCheckItem CreateCheckItem) (*CheckItem, error) {
+	checkItem, err := c.r.CreateCheckItem(createCheckItem)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(checklistsCacheKind, createCheckItem.IDCard)
+	return checkItem, nil
+}
+
+func (c *CacheOnDisk) UpdateCheckItem(updateCheckItem UpdateCheckItem) (*CheckItem, error) {
+	checkItem, err := c.r.UpdateCheckItem(updateCheckItem)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(checklistsCacheKind, updateCheckItem.IDCard)
+	return checkItem, nil
+}
+
+func (c *CacheOnDisk) DeleteCheckItem(idCard, idCheckItem string) error {
+	if err := c.r.DeleteCheckItem(idCard, idCheckItem); err != nil {
+		return err
+	}
+	c.invalidate(checklistsCacheKind, idCard)
+	return nil
+}
+
+func (c *CacheOnDisk) FindCustomFields(idBoard string) (CustomFields, error) {
+	var customFields CustomFields
+	if c.read(customFieldsCacheKind, idBoard, &customFields) {
+		log.Debug().Str("idBoard", idBoard).Msg("fetching custom fields from disk cache")
+		return customFields, nil
+	}
+	log.Debug().Str("idBoard", idBoard).Msg("fetching custom fields from remote")
+	customFields, err := c.r.FindCustomFields(idBoard)
+	if err == nil {
+		c.write(customFieldsCacheKind, idBoard, customFields)
+	}
+	return customFields, err
+}
+
+func (c *CacheOnDisk) UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	customFieldItem, err := c.r.UpdateCustomFieldItem(updateCustomFieldItem)
+	if err != nil {
+		return nil, err
+	}
+	// the values of the custom fields are embedded in the cards, whose list is unknown
+	c.invalidateAll(cardsCacheKind)
+	return customFieldItem, nil
+}
+
+func (c *CacheOnDisk) FindAttachments(idCard string) (Attachments, error) {
+	var attachments Attachments
+	if c.read(attachmentsCacheKind, idCard, &attachments) {
+		log.Debug().Str("idCard", idCard).Msg("fetching attachments from disk cache")
+		return attachments, nil
+	}
+	log.Debug().Str("idCard", idCard).Msg("fetching attachments from remote")
+	attachments, err := c.r.FindAttachments(idCard)
+	if err == nil {
+		c.write(attachmentsCacheKind, idCard, attachments)
+	}
+	return attachments, err
+}
+
+func (c *CacheOnDisk) CreateAttachment(createAttachment CreateAttachment) (*Attachment, error) {
+	attachment, err := c.r.CreateAttachment(createAttachment)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(attachmentsCacheKind, createAttachment.IDCard)
+	return attachment, nil
+}
+
+func (c *CacheOnDisk) DownloadAttachment(attachment Attachment, w io.Writer) error {
+	// the content of the attachments is not cached
+	return c.r.DownloadAttachment(attachment, w)
+}
+
+func (c *CacheOnDisk) DeleteAttachment(idCard, idAttachment string) error {
+	if err := c.r.DeleteAttachment(idCard, idAttachment); err != nil {
+		return err
+	}
+	c.invalidate(attachmentsCacheKind, idCard)
+	return nil
+}
+
+// the activity is never cached, so it is always up to date
+
+func (c *CacheOnDisk) FindWebhooks() (Webhooks, error) {
+	return c.r.FindWebhooks()
+}
+
+func (c *CacheOnDisk) CreateWebhook(createWebhook CreateWebhook) (*Webhook, error) {
+	return c.r.CreateWebhook(createWebhook)
+}
+
+func (c *CacheOnDisk) DeleteWebhook(idWebhook string) error {
+	return c.r.DeleteWebhook(idWebhook)
+}
+
+func (c *CacheOnDisk) FindCardActions(idCard string, since time.Time) (Actions, error) {
+	return c.r.FindCardActions(idCard, since)
+}
+
+func (c *CacheOnDisk) FindBoardActions(idBoard string, since time.Time) (Actions, error) {
+	actions, err := c.r.FindBoardActions(idBoard, since)
+	if err == nil {
+		c.evict(actions)
+	}
+	return actions, err
+}
+
+// Forget removes from the disk cache the resources changed by the given actions, performed outside tcli
+func (c *CacheOnDisk) Forget(actions Actions) {
+	c.evict(actions)
+	c.r.Forget(actions)
+}
+
+// evict removes from the disk cache the resources changed by the given actions
+func (c *CacheOnDisk) evict(actions Actions) {
+	for _, action := range actions {
+		stale := action.staleResources()
+		if stale.board {
+			c.invalidate(boardsCacheKind, "")
+		}
+		if stale.lists {
+			c.invalidate(listsCacheKind, stale.idBoard)
+		}
+		if stale.labels {
+			c.invalidate(labelsCacheKind, stale.idBoard)
+		}
+		if stale.members {
+			c.invalidate(membersCacheKind, stale.idBoard)
+		}
+		if stale.customFields {
+			c.invalidate(customFieldsCacheKind, stale.idBoard)
+		}
+		for _, idList := range stale.idLists {
+			c.invalidate(cardsCacheKind, idList)
+		}
+		if stale.idCard != "" {
+			c.invalidate(commentsCacheKind, stale.idCard)
+			c.invalidate(checklistsCacheKind, stale.idCard)
+			c.invalidate(attachmentsCacheKind, stale.idCard)
+		}
+	}
+}
+
+func (c *CacheOnDisk) FindComments(idCard string) (Comments, error) {
+	var comments Comments
+	if c.read(commentsCacheKind, idCard, &comments) {
+		log.Debug().Str("idCard", idCard).Msg("fetching comments from disk cache")
+		return comments, nil
+	}
+	log.Debug().Str("idCard", idCard).Msg("fetching comments from remote")
+	comments, err := c.r.FindComments(idCard)
+	if err == nil {
+		c.write(commentsCacheKind, idCard, comments)
+	}
+	return comments, err
+}
+
+func (c *CacheOnDisk) FindComment(idCard string, idComment string) (*Comment, error) {
+	comments, err := c.FindComments(idCard)
+	if err != nil {
+		return nil, err
+	}
+	if comment := FindComment(comments, idComment); comment != nil {
+		return comment, nil
+	}
+	return nil, fmt.Errorf("no comment found with id %s", idComment)
+}
+
+func (c *CacheOnDisk) CreateComment(createComment CreateComment) (*Comment, error) {
+	comment, err := c.r.CreateComment(createComment)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(commentsCacheKind, createComment.IDCard)
+	return comment, nil
+}
+
+func (c *CacheOnDisk) UpdateComment(updateComment UpdateComment) (*Comment, error) {
+	comment, err := c.r.UpdateComment(updateComment)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(commentsCacheKind, updateComment.IDCard)
+	return comment, nil
+}
+
+func (c *CacheOnDisk) DeleteComment(idCard, idComment string) error {
+	if err := c.r.DeleteComment(idCard, idComment); err != nil {
+		return err
+	}
+	c.invalidate(commentsCacheKind, idCard)
+	return nil
+}
+
+// path of the cache file of the given kind, the boards being stored in a single file
+// whereas the other resources are stored in a file per parent ID
+func (c *CacheOnDisk) path(kind, id string) string {
+	if id == "" {
+		return filepath.Join(c.dir, kind+".json")
+	}
+	return filepath.Join(c.dir, kind, url.PathEscape(id)+".json")
+}
+
+// read the cached resources in v, returning false if they are absent or expired
+func (c *CacheOnDisk) read(kind, id string, v interface{}) bool {
+	ttl := c.ttl[kind]
+	if ttl <= 0 {
+		return false
+	}
+	b, err := ioutil.ReadFile(c.path(kind, id))
+	if err != nil {
+		return false
+	}
+	var entry cacheEntry
+	if err = json.Unmarshal(b, &entry); err != nil {
+		log.Debug().Err(err).Str("kind", kind).Str("id", id).Msg("could not read cache entry")
+		return false
+	}
+	if c.now().Sub(entry.CachedAt) >= ttl {
+		return false
+	}
+	return json.Unmarshal(entry.Data, v) == nil
+}
+
+func (c *CacheOnDisk) write(kind, id string, v interface{}) {
+	if c.ttl[kind] <= 0 {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Debug().Err(err).Str("kind", kind).Str("id", id).Msg("could not marshal cache entry")
+		return
+	}
+	b, err := json.Marshal(cacheEntry{CachedAt: c.now(), Data: data})
+	if err != nil {
+		log.Debug().Err(err).Str("kind", kind).Str("id", id).Msg("could not marshal cache entry")
+		return
+	}
+	p := c.path(kind, id)
+	if err = writeFile(p, b); err != nil {
+		log.Debug().Err(err).Str("path", p).Msg("could not write cache entry")
+	}
+}
+
+// writeFile writes in a temporary file first, so concurrent executions never read a partial file
+func writeFile(p string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(p), filepath.Base(p))
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(b)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), p)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (c *CacheOnDisk) invalidate(kind, id string) {
+	if err := os.Remove(c.path(kind, id)); err != nil && !os.IsNotExist(err) {
+		log.Debug().Err(err).Str("kind", kind).Str("id", id).Msg("could not invalidate cache entry")
+	}
+}
+
+func (c *CacheOnDisk) invalidateAll(kind string) {
+	if err := os.RemoveAll(filepath.Join(c.dir, kind)); err != nil {
+		log.Debug().Err(err).Str("kind", kind).Msg("could not invalidate cache entries")
+	}
+}
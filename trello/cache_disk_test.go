@@ -0,0 +1,318 @@
+package trello
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/conf"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCacheOnDisk_FindBoards(t *testing.T) {
+	boards := Boards{
+		{ID: "board 1", Name: "board"},
+		{ID: "board 2", Name: "another board"},
+	}
+
+	t.Run("cached between executions", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			FindBoards().
+			Return(boards, nil).
+			Times(1)
+		c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+
+		// WHEN
+		actual1, err1 := NewCacheOnDisk(r, c).FindBoards()
+		actual2, err2 := NewCacheOnDisk(r, c).FindBoards()
+
+		// THEN
+		if err1 != nil || err2 != nil {
+			t.Error("expected no error")
+		}
+		if !reflect.DeepEqual(boards, actual1) || !reflect.DeepEqual(boards, actual2) {
+			t.Errorf("expected %v, actual1 %v, actual2 %v", boards, actual1, actual2)
+		}
+	})
+	t.Run("TTL expired", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			FindBoards().
+			Return(boards, nil).
+			Times(2)
+		c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir(), TTL: conf.CacheTTL{Boards: "10m"}}}
+		cr := NewCacheOnDisk(r, c).(*CacheOnDisk)
+		now := time.Now()
+		cr.now = func() time.Time { return now }
+
+		// WHEN
+		_, err1 := cr.FindBoards()
+		now = now.Add(5 * time.Minute)
+		_, err2 := cr.FindBoards()
+		now = now.Add(5 * time.Minute)
+		actual, err3 := cr.FindBoards()
+
+		// THEN
+		if err1 != nil || err2 != nil || err3 != nil {
+			t.Error("expected no error")
+		}
+		if !reflect.DeepEqual(boards, actual) {
+			t.Errorf("expected %v, actual %v", boards, actual)
+		}
+	})
+	t.Run("cache disabled", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			FindBoards().
+			Return(boards, nil).
+			Times(2)
+		cr := NewCacheOnDisk(r, conf.Conf{Cache: conf.Cache{Dir: t.TempDir(), TTL: conf.CacheTTL{Boards: "0"}}})
+
+		// WHEN
+		_, err1 := cr.FindBoards()
+		_, err2 := cr.FindBoards()
+
+		// THEN
+		if err1 != nil || err2 != nil {
+			t.Error("expected no error")
+		}
+	})
+	t.Run("different Trello accounts", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			FindBoards().
+			Return(boards, nil).
+			Times(2)
+		dir := t.TempDir()
+
+		// WHEN
+		_, err1 := NewCacheOnDisk(r, conf.Conf{Trello: conf.Trello{AccessToken: "token 1"}, Cache: conf.Cache{Dir: dir}}).FindBoards()
+		_, err2 := NewCacheOnDisk(r, conf.Conf{Trello: conf.Trello{AccessToken: "token 2"}, Cache: conf.Cache{Dir: dir}}).FindBoards()
+
+		// THEN
+		if err1 != nil || err2 != nil {
+			t.Error("expected no error")
+		}
+	})
+}
+
+func TestCacheOnDisk_FindCards(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	idList := "list 1"
+	expected := Cards{
+		{ID: "card 1", Name: "card", IDList: idList, Pos: 1, Labels: Labels{{ID: "label 1", Name: "label", Color: "red"}}},
+		{ID: "card 2", Name: "another card", IDList: idList, Pos: 2},
+	}
+	r.EXPECT().
+		FindCards(idList).
+		Return(expected, nil).
+		Times(1)
+	r.EXPECT().
+		FindCards("list 2").
+		Return(Cards{}, nil).
+		Times(1)
+	c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+
+	// WHEN
+	actual1, err1 := NewCacheOnDisk(r, c).FindCards(idList)
+	actual2, err2 := NewCacheOnDisk(r, c).FindCards(idList)
+	_, err3 := NewCacheOnDisk(r, c).FindCards("list 2")
+
+	// THEN
+	if err1 != nil || err2 != nil || err3 != nil {
+		t.Error("expected no error")
+	}
+	if !reflect.DeepEqual(expected, actual1) || !reflect.DeepEqual(expected, actual2) {
+		t.Errorf("expected %v, actual1 %v, actual2 %v", expected, actual1, actual2)
+	}
+}
+
+func TestCacheOnDisk_CreateCard(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	idList := "list 1"
+	card := Card{ID: "card 1", Name: "card", IDList: idList}
+	createdCard := Card{ID: "card 2", Name: "another card", IDList: idList}
+	createCard := CreateCard{Name: createdCard.Name, IDList: idList}
+	gomock.InOrder(
+		r.EXPECT().
+			FindCards(idList).
+			Return(Cards{card}, nil),
+		r.EXPECT().
+			CreateCard(createCard).
+			Return(&createdCard, nil),
+		r.EXPECT().
+			FindCards(idList).
+			Return(Cards{card, createdCard}, nil),
+	)
+	cr := NewCacheOnDisk(r, conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}})
+
+	// WHEN
+	_, err1 := cr.FindCards(idList)
+	_, err2 := cr.CreateCard(createCard)
+	actual, err3 := cr.FindCards(idList)
+
+	// THEN
+	if err1 != nil || err2 != nil || err3 != nil {
+		t.Error("expected no error")
+	}
+	expected := Cards{card, createdCard}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
+func TestCacheOnDisk_UpdateList(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	list := List{ID: "list 1", Name: "list", IDBoard: "board 1"}
+	movedList := List{ID: "list 1", Name: "list", IDBoard: "board 2"}
+	updateList := NewUpdateList(movedList)
+	gomock.InOrder(
+		r.EXPECT().
+			FindLists("board 1").
+			Return(Lists{list}, nil),
+		r.EXPECT().
+			UpdateList(updateList).
+			Return(&movedList, nil),
+		r.EXPECT().
+			FindLists("board 1").
+			Return(Lists{}, nil),
+	)
+	cr := NewCacheOnDisk(r, conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}})
+
+	// WHEN
+	_, err1 := cr.FindLists("board 1")
+	_, err2 := cr.UpdateList(updateList)
+	actual, err3 := cr.FindLists("board 1")
+
+	// THEN
+	if err1 != nil || err2 != nil || err3 != nil {
+		t.Error("expected no error")
+	}
+	if len(actual) != 0 {
+		t.Errorf("expected no list, actual %v", actual)
+	}
+}
+
+func TestCacheOnDisk_DeleteComment(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	idCard := "card 1"
+	comment := Comment{ID: "comment 1", Data: CommentData{Text: "comment"}}
+	gomock.InOrder(
+		r.EXPECT().
+			FindComments(idCard).
+			Return(Comments{comment}, nil),
+		r.EXPECT().
+			DeleteComment(idCard, comment.ID).
+			Return(nil),
+		r.EXPECT().
+			FindComments(idCard).
+			Return(Comments{}, nil),
+	)
+	cr := NewCacheOnDisk(r, conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}})
+
+	// WHEN
+	_, err1 := cr.FindComment(idCard, comment.ID)
+	err2 := cr.DeleteComment(idCard, comment.ID)
+	_, err3 := cr.FindComment(idCard, comment.ID)
+
+	// THEN
+	if err1 != nil || err2 != nil {
+		t.Error("expected no error")
+	}
+	if err3 == nil {
+		t.Error("expected an error as the comment was deleted")
+	}
+}
+
+func TestCacheOnDisk_FindBoardActions(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	idBoard := "board 1"
+	card := Card{ID: "card 1", Name: "card", IDList: "list 2"}
+	actions := Actions{
+		{
+			ID:   "action 1",
+			Type: updateCardActionType,
+			Data: ActionData{
+				Card:       &ActionCard{ID: card.ID, IDList: "list 2"},
+				ListBefore: &ActionResource{ID: "list 1"},
+				ListAfter:  &ActionResource{ID: "list 2"},
+			},
+		},
+	}
+	gomock.InOrder(
+		r.EXPECT().FindCards("list 1").Return(Cards{card}, nil),
+		r.EXPECT().FindBoardActions(idBoard, time.Time{}).Return(actions, nil),
+		r.EXPECT().FindCards("list 1").Return(Cards{}, nil),
+	)
+	cr := NewCacheOnDisk(r, conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}})
+
+	// WHEN
+	_, err1 := cr.FindCards("list 1")
+	_, err2 := cr.FindBoardActions(idBoard, time.Time{})
+	actual, err3 := cr.FindCards("list 1")
+
+	// THEN
+	if err1 != nil || err2 != nil || err3 != nil {
+		t.Error("expected no error")
+	}
+	if len(actual) != 0 {
+		t.Errorf("expected the card moved out of the list to be fetched again, actual %v", actual)
+	}
+}
+
+func TestCacheOnDisk_Refresh(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	r.EXPECT().
+		FindLabels("board 1").
+		Return(Labels{{ID: "label 1", Name: "label"}}, nil).
+		Times(2)
+	r.EXPECT().
+		Refresh().
+		Times(2)
+	cr := NewCacheOnDisk(r, conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}).(*CacheOnDisk)
+
+	// WHEN
+	_, err1 := cr.FindLabels("board 1")
+	cr.Refresh()
+	_, err2 := cr.FindLabels("board 1")
+
+	// THEN
+	if err1 != nil || err2 != nil {
+		t.Error("expected no error")
+	}
+	cr.Refresh()
+	if _, err := os.Stat(cr.dir); !os.IsNotExist(err) {
+		t.Errorf("expected cache directory %s to be purged", cr.dir)
+	}
+}
@@ -5,8 +5,99 @@ import (
 	"github.com/golang/mock/gomock"
 	"reflect"
 	"testing"
+	"time"
 )
 
+func TestCacheInMemory_Prefetch(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		labels := Labels{{ID: "label 1", IDBoard: "board 1", Name: "bug"}}
+		lists := Lists{
+			{ID: "list 1", Name: "todo"},
+			{ID: "list 2", Name: "doing"},
+		}
+		cards1 := Cards{{ID: "card 1", Name: "card 1", IDList: "list 1"}}
+		cards2 := Cards{{ID: "card 2", Name: "card 2", IDList: "list 2"}}
+		r.EXPECT().
+			FindLabels("board 1").
+			Return(labels, nil).
+			Times(1)
+		r.EXPECT().
+			FindLists("board 1").
+			Return(lists, nil).
+			Times(1)
+		r.EXPECT().
+			FindCards("list 1").
+			Return(cards1, nil).
+			Times(1)
+		r.EXPECT().
+			FindCards("list 2").
+			Return(cards2, nil).
+			Times(1)
+		cr := NewCacheInMemory(r)
+
+		// WHEN
+		err := cr.Prefetch("board 1")
+
+		// THEN
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+		// already prefetched
+		if err = cr.Prefetch("board 1"); err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+		// everything is fetched from the cache
+		actualLabels, _ := cr.FindLabels("board 1")
+		actualLists, _ := cr.FindLists("board 1")
+		actualCards1, _ := cr.FindCards("list 1")
+		actualCards2, _ := cr.FindCards("list 2")
+		if !reflect.DeepEqual(labels, actualLabels) || !reflect.DeepEqual(lists, actualLists) {
+			t.Errorf("expected labels %v and lists %v, actual labels %v and lists %v", labels, lists, actualLabels, actualLists)
+		}
+		if !reflect.DeepEqual(cards1, actualCards1) || !reflect.DeepEqual(cards2, actualCards2) {
+			t.Errorf("expected cards %v and %v, actual %v and %v", cards1, cards2, actualCards1, actualCards2)
+		}
+	})
+	t.Run("nothing cached when a list could not be fetched", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		lists := Lists{
+			{ID: "list 1", Name: "todo"},
+			{ID: "list 2", Name: "doing"},
+		}
+		r.EXPECT().
+			FindLabels("board 1").
+			Return(Labels{}, nil)
+		r.EXPECT().
+			FindLists("board 1").
+			Return(lists, nil)
+		r.EXPECT().
+			FindCards("list 1").
+			Return(Cards{}, nil)
+		r.EXPECT().
+			FindCards("list 2").
+			Return(nil, errors.New("unexpected error"))
+		cr := NewCacheInMemory(r).(*CacheInMemory)
+
+		// WHEN
+		err := cr.Prefetch("board 1")
+
+		// THEN
+		if err == nil {
+			t.Error("expected an error")
+		}
+		if len(cr.mapListsByIDBoard) != 0 || len(cr.mapLabelsByIDBoard) != 0 || len(cr.mapCardsByIDList) != 0 {
+			t.Errorf("expected nothing to be cached")
+		}
+	})
+}
+
 func TestCacheInMemory_FindBoards(t *testing.T) {
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -111,6 +202,210 @@ func TestCacheInMemory_FindBoard(t *testing.T) {
 	})
 }
 
+func TestCacheInMemory_CreateBoard(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		expected := Board{ID: "board 1", Name: "board"}
+		createBoard := CreateBoard{Name: expected.Name}
+		r.EXPECT().
+			CreateBoard(createBoard).
+			Return(&expected, nil)
+		cr := &CacheInMemory{
+			r:      r,
+			Boards: &Boards{{ID: "board 2", Name: "another board"}},
+		}
+
+		// WHEN
+		actual, err := cr.CreateBoard(createBoard)
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		if actual == nil {
+			t.Error("expected no nil board returned")
+			t.FailNow()
+		}
+		if len(*cr.Boards) != 2 || (*cr.Boards)[1] != expected || *actual != expected {
+			t.Errorf("expected %v, actual %v, boards in cache %v", expected, actual, *cr.Boards)
+		}
+	})
+	t.Run("error when creating board", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		createBoard := CreateBoard{Name: "board"}
+		r.EXPECT().
+			CreateBoard(createBoard).
+			Return(nil, errors.New("unexpected error"))
+		cr := &CacheInMemory{
+			r:      r,
+			Boards: &Boards{{ID: "board 2", Name: "another board"}},
+		}
+
+		// WHEN
+		actual, err := cr.CreateBoard(createBoard)
+
+		// THEN
+		if err == nil {
+			t.Error("expected error")
+		}
+		if actual != nil {
+			t.Errorf("expected nil board returned, got %v", actual)
+		}
+		if len(*cr.Boards) != 1 {
+			t.Errorf("expected cache to be unchanged, got %v", *cr.Boards)
+		}
+	})
+}
+
+func TestCacheInMemory_UpdateBoard(t *testing.T) {
+	t.Run("update board", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		expected := Board{ID: "board 1", Name: "updated board"}
+		r.EXPECT().
+			UpdateBoard(NewUpdateBoard(expected)).
+			Return(&expected, nil)
+		cr := &CacheInMemory{
+			r: r,
+			Boards: &Boards{
+				{ID: "board 1", Name: "board"},
+				{ID: "board 2", Name: "another board"},
+			},
+		}
+
+		// WHEN
+		actual, err := cr.UpdateBoard(NewUpdateBoard(expected))
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		if actual == nil || *actual != expected {
+			t.Errorf("expected %v, actual %v", expected, actual)
+		}
+		if (*cr.Boards)[0] != expected {
+			t.Errorf("expected %v, board in cache %v", expected, (*cr.Boards)[0])
+		}
+	})
+	t.Run("close board", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		expected := Board{ID: "board 1", Name: "board", Closed: true}
+		r.EXPECT().
+			UpdateBoard(NewUpdateBoard(expected)).
+			Return(&expected, nil)
+		cr := &CacheInMemory{
+			r: r,
+			Boards: &Boards{
+				{ID: "board 1", Name: "board"},
+				{ID: "board 2", Name: "another board"},
+			},
+		}
+
+		// WHEN
+		_, err := cr.UpdateBoard(NewUpdateBoard(expected))
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		if len(*cr.Boards) != 1 || (*cr.Boards)[0].ID != "board 2" {
+			t.Errorf("expected closed board to be removed from cache, got %v", *cr.Boards)
+		}
+	})
+	t.Run("error when updating board", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		board := Board{ID: "board 1", Name: "board"}
+		r.EXPECT().
+			UpdateBoard(NewUpdateBoard(board)).
+			Return(nil, errors.New("unexpected error"))
+		cr := &CacheInMemory{r: r}
+
+		// WHEN
+		actual, err := cr.UpdateBoard(NewUpdateBoard(board))
+
+		// THEN
+		if err == nil {
+			t.Error("expected error")
+		}
+		if actual != nil {
+			t.Errorf("expected nil board returned, got %v", actual)
+		}
+	})
+}
+
+func TestCacheInMemory_CloseBoard(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			CloseBoard("board 1").
+			Return(nil)
+		cr := &CacheInMemory{
+			r: r,
+			Boards: &Boards{
+				{ID: "board 1", Name: "board"},
+				{ID: "board 2", Name: "another board"},
+			},
+			mapLabelsByIDBoard: map[string]Labels{"board 1": {{ID: "label 1"}}},
+			mapListsByIDBoard:  map[string]Lists{"board 1": {{ID: "list 1"}}},
+		}
+
+		// WHEN
+		err := cr.CloseBoard("board 1")
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		if len(*cr.Boards) != 1 || (*cr.Boards)[0].ID != "board 2" {
+			t.Errorf("expected closed board to be removed from cache, got %v", *cr.Boards)
+		}
+		if cr.mapLabelsByIDBoard["board 1"] != nil || cr.mapListsByIDBoard["board 1"] != nil {
+			t.Error("expected labels and lists of the closed board to be removed from cache")
+		}
+	})
+	t.Run("error when closing board", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			CloseBoard("board 1").
+			Return(errors.New("unexpected error"))
+		cr := &CacheInMemory{
+			r:      r,
+			Boards: &Boards{{ID: "board 1", Name: "board"}},
+		}
+
+		// WHEN
+		err := cr.CloseBoard("board 1")
+
+		// THEN
+		if err == nil {
+			t.Error("expected error")
+		}
+		if len(*cr.Boards) != 1 {
+			t.Errorf("expected cache to be unchanged, got %v", *cr.Boards)
+		}
+	})
+}
+
 func TestCacheInMemory_FindLabels(t *testing.T) {
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -146,6 +441,78 @@ func TestCacheInMemory_FindLabels(t *testing.T) {
 	}
 }
 
+func TestCacheInMemory_UpdateLabel(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	label := Label{ID: "label 1", IDBoard: "board 1", Color: "red", Name: "bug"}
+	updatedLabel := Label{ID: "label 1", IDBoard: "board 1", Color: "red", Name: "defect"}
+	card := Card{ID: "card 1", IDList: "list 1", Labels: Labels{label}}
+	updatedCard := Card{ID: "card 1", IDList: "list 1", Labels: Labels{updatedLabel}}
+	updateLabel := NewUpdateLabel(label, "defect")
+	gomock.InOrder(
+		r.EXPECT().
+			FindLabels("board 1").
+			Return(Labels{label}, nil),
+		r.EXPECT().
+			FindCards("list 1").
+			Return(Cards{card}, nil),
+		r.EXPECT().
+			UpdateLabel(updateLabel).
+			Return(&updatedLabel, nil),
+		r.EXPECT().
+			FindLabels("board 1").
+			Return(Labels{updatedLabel}, nil),
+		r.EXPECT().
+			FindCards("list 1").
+			Return(Cards{updatedCard}, nil),
+	)
+	cr := NewCacheInMemory(r)
+
+	// WHEN
+	_, err1 := cr.FindLabels("board 1")
+	_, err2 := cr.FindCards("list 1")
+	_, err3 := cr.UpdateLabel(updateLabel)
+	actualLabels, err4 := cr.FindLabels("board 1")
+	actualCards, err5 := cr.FindCards("list 1")
+
+	// THEN
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil {
+		t.Error("expected no error")
+	}
+	if len(actualLabels) != 1 || actualLabels[0] != updatedLabel {
+		t.Errorf("expected %v, actual %v", Labels{updatedLabel}, actualLabels)
+	}
+	if len(actualCards) != 1 || actualCards[0].Labels[0] != updatedLabel {
+		t.Errorf("expected %v, actual %v", Cards{updatedCard}, actualCards)
+	}
+}
+func TestCacheInMemory_FindCurrentMember(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	expected := &Member{ID: "member 1", Username: "member1"}
+	r.EXPECT().
+		FindCurrentMember().
+		Return(expected, nil).
+		Times(1)
+	cr := NewCacheInMemory(r)
+
+	// WHEN
+	actual1, err1 := cr.FindCurrentMember()
+	actual2, err2 := cr.FindCurrentMember()
+
+	// THEN
+	if err1 != nil || err2 != nil {
+		t.Error("expected no error")
+	}
+	if *actual1 != *expected || *actual2 != *expected {
+		t.Errorf("expected %v, actual1 %v, actual2 %v", expected, actual1, actual2)
+	}
+}
+
 func TestCacheInMemory_FindLists(t *testing.T) {
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -255,6 +622,255 @@ func TestCacheInMemory_FindList(t *testing.T) {
 	})
 }
 
+func TestCacheInMemory_CreateList(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		expected := List{ID: "list 1", Name: "list", IDBoard: "board 1"}
+		createList := CreateList{Name: expected.Name, IDBoard: expected.IDBoard}
+		r.EXPECT().
+			CreateList(createList).
+			Return(&expected, nil)
+		cr := &CacheInMemory{
+			r: r,
+			mapListsByIDBoard: map[string]Lists{
+				expected.IDBoard: {{ID: "list 2", Name: "another list", IDBoard: expected.IDBoard}},
+			},
+		}
+
+		// WHEN
+		actual, err := cr.CreateList(createList)
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		if actual == nil || *actual != expected {
+			t.Errorf("expected %v, actual %v", expected, actual)
+		}
+		if listInCache := cr.mapListsByIDBoard[expected.IDBoard][1]; listInCache != expected {
+			t.Errorf("expected %v, list in cache %v", expected, listInCache)
+		}
+	})
+	t.Run("error when creating list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		createList := CreateList{Name: "list", IDBoard: "board 1"}
+		r.EXPECT().
+			CreateList(createList).
+			Return(nil, errors.New("unexpected error"))
+		cr := &CacheInMemory{
+			r:                 r,
+			mapListsByIDBoard: map[string]Lists{},
+		}
+
+		// WHEN
+		actual, err := cr.CreateList(createList)
+
+		// THEN
+		if err == nil {
+			t.Error("expected error")
+		}
+		if actual != nil {
+			t.Errorf("expected nil list returned, got %v", actual)
+		}
+		if len(cr.mapListsByIDBoard) != 0 {
+			t.Errorf("expected cache to be unchanged, got %v", cr.mapListsByIDBoard)
+		}
+	})
+}
+
+func TestCacheInMemory_UpdateList(t *testing.T) {
+	t.Run("update list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		expected := List{ID: "list 1", Name: "updated list", IDBoard: "board 1"}
+		r.EXPECT().
+			UpdateList(NewUpdateList(expected)).
+			Return(&expected, nil)
+		cr := &CacheInMemory{
+			r: r,
+			mapListsByIDBoard: map[string]Lists{
+				expected.IDBoard: {
+					{ID: "list 1", Name: "list", IDBoard: expected.IDBoard},
+					{ID: "list 2", Name: "another list", IDBoard: expected.IDBoard},
+				},
+			},
+		}
+
+		// WHEN
+		actual, err := cr.UpdateList(NewUpdateList(expected))
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		if actual == nil || *actual != expected {
+			t.Errorf("expected %v, actual %v", expected, actual)
+		}
+		if listInCache := cr.mapListsByIDBoard[expected.IDBoard][0]; listInCache != expected {
+			t.Errorf("expected %v, list in cache %v", expected, listInCache)
+		}
+	})
+	t.Run("archive list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		expected := List{ID: "list 1", Name: "list", IDBoard: "board 1", Closed: true}
+		r.EXPECT().
+			UpdateList(NewUpdateList(expected)).
+			Return(&expected, nil)
+		cr := &CacheInMemory{
+			r: r,
+			mapListsByIDBoard: map[string]Lists{
+				expected.IDBoard: {
+					{ID: "list 1", Name: "list", IDBoard: expected.IDBoard},
+					{ID: "list 2", Name: "another list", IDBoard: expected.IDBoard},
+				},
+			},
+			mapCardsByIDList: map[string]Cards{
+				expected.ID: {{ID: "card 1"}},
+			},
+		}
+
+		// WHEN
+		_, err := cr.UpdateList(NewUpdateList(expected))
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		lists := cr.mapListsByIDBoard[expected.IDBoard]
+		if len(lists) != 1 || lists[0].ID != "list 2" {
+			t.Errorf("expected archived list to be removed from cache, got %v", lists)
+		}
+		if cr.mapCardsByIDList[expected.ID] != nil {
+			t.Error("expected cards of the archived list to be removed from cache")
+		}
+	})
+	t.Run("list moved to another board", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		expected := List{ID: "list 1", Name: "list", IDBoard: "board 2"}
+		r.EXPECT().
+			UpdateList(NewUpdateList(expected)).
+			Return(&expected, nil)
+		cr := &CacheInMemory{
+			r: r,
+			mapListsByIDBoard: map[string]Lists{
+				"board 1": {{ID: "list 1", Name: "list", IDBoard: "board 1"}},
+			},
+		}
+
+		// WHEN
+		_, err := cr.UpdateList(NewUpdateList(expected))
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		if len(cr.mapListsByIDBoard) != 0 {
+			t.Errorf("expected lists cache to be cleared, got %v", cr.mapListsByIDBoard)
+		}
+	})
+	t.Run("error when updating list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		list := List{ID: "list 1", Name: "list", IDBoard: "board 1"}
+		r.EXPECT().
+			UpdateList(NewUpdateList(list)).
+			Return(nil, errors.New("unexpected error"))
+		cr := &CacheInMemory{r: r}
+
+		// WHEN
+		actual, err := cr.UpdateList(NewUpdateList(list))
+
+		// THEN
+		if err == nil {
+			t.Error("expected error")
+		}
+		if actual != nil {
+			t.Errorf("expected nil list returned, got %v", actual)
+		}
+	})
+}
+
+func TestCacheInMemory_ArchiveList(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			ArchiveList("board 1", "list 1").
+			Return(nil)
+		cr := &CacheInMemory{
+			r: r,
+			mapListsByIDBoard: map[string]Lists{
+				"board 1": {
+					{ID: "list 1", Name: "list", IDBoard: "board 1"},
+					{ID: "list 2", Name: "another list", IDBoard: "board 1"},
+				},
+			},
+			mapCardsByIDList: map[string]Cards{
+				"list 1": {{ID: "card 1"}},
+			},
+		}
+
+		// WHEN
+		err := cr.ArchiveList("board 1", "list 1")
+
+		// THEN
+		if err != nil {
+			t.Error("expected no error")
+		}
+		lists := cr.mapListsByIDBoard["board 1"]
+		if len(lists) != 1 || lists[0].ID != "list 2" {
+			t.Errorf("expected archived list to be removed from cache, got %v", lists)
+		}
+		if cr.mapCardsByIDList["list 1"] != nil {
+			t.Error("expected cards of the archived list to be removed from cache")
+		}
+	})
+	t.Run("error when archiving list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			ArchiveList("board 1", "list 1").
+			Return(errors.New("unexpected error"))
+		cr := &CacheInMemory{
+			r: r,
+			mapListsByIDBoard: map[string]Lists{
+				"board 1": {{ID: "list 1", Name: "list", IDBoard: "board 1"}},
+			},
+		}
+
+		// WHEN
+		err := cr.ArchiveList("board 1", "list 1")
+
+		// THEN
+		if err == nil {
+			t.Error("expected error")
+		}
+		if len(cr.mapListsByIDBoard["board 1"]) != 1 {
+			t.Errorf("expected cache to be unchanged, got %v", cr.mapListsByIDBoard)
+		}
+	})
+}
+
 func TestCacheInMemory_FindCards(t *testing.T) {
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -839,6 +1455,92 @@ func TestCacheInMemory_removeComment(t *testing.T) {
 	}
 }
 
+func TestCacheInMemory_FindBoardActions(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	idBoard := "board 1"
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1"}
+	comment := Comment{ID: "comment 1", Data: CommentData{Text: "comment"}}
+	actions := Actions{
+		{ID: "action 1", Type: commentCardActionType, Data: ActionData{Card: &ActionCard{ID: card.ID, IDList: "list 1"}}},
+	}
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	gomock.InOrder(
+		r.EXPECT().FindCards("list 1").Return(Cards{card}, nil),
+		r.EXPECT().FindCards("list 2").Return(Cards{}, nil),
+		r.EXPECT().FindComments(card.ID).Return(Comments{}, nil),
+		r.EXPECT().FindBoardActions(idBoard, since).Return(actions, nil),
+		r.EXPECT().FindCards("list 1").Return(Cards{card}, nil),
+		r.EXPECT().FindComments(card.ID).Return(Comments{comment}, nil),
+	)
+	cr := NewCacheInMemory(r)
+
+	// WHEN
+	_, err1 := cr.FindCards("list 1")
+	_, err2 := cr.FindCards("list 2")
+	_, err3 := cr.FindComments(card.ID)
+	actual, err4 := cr.FindBoardActions(idBoard, since)
+	_, err5 := cr.FindCards("list 1")
+	_, err6 := cr.FindCards("list 2")
+	comments, err7 := cr.FindComments(card.ID)
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4, err5, err6, err7} {
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+	}
+	if !reflect.DeepEqual(actions, actual) {
+		t.Errorf("expected %v, actual %v", actions, actual)
+	}
+	if !reflect.DeepEqual(Comments{comment}, comments) {
+		t.Errorf("expected the comments to be fetched again, actual %v", comments)
+	}
+}
+
+func TestCacheInMemory_Forget(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	idBoard := "board 1"
+	labels := Labels{{ID: "label 1", Name: "label", IDBoard: idBoard}}
+	members := Members{{ID: "member 1", Username: "member"}}
+	createdLabel := Label{ID: "label 2", Name: "another label", IDBoard: idBoard}
+	actions := Actions{
+		{ID: "action 1", Type: createLabelActionType, Data: ActionData{Board: &ActionResource{ID: idBoard}, Label: &createdLabel}},
+	}
+	gomock.InOrder(
+		r.EXPECT().FindLabels(idBoard).Return(labels, nil),
+		r.EXPECT().FindMembers(idBoard).Return(members, nil),
+		r.EXPECT().Forget(actions),
+		r.EXPECT().FindLabels(idBoard).Return(append(labels, createdLabel), nil),
+	)
+	cr := NewCacheInMemory(r)
+
+	// WHEN
+	_, err1 := cr.FindLabels(idBoard)
+	_, err2 := cr.FindMembers(idBoard)
+	cr.Forget(actions)
+	actualLabels, err3 := cr.FindLabels(idBoard)
+	actualMembers, err4 := cr.FindMembers(idBoard)
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4} {
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+	}
+	if !reflect.DeepEqual(append(labels, createdLabel), actualLabels) {
+		t.Errorf("expected the labels to be fetched again, actual %v", actualLabels)
+	}
+	if !reflect.DeepEqual(members, actualMembers) {
+		t.Errorf("expected the members to be kept in cache, actual %v", actualMembers)
+	}
+}
+
 func TestCacheInMemory_FindComments(t *testing.T) {
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -3,6 +3,8 @@ package trello
 import (
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 )
 
 func FindCard(cards Cards, query string) *Card {
@@ -28,17 +30,51 @@ func (c Cards) SortedByPos() Cards {
 	return c
 }
 
+// without returns the cards except the one with the given ID
+func (c Cards) without(idCard string) Cards {
+	filtered := Cards{}
+	for _, card := range c {
+		if card.ID != idCard {
+			filtered = append(filtered, card)
+		}
+	}
+	return filtered
+}
+
+// AssignedTo returns the cards assigned to the given member
+func (c Cards) AssignedTo(idMember string) Cards {
+	assigned := Cards{}
+	for _, card := range c {
+		if card.IsAssignedTo(idMember) {
+			assigned = append(assigned, card)
+		}
+	}
+	return assigned
+}
+
 type Card struct {
-	ID        string  `json:"id"        toml:"id"`
-	Name      string  `json:"name"      toml:"name"`
-	Desc      string  `json:"desc"      toml:"desc"`
-	IDBoard   string  `json:"idBoard"   toml:"idBoard"`
-	IDList    string  `json:"idList"    toml:"idList"`
-	Closed    bool    `json:"closed"    toml:"closed"`
-	ShortLink string  `json:"shortLink" toml:"shortLink"`
-	ShortURL  string  `json:"shortUrl"  toml:"shortUrl"`
-	Pos       float64 `json:"pos"       toml:"pos"`
-	Labels    `json:"labels" toml:"labels"`
+	ID          string   `json:"id"                  toml:"id"`
+	Name        string   `json:"name"                toml:"name"`
+	Desc        string   `json:"desc"                toml:"desc"`
+	IDBoard     string   `json:"idBoard"             toml:"idBoard"`
+	IDList      string   `json:"idList"              toml:"idList"`
+	Closed      bool     `json:"closed"              toml:"closed"`
+	ShortLink   string   `json:"shortLink"           toml:"shortLink"`
+	ShortURL    string   `json:"shortUrl"            toml:"shortUrl"`
+	Pos         float64  `json:"pos"                 toml:"pos"`
+	Due         string   `json:"due,omitempty"       toml:"due,omitempty"`
+	DueComplete bool     `json:"dueComplete"         toml:"dueComplete"`
+	Start       string   `json:"start,omitempty"     toml:"start,omitempty"`
+	IDMembers   []string `json:"idMembers,omitempty" toml:"idMembers,omitempty"`
+	Labels      `json:"labels" toml:"labels"`
+	// values of the custom fields set on the card, fetched with the card
+	CustomFieldItems CustomFieldItems `json:"customFieldItems,omitempty" toml:"customFieldItems,omitempty"`
+	// not fetched with the card, use CustomFields.Values with the custom fields of the board to get them
+	CustomFields CustomFieldValues `json:"customFields,omitempty" toml:"customFields,omitempty"`
+	// not fetched with the card, use Repository.FindChecklists to get them
+	Checklists `json:"checklists,omitempty" toml:"checklists,omitempty"`
+	// not fetched with the card, use Repository.FindAttachments to get them
+	Attachments `json:"attachments,omitempty" toml:"attachments,omitempty"`
 }
 
 func (c Card) TCliID() string {
@@ -49,17 +85,42 @@ func (c Card) SanitizedName() string {
 	return sanitize(c.Name)
 }
 
+// IsAssignedTo returns true if the given member is assigned to the card
+func (c Card) IsAssignedTo(idMember string) bool {
+	for _, id := range c.IDMembers {
+		if id == idMember {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOverdue returns true if the due date of the card is passed and not marked as complete
+func (c Card) IsOverdue(now time.Time) bool {
+	if c.Due == "" || c.DueComplete {
+		return false
+	}
+	due, err := time.Parse(time.RFC3339, c.Due)
+	if err != nil {
+		return false
+	}
+	return due.Before(now)
+}
+
 // CARD CREATION ---------------------------------------------------------------------------------------
 
 // CreateCard represents the resources used to create a new card
 // See https://developer.atlassian.com/cloud/trello/rest/api-group-cards/#api-cards-post for more info
 type CreateCard struct {
-	Name     string      `json:"name"               toml:"name"`
-	Desc     string      `json:"desc"               toml:"desc"`
-	IDList   string      `json:"idList"             toml:"idList"`
-	IDLabels string      `json:"idLabels,omitempty" toml:"idLabels,omitempty"`
-	Closed   bool        `json:"closed,omitempty"   toml:"closed,omitempty"`
-	Pos      interface{} `json:"pos,omitempty"      toml:"pos,omitempty"` // "top", "bottom" or a positive float
+	Name      string      `json:"name"                toml:"name"`
+	Desc      string      `json:"desc"                toml:"desc"`
+	IDList    string      `json:"idList"              toml:"idList"`
+	IDLabels  string      `json:"idLabels,omitempty"  toml:"idLabels,omitempty"`
+	IDMembers string      `json:"idMembers,omitempty" toml:"idMembers,omitempty"`
+	Closed    bool        `json:"closed,omitempty"    toml:"closed,omitempty"`
+	Pos       interface{} `json:"pos,omitempty"       toml:"pos,omitempty"` // "top", "bottom" or a positive float
+	Due       *string     `json:"due,omitempty"       toml:"due,omitempty"`
+	Start     *string     `json:"start,omitempty"     toml:"start,omitempty"`
 }
 
 func NewCreateCard(card Card) CreateCard {
@@ -79,6 +140,8 @@ func NewCardToCreate(card Card, defaultLabels []string) CardToCreate {
 		IDList: card.IDList,
 		Pos:    strconv.FormatFloat(card.Pos, 'f', 2, 64),
 		Labels: defaultLabels,
+		Due:    FormatDate(card.Due),
+		Start:  FormatDate(card.Start),
 	}
 }
 
@@ -86,53 +149,79 @@ func NewCardToCreate(card Card, defaultLabels []string) CardToCreate {
 // it's different from the other card representation because we do not want to expose everything to the user
 // like for instance, the card ID as the user
 type CardToCreate struct {
-	Name   string   `yaml:"name"          toml:"name"`
-	Desc   string   `yaml:"desc"          toml:"desc"`
-	IDList string   `yaml:"idList"        toml:"idList"`
-	Pos    string   `yaml:"pos,omitempty" toml:"pos,omitempty"` // "top", "bottom" or a positive float
-	Labels []string `yaml:"labels"        toml:"labels"`
+	Name    string   `yaml:"name"          toml:"name"`
+	Desc    string   `yaml:"desc"          toml:"desc"`
+	IDList  string   `yaml:"idList"        toml:"idList"`
+	Pos     string   `yaml:"pos,omitempty" toml:"pos,omitempty"` // "top", "bottom" or a positive float
+	Labels  []string `yaml:"labels"        toml:"labels"`
+	Members []string `yaml:"members"       toml:"members"` // usernames or IDs
+	Due     string   `yaml:"due"           toml:"due"`     // natural date, e.g. "2026-11-01 17:00" or "tomorrow"
+	Start   string   `yaml:"start"         toml:"start"`   // natural date, e.g. "2026-11-01 17:00" or "tomorrow"
 }
 
 func (ctc CardToCreate) GetPos() interface{} {
 	return getPos(ctc.Pos)
 }
 
+func (ctc CardToCreate) GetDue() (*string, error) {
+	return toTrelloDate(ctc.Due, time.Now())
+}
+
+func (ctc CardToCreate) GetStart() (*string, error) {
+	return toTrelloDate(ctc.Start, time.Now())
+}
+
 // CARD UPDATE ---------------------------------------------------------------------------------------
 
 // UpdateCard represents the resources used to update a card
 // See https://developer.atlassian.com/cloud/trello/rest/api-group-cards/#api-cards-id-put for more info
 type UpdateCard struct {
-	ID       string      `json:"id"                 toml:"id"`
-	Name     string      `json:"name"               toml:"name"`
-	Desc     string      `json:"desc"               toml:"desc"`
-	IDBoard  string      `json:"idBoard"            toml:"idBoard"`
-	IDList   string      `json:"idList"             toml:"idList"`
-	IDLabels string      `json:"idLabels,omitempty" toml:"idLabels,omitempty"`
-	Closed   bool        `json:"closed,omitempty"   toml:"closed,omitempty"`
-	Pos      interface{} `json:"pos,omitempty"      toml:"pos,omitempty"` // "top", "bottom" or a positive float
+	ID          string      `json:"id"                 toml:"id"`
+	Name        string      `json:"name"               toml:"name"`
+	Desc        string      `json:"desc"               toml:"desc"`
+	IDBoard     string      `json:"idBoard"            toml:"idBoard"`
+	IDList      string      `json:"idList"             toml:"idList"`
+	IDLabels    string      `json:"idLabels,omitempty" toml:"idLabels,omitempty"`
+	IDMembers   string      `json:"idMembers"          toml:"idMembers"` // empty to remove all the members
+	Closed      bool        `json:"closed"             toml:"closed,omitempty"`
+	Pos         interface{} `json:"pos,omitempty"      toml:"pos,omitempty"` // "top", "bottom" or a positive float
+	Due         *string     `json:"due"                toml:"due,omitempty"` // nil to remove the due date
+	DueComplete bool        `json:"dueComplete"        toml:"dueComplete"`
+	Start       *string     `json:"start"              toml:"start,omitempty"` // nil to remove the start date
 }
 
 func NewUpdateCard(card Card) UpdateCard {
 	return UpdateCard{
-		ID:       card.ID,
-		Name:     card.Name,
-		Desc:     card.Desc,
-		IDBoard:  card.IDBoard,
-		IDList:   card.IDList,
-		IDLabels: card.Labels.String(),
-		Closed:   card.Closed,
-		Pos:      card.Pos,
+		ID:          card.ID,
+		Name:        card.Name,
+		Desc:        card.Desc,
+		IDBoard:     card.IDBoard,
+		IDList:      card.IDList,
+		IDLabels:    card.Labels.String(),
+		IDMembers:   strings.Join(card.IDMembers, ","),
+		Closed:      card.Closed,
+		Pos:         card.Pos,
+		Due:         toNullableString(card.Due),
+		DueComplete: card.DueComplete,
+		Start:       toNullableString(card.Start),
 	}
 }
 
-func NewCardToEdit(card Card) CardToEdit {
+func NewCardToEdit(card Card, members Members) CardToEdit {
 	return CardToEdit{
-		Name:   card.Name,
-		Desc:   card.Desc,
-		Closed: card.Closed,
-		IDList: card.IDList,
-		Labels: card.Labels.ToSliceTCliColors(),
-		Pos:    strconv.FormatFloat(card.Pos, 'f', 2, 64),
+		Name:    card.Name,
+		Desc:    card.Desc,
+		Closed:  card.Closed,
+		IDList:  card.IDList,
+		Labels:  card.Labels.ToSliceTCliColors(),
+		Members: members.ToUsernames(card.IDMembers),
+		Pos:     strconv.FormatFloat(card.Pos, 'f', 2, 64),
+
+		Due:         FormatDate(card.Due),
+		DueComplete: card.DueComplete,
+		Start:       FormatDate(card.Start),
+
+		Checklists: NewChecklistsToEdit(card.Checklists),
 	}
 }
 
@@ -140,14 +229,31 @@ func NewCardToEdit(card Card) CardToEdit {
 // it's different from the other card representation because we do not want to expose everything to the user
 // like for instance, the card ID as the user
 type CardToEdit struct {
-	Name   string   `yaml:"name"          toml:"name"`
-	Desc   string   `yaml:"desc"          toml:"desc"`
-	Closed bool     `yaml:"closed"        toml:"closed"`
-	IDList string   `yaml:"idList"        toml:"idList"`
-	Labels []string `yaml:"labels"        toml:"labels"`
-	Pos    string   `yaml:"pos,omitempty" toml:"pos,omitempty"` // "top", "bottom" or a positive float
+	Name    string   `yaml:"name"          toml:"name"`
+	Desc    string   `yaml:"desc"          toml:"desc"`
+	Closed  bool     `yaml:"closed"        toml:"closed"`
+	IDList  string   `yaml:"idList"        toml:"idList"`
+	Labels  []string `yaml:"labels"        toml:"labels"`
+	Members []string `yaml:"members"       toml:"members"`       // usernames or IDs
+	Pos     string   `yaml:"pos,omitempty" toml:"pos,omitempty"` // "top", "bottom" or a positive float
+
+	Due         string `yaml:"due"         toml:"due"` // natural date, e.g. "2026-11-01 17:00" or "tomorrow"
+	DueComplete bool   `yaml:"dueComplete" toml:"dueComplete"`
+	Start       string `yaml:"start"       toml:"start"` // natural date, e.g. "2026-11-01 17:00" or "tomorrow"
+
+	Checklists []ChecklistToEdit `yaml:"checklists" toml:"checklists"`
+
+	CustomFields map[string]string `yaml:"customFields,omitempty" toml:"customFields,omitempty"` // <custom field name, value>
 }
 
 func (cte CardToEdit) GetPos() interface{} {
 	return getPos(cte.Pos)
 }
+
+func (cte CardToEdit) GetDue() (*string, error) {
+	return toTrelloDate(cte.Due, time.Now())
+}
+
+func (cte CardToEdit) GetStart() (*string, error) {
+	return toTrelloDate(cte.Start, time.Now())
+}
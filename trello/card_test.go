@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestFindCards(t *testing.T) {
@@ -218,7 +219,7 @@ func TestNewCardToEdit(t *testing.T) {
 	}
 
 	// WHEN
-	actual := NewCardToEdit(c)
+	actual := NewCardToEdit(c, nil)
 
 	// THEN
 	if c.Name != actual.Name {
@@ -242,3 +243,36 @@ func TestNewCardToEdit(t *testing.T) {
 		t.Errorf("expected %v, actual %v", expectedIDsLabel, actual.Labels)
 	}
 }
+
+func TestCard_IsOverdue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	var tests = map[string]struct {
+		given    Card
+		expected bool
+	}{
+		"due date passed": {
+			given:    Card{Due: "2026-10-17T12:00:00.000Z"},
+			expected: true,
+		},
+		"due date passed but complete": {
+			given:    Card{Due: "2026-10-17T12:00:00.000Z", DueComplete: true},
+			expected: false,
+		},
+		"due date not passed": {
+			given:    Card{Due: "2026-10-19T12:00:00.000Z"},
+			expected: false,
+		},
+		"no due date": {
+			given:    Card{},
+			expected: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tt.given.IsOverdue(now)
+			if actual != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package trello
+
+import "sort"
+
+const (
+	checkItemStateComplete   = "complete"
+	checkItemStateIncomplete = "incomplete"
+)
+
+type Checklists []Checklist
+
+func (c Checklists) SortedByPos() Checklists {
+	sort.Slice(c, func(i, j int) bool {
+		return c[i].Pos < c[j].Pos
+	})
+	return c
+}
+
+// Progress returns the number of checked items and the total number of items of all the checklists
+func (c Checklists) Progress() (checked int, total int) {
+	for _, checklist := range c {
+		checklistChecked, checklistTotal := checklist.Progress()
+		checked += checklistChecked
+		total += checklistTotal
+	}
+	return
+}
+
+type Checklist struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	IDCard     string     `json:"idCard"`
+	Pos        float64    `json:"pos"`
+	CheckItems CheckItems `json:"checkItems"`
+}
+
+// Progress returns the number of checked items and the total number of items of the checklist
+func (c Checklist) Progress() (checked int, total int) {
+	for _, checkItem := range c.CheckItems {
+		if checkItem.IsChecked() {
+			checked++
+		}
+	}
+	return checked, len(c.CheckItems)
+}
+
+type CheckItems []CheckItem
+
+func (c CheckItems) SortedByPos() CheckItems {
+	sort.Slice(c, func(i, j int) bool {
+		return c[i].Pos < c[j].Pos
+	})
+	return c
+}
+
+type CheckItem struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	IDChecklist string  `json:"idChecklist"`
+	State       string  `json:"state"` // "complete" or "incomplete"
+	Pos         float64 `json:"pos"`
+}
+
+func (c CheckItem) IsChecked() bool {
+	return c.State == checkItemStateComplete
+}
+
+func toCheckItemState(checked bool) string {
+	if checked {
+		return checkItemStateComplete
+	}
+	return checkItemStateIncomplete
+}
+
+// CHECKLIST CREATION ---------------------------------------------------------------------------------------
+
+// CreateChecklist represents the resources used to create a new checklist
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-checklists/#api-checklists-post for more info
+type CreateChecklist struct {
+	IDCard string `json:"idCard"`
+	Name   string `json:"name"`
+	Pos    string `json:"pos,omitempty"` // "top", "bottom" or a positive float
+}
+
+// CreateCheckItem represents the resources used to create a new item in a checklist
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-checklists/#api-checklists-id-checkitems-post for more info
+type CreateCheckItem struct {
+	IDCard      string `json:"idCard"`
+	IDChecklist string `json:"idChecklist"`
+	Name        string `json:"name"`
+	Checked     bool   `json:"checked"`
+	Pos         string `json:"pos,omitempty"` // "top", "bottom" or a positive float
+}
+
+// CHECKLIST UPDATE ---------------------------------------------------------------------------------------
+
+// UpdateChecklist represents the resources used to update a checklist
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-checklists/#api-checklists-id-put for more info
+type UpdateChecklist struct {
+	ID     string `json:"id"`
+	IDCard string `json:"idCard"`
+	Name   string `json:"name"`
+}
+
+// UpdateCheckItem represents the resources used to update an item of a checklist
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-cards/#api-cards-id-checkitem-idcheckitem-put for more info
+type UpdateCheckItem struct {
+	ID          string `json:"id"`
+	IDCard      string `json:"idCard"`
+	IDChecklist string `json:"idChecklist"`
+	Name        string `json:"name"`
+	State       string `json:"state"` // "complete" or "incomplete"
+}
+
+func NewUpdateCheckItem(idCard string, checkItem CheckItem) UpdateCheckItem {
+	return UpdateCheckItem{
+		ID:          checkItem.ID,
+		IDCard:      idCard,
+		IDChecklist: checkItem.IDChecklist,
+		Name:        checkItem.Name,
+		State:       checkItem.State,
+	}
+}
+
+// CHECKLIST EDITION ---------------------------------------------------------------------------------------
+
+func NewChecklistsToEdit(checklists Checklists) []ChecklistToEdit {
+	checklistsToEdit := make([]ChecklistToEdit, 0, len(checklists))
+	for _, checklist := range checklists.SortedByPos() {
+		items := make([]CheckItemToEdit, 0, len(checklist.CheckItems))
+		for _, checkItem := range checklist.CheckItems.SortedByPos() {
+			items = append(items, CheckItemToEdit{
+				ID:      checkItem.ID,
+				Name:    checkItem.Name,
+				Checked: checkItem.IsChecked(),
+			})
+		}
+		checklistsToEdit = append(checklistsToEdit, ChecklistToEdit{
+			ID:    checklist.ID,
+			Name:  checklist.Name,
+			Items: items,
+		})
+	}
+	return checklistsToEdit
+}
+
+// ChecklistToEdit is the representation used in the card edition
+// a checklist or an item without ID is created, whereas a checklist or an item that is removed is deleted
+type ChecklistToEdit struct {
+	ID    string            `yaml:"id,omitempty" toml:"id,omitempty"`
+	Name  string            `yaml:"name"         toml:"name"`
+	Items []CheckItemToEdit `yaml:"items"        toml:"items"`
+}
+
+type CheckItemToEdit struct {
+	ID      string `yaml:"id,omitempty" toml:"id,omitempty"`
+	Name    string `yaml:"name"         toml:"name"`
+	Checked bool   `yaml:"checked"      toml:"checked"`
+}
+
+// ChecklistsChanges are the operations to perform on the checklists of a card to apply its edition
+type ChecklistsChanges struct {
+	// checklists to create with their items
+	ChecklistsToCreate []ChecklistToEdit
+	ChecklistsToUpdate []UpdateChecklist
+	ChecklistsToDelete Checklists
+	CheckItemsToCreate []CreateCheckItem
+	CheckItemsToUpdate []UpdateCheckItem
+	CheckItemsToDelete CheckItems
+}
+
+// NewChecklistsChanges computes the operations to perform to get from the card checklists
+// to the edited ones
+func NewChecklistsChanges(card Card, editedChecklists []ChecklistToEdit) ChecklistsChanges {
+	changes := ChecklistsChanges{}
+	editedChecklistsByID := map[string]ChecklistToEdit{}
+	for _, editedChecklist := range editedChecklists {
+		if editedChecklist.ID == "" {
+			changes.ChecklistsToCreate = append(changes.ChecklistsToCreate, editedChecklist)
+		} else {
+			editedChecklistsByID[editedChecklist.ID] = editedChecklist
+		}
+	}
+
+	for _, checklist := range card.Checklists {
+		editedChecklist, found := editedChecklistsByID[checklist.ID]
+		if !found {
+			changes.ChecklistsToDelete = append(changes.ChecklistsToDelete, checklist)
+			continue
+		}
+		if editedChecklist.Name != checklist.Name {
+			changes.ChecklistsToUpdate = append(changes.ChecklistsToUpdate, UpdateChecklist{
+				ID:     checklist.ID,
+				IDCard: card.ID,
+				Name:   editedChecklist.Name,
+			})
+		}
+
+		editedCheckItemsByID := map[string]CheckItemToEdit{}
+		for _, editedCheckItem := range editedChecklist.Items {
+			if editedCheckItem.ID == "" {
+				changes.CheckItemsToCreate = append(changes.CheckItemsToCreate, CreateCheckItem{
+					IDCard:      card.ID,
+					IDChecklist: checklist.ID,
+					Name:        editedCheckItem.Name,
+					Checked:     editedCheckItem.Checked,
+					Pos:         "bottom",
+				})
+			} else {
+				editedCheckItemsByID[editedCheckItem.ID] = editedCheckItem
+			}
+		}
+		for _, checkItem := range checklist.CheckItems {
+			editedCheckItem, found := editedCheckItemsByID[checkItem.ID]
+			if !found {
+				changes.CheckItemsToDelete = append(changes.CheckItemsToDelete, checkItem)
+				continue
+			}
+			if editedCheckItem.Name != checkItem.Name || editedCheckItem.Checked != checkItem.IsChecked() {
+				updateCheckItem := NewUpdateCheckItem(card.ID, checkItem)
+				updateCheckItem.Name = editedCheckItem.Name
+				updateCheckItem.State = toCheckItemState(editedCheckItem.Checked)
+				changes.CheckItemsToUpdate = append(changes.CheckItemsToUpdate, updateCheckItem)
+			}
+		}
+	}
+	return changes
+}
@@ -0,0 +1,104 @@
+package trello
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChecklists_Progress(t *testing.T) {
+	var tests = map[string]struct {
+		given           Checklists
+		expectedChecked int
+		expectedTotal   int
+	}{
+		"no checklist": {
+			given:           Checklists{},
+			expectedChecked: 0,
+			expectedTotal:   0,
+		},
+		"two checklists": {
+			given: Checklists{
+				{CheckItems: CheckItems{{State: "complete"}, {State: "incomplete"}, {State: "complete"}}},
+				{CheckItems: CheckItems{{State: "incomplete"}, {State: "complete"}}},
+			},
+			expectedChecked: 3,
+			expectedTotal:   5,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualChecked, actualTotal := tt.given.Progress()
+			if actualChecked != tt.expectedChecked || actualTotal != tt.expectedTotal {
+				t.Errorf("expected %d/%d, actual %d/%d", tt.expectedChecked, tt.expectedTotal, actualChecked, actualTotal)
+			}
+		})
+	}
+}
+
+func TestNewChecklistsChanges(t *testing.T) {
+	card := Card{
+		ID: "card 1",
+		Checklists: Checklists{
+			{
+				ID:   "checklist 1",
+				Name: "checklist",
+				CheckItems: CheckItems{
+					{ID: "item 1", Name: "item 1", IDChecklist: "checklist 1", State: "incomplete"},
+					{ID: "item 2", Name: "item 2", IDChecklist: "checklist 1", State: "complete"},
+					{ID: "item 3", Name: "item 3", IDChecklist: "checklist 1", State: "complete"},
+				},
+			},
+			{ID: "checklist 2", Name: "another checklist"},
+		},
+	}
+	var tests = map[string]struct {
+		given    []ChecklistToEdit
+		expected ChecklistsChanges
+	}{
+		"no change": {
+			given:    NewChecklistsToEdit(card.Checklists),
+			expected: ChecklistsChanges{},
+		},
+		"all changes": {
+			given: []ChecklistToEdit{
+				{
+					ID:   "checklist 1",
+					Name: "renamed checklist",
+					Items: []CheckItemToEdit{
+						{ID: "item 1", Name: "item 1", Checked: true},
+						{ID: "item 2", Name: "renamed item 2", Checked: true},
+						{Name: "new item"},
+					},
+				},
+				{Name: "new checklist", Items: []CheckItemToEdit{{Name: "new checklist item"}}},
+			},
+			expected: ChecklistsChanges{
+				ChecklistsToCreate: []ChecklistToEdit{
+					{Name: "new checklist", Items: []CheckItemToEdit{{Name: "new checklist item"}}},
+				},
+				ChecklistsToUpdate: []UpdateChecklist{
+					{ID: "checklist 1", IDCard: "card 1", Name: "renamed checklist"},
+				},
+				ChecklistsToDelete: Checklists{{ID: "checklist 2", Name: "another checklist"}},
+				CheckItemsToCreate: []CreateCheckItem{
+					{IDCard: "card 1", IDChecklist: "checklist 1", Name: "new item", Pos: "bottom"},
+				},
+				CheckItemsToUpdate: []UpdateCheckItem{
+					{ID: "item 1", IDCard: "card 1", IDChecklist: "checklist 1", Name: "item 1", State: "complete"},
+					{ID: "item 2", IDCard: "card 1", IDChecklist: "checklist 1", Name: "renamed item 2", State: "complete"},
+				},
+				CheckItemsToDelete: CheckItems{
+					{ID: "item 3", Name: "item 3", IDChecklist: "checklist 1", State: "complete"},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := NewChecklistsChanges(card, tt.given)
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %+v, actual %+v", tt.expected, actual)
+			}
+		})
+	}
+}
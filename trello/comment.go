@@ -18,6 +18,17 @@ func FindComment(comments Comments, idComment string) *Comment {
 
 type Comments []Comment
 
+// without returns the comments except the one with the given ID
+func (c Comments) without(idComment string) Comments {
+	filtered := Comments{}
+	for _, comment := range c {
+		if comment.ID != idComment {
+			filtered = append(filtered, comment)
+		}
+	}
+	return filtered
+}
+
 func (c Comments) SortedByDateDesc() Comments {
 	sort.Slice(c, func(i, j int) bool {
 		ti, err := time.Parse(dateTimeLayout, c[i].Date)
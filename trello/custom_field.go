@@ -0,0 +1,304 @@
+package trello
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// types of the custom fields
+const (
+	CustomFieldTypeCheckbox = "checkbox"
+	CustomFieldTypeDate     = "date"
+	CustomFieldTypeList     = "list"
+	CustomFieldTypeNumber   = "number"
+	CustomFieldTypeText     = "text"
+)
+
+// CustomFields are the definitions of the custom fields of a board, added with the Custom Fields power-up
+type CustomFields []CustomField
+
+func (c CustomFields) SortedByPos() CustomFields {
+	sort.Slice(c, func(i, j int) bool {
+		return c[i].Pos < c[j].Pos
+	})
+	return c
+}
+
+// FindCustomField finds the custom field by its name or its ID
+func (c CustomFields) FindCustomField(query string) *CustomField {
+	for _, customField := range c {
+		if customField.Name == query || customField.ID == query {
+			return &customField
+		}
+	}
+	return nil
+}
+
+// Values returns the values of all the custom fields for the given card, from the first to the last custom field,
+// the value being empty if it is not set on the card
+func (c CustomFields) Values(card Card) CustomFieldValues {
+	values := CustomFieldValues{}
+	for _, customField := range c.SortedByPos() {
+		values = append(values, CustomFieldValue{
+			Name:  customField.Name,
+			Type:  customField.Type,
+			Value: customField.Format(card.CustomFieldItems.Find(customField.ID)),
+		})
+	}
+	return values
+}
+
+// ToEdit returns the values of the custom fields of the card, by custom field name, as edited by the user
+func (c CustomFields) ToEdit(card Card) map[string]string {
+	toEdit := map[string]string{}
+	for _, value := range c.Values(card) {
+		toEdit[value.Name] = value.Value
+	}
+	return toEdit
+}
+
+// Changes returns the updates to perform to set the edited values of the custom fields of the card,
+// the custom fields missing from the edited values being left untouched
+func (c CustomFields) Changes(card Card, edited map[string]string) ([]UpdateCustomFieldItem, error) {
+	names := make([]string, 0, len(edited))
+	for name := range edited {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []UpdateCustomFieldItem
+	for _, name := range names {
+		customField := c.FindCustomField(name)
+		if customField == nil {
+			return nil, fmt.Errorf("unknown custom field '%s'", name)
+		}
+		value := strings.TrimSpace(edited[name])
+		if checked, err := strconv.ParseBool(value); err == nil && !checked && customField.Type == CustomFieldTypeCheckbox {
+			// an unchecked checkbox is not set for Trello
+			value = ""
+		}
+		if value == customField.Format(card.CustomFieldItems.Find(customField.ID)) {
+			continue
+		}
+		updateCustomFieldItem, err := customField.toUpdate(card.ID, value)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, updateCustomFieldItem)
+	}
+	return changes, nil
+}
+
+type CustomField struct {
+	ID      string             `json:"id"`
+	IDModel string             `json:"idModel"` // ID of the board
+	Name    string             `json:"name"`
+	Type    string             `json:"type"` // "checkbox", "date", "list", "number" or "text"
+	Pos     float64            `json:"pos"`
+	Options CustomFieldOptions `json:"options,omitempty"` // only for the "list" custom fields
+}
+
+// Format returns the value of the custom field in the given item, in the format used to edit it
+func (c CustomField) Format(item *CustomFieldItem) string {
+	if item == nil {
+		return ""
+	}
+	if c.Type == CustomFieldTypeList {
+		if option := c.Options.Find(item.IDValue); option != nil {
+			return option.Value.Text
+		}
+		return item.IDValue
+	}
+	if item.Value == nil {
+		return ""
+	}
+	switch c.Type {
+	case CustomFieldTypeCheckbox:
+		if item.Value.Checked != "true" {
+			return ""
+		}
+		return item.Value.Checked
+	case CustomFieldTypeDate:
+		return FormatDate(item.Value.Date)
+	case CustomFieldTypeNumber:
+		return item.Value.Number
+	}
+	return item.Value.Text
+}
+
+// toUpdate converts the value given by the user into the update of the custom field of the card,
+// an empty value clearing the custom field
+func (c CustomField) toUpdate(idCard, value string) (UpdateCustomFieldItem, error) {
+	u := UpdateCustomFieldItem{IDCard: idCard, IDCustomField: c.ID, Name: c.Name}
+	if c.Type == CustomFieldTypeList {
+		idValue := ""
+		if value != "" {
+			option := c.Options.FindOption(value)
+			if option == nil {
+				return u, fmt.Errorf("invalid value '%s' for custom field '%s', use one of the options: %s", value, c.Name, c.Options)
+			}
+			idValue = option.ID
+		}
+		u.IDValue = &idValue
+		return u, nil
+	}
+	if value == "" {
+		u.Value = ""
+		return u, nil
+	}
+	switch c.Type {
+	case CustomFieldTypeCheckbox:
+		checked, err := strconv.ParseBool(value)
+		if err != nil {
+			return u, fmt.Errorf("invalid value '%s' for custom field '%s', use true or false", value, c.Name)
+		}
+		if !checked {
+			u.Value = ""
+			return u, nil
+		}
+		u.Value = CustomFieldItemValue{Checked: "true"}
+	case CustomFieldTypeDate:
+		date, err := toTrelloDate(value, time.Now())
+		if err != nil {
+			return u, fmt.Errorf("invalid value for custom field '%s': %w", c.Name, err)
+		}
+		u.Value = CustomFieldItemValue{Date: *date}
+	case CustomFieldTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return u, fmt.Errorf("invalid value '%s' for custom field '%s', use a number", value, c.Name)
+		}
+		u.Value = CustomFieldItemValue{Number: value}
+	default:
+		u.Value = CustomFieldItemValue{Text: value}
+	}
+	return u, nil
+}
+
+type CustomFieldOptions []CustomFieldOption
+
+func (c CustomFieldOptions) String() string {
+	values := make([]string, 0, len(c))
+	for _, option := range c {
+		values = append(values, option.Value.Text)
+	}
+	return strings.Join(values, ", ")
+}
+
+// Find finds the option with the given ID
+func (c CustomFieldOptions) Find(idOption string) *CustomFieldOption {
+	for _, option := range c {
+		if option.ID == idOption {
+			return &option
+		}
+	}
+	return nil
+}
+
+// FindOption finds the option by its value or its ID
+func (c CustomFieldOptions) FindOption(query string) *CustomFieldOption {
+	for _, option := range c {
+		if option.Value.Text == query || option.ID == query {
+			return &option
+		}
+	}
+	return nil
+}
+
+// CustomFieldOption is an option of a "list" custom field
+type CustomFieldOption struct {
+	ID            string               `json:"id"`
+	IDCustomField string               `json:"idCustomField"`
+	Value         CustomFieldItemValue `json:"value"`
+	Color         string               `json:"color"`
+	Pos           float64              `json:"pos"`
+}
+
+// CustomFieldItems are the values of the custom fields set on a card
+type CustomFieldItems []CustomFieldItem
+
+// Find finds the item of the given custom field
+func (c CustomFieldItems) Find(idCustomField string) *CustomFieldItem {
+	for _, item := range c {
+		if item.IDCustomField == idCustomField {
+			return &item
+		}
+	}
+	return nil
+}
+
+// with returns the items where the item of the custom field is replaced by the given one,
+// or removed if the given item has no value
+func (c CustomFieldItems) with(item CustomFieldItem) CustomFieldItems {
+	items := CustomFieldItems{}
+	for _, i := range c {
+		if i.IDCustomField != item.IDCustomField {
+			items = append(items, i)
+		}
+	}
+	if item.IDValue != "" || (item.Value != nil && *item.Value != CustomFieldItemValue{}) {
+		items = append(items, item)
+	}
+	return items
+}
+
+// CustomFieldItem is the value of a custom field set on a card
+type CustomFieldItem struct {
+	ID            string                `json:"id"                toml:"id"`
+	IDCustomField string                `json:"idCustomField"     toml:"idCustomField"`
+	IDModel       string                `json:"idModel"           toml:"idModel"`           // ID of the card
+	IDValue       string                `json:"idValue,omitempty" toml:"idValue,omitempty"` // ID of the option of the "list" custom fields
+	Value         *CustomFieldItemValue `json:"value,omitempty"   toml:"value,omitempty"`
+}
+
+// CustomFieldItemValue is the value of a custom field, only the field matching its type being set
+type CustomFieldItemValue struct {
+	Text    string `json:"text,omitempty"    toml:"text,omitempty"`
+	Number  string `json:"number,omitempty"  toml:"number,omitempty"`
+	Date    string `json:"date,omitempty"    toml:"date,omitempty"`
+	Checked string `json:"checked,omitempty" toml:"checked,omitempty"` // "true" or "false"
+}
+
+// CustomFieldValues are the values of the custom fields of a card, resolved with the custom fields of its board
+type CustomFieldValues []CustomFieldValue
+
+// NotEmpty returns the values that are set on the card
+func (c CustomFieldValues) NotEmpty() CustomFieldValues {
+	values := CustomFieldValues{}
+	for _, value := range c {
+		if value.Value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+type CustomFieldValue struct {
+	Name  string `json:"name"  toml:"name"`
+	Type  string `json:"type"  toml:"type"`
+	Value string `json:"value" toml:"value"`
+}
+
+// CUSTOM FIELD ITEM UPDATE ---------------------------------------------------------------------------------------
+
+// UpdateCustomFieldItem represents the resources used to set or clear the value of a custom field of a card
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-customfielditems/#api-cards-idcard-customfield-idcustomfield-item-put for more info
+type UpdateCustomFieldItem struct {
+	IDCard        string `json:"-"`
+	IDCustomField string `json:"-"`
+	Name          string `json:"-"` // name of the custom field
+	// CustomFieldItemValue to set the value of the custom field, or "" to clear it
+	Value interface{} `json:"value,omitempty"`
+	// ID of the option to set the value of a "list" custom field, or "" to clear it
+	IDValue *string `json:"idValue,omitempty"`
+}
+
+// Clears returns true if the update clears the value of the custom field
+func (u UpdateCustomFieldItem) Clears() bool {
+	if u.IDValue != nil {
+		return *u.IDValue == ""
+	}
+	return u.Value == nil || u.Value == ""
+}
@@ -0,0 +1,193 @@
+package trello
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCustomFields_Values(t *testing.T) {
+	customFields := CustomFields{
+		{ID: "custom field 3", Name: "Done", Type: CustomFieldTypeCheckbox, Pos: 3},
+		{
+			ID:      "custom field 1",
+			Name:    "Priority",
+			Type:    CustomFieldTypeList,
+			Pos:     1,
+			Options: CustomFieldOptions{{ID: "option 1", Value: CustomFieldItemValue{Text: "high"}}},
+		},
+		{ID: "custom field 2", Name: "Estimate", Type: CustomFieldTypeNumber, Pos: 2},
+	}
+	var tests = map[string]struct {
+		given    Card
+		expected CustomFieldValues
+	}{
+		"no value": {
+			given: Card{ID: "card 1"},
+			expected: CustomFieldValues{
+				{Name: "Priority", Type: CustomFieldTypeList},
+				{Name: "Estimate", Type: CustomFieldTypeNumber},
+				{Name: "Done", Type: CustomFieldTypeCheckbox},
+			},
+		},
+		"all values": {
+			given: Card{
+				ID: "card 1",
+				CustomFieldItems: CustomFieldItems{
+					{IDCustomField: "custom field 1", IDValue: "option 1"},
+					{IDCustomField: "custom field 2", Value: &CustomFieldItemValue{Number: "3.5"}},
+					{IDCustomField: "custom field 3", Value: &CustomFieldItemValue{Checked: "true"}},
+				},
+			},
+			expected: CustomFieldValues{
+				{Name: "Priority", Type: CustomFieldTypeList, Value: "high"},
+				{Name: "Estimate", Type: CustomFieldTypeNumber, Value: "3.5"},
+				{Name: "Done", Type: CustomFieldTypeCheckbox, Value: "true"},
+			},
+		},
+		"unchecked checkbox": {
+			given: Card{
+				ID:               "card 1",
+				CustomFieldItems: CustomFieldItems{{IDCustomField: "custom field 3", Value: &CustomFieldItemValue{Checked: "false"}}},
+			},
+			expected: CustomFieldValues{
+				{Name: "Priority", Type: CustomFieldTypeList},
+				{Name: "Estimate", Type: CustomFieldTypeNumber},
+				{Name: "Done", Type: CustomFieldTypeCheckbox},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := customFields.Values(tt.given)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Changes(t *testing.T) {
+	customFields := CustomFields{
+		{
+			ID:   "custom field 1",
+			Name: "Priority",
+			Type: CustomFieldTypeList,
+			Options: CustomFieldOptions{
+				{ID: "option 1", Value: CustomFieldItemValue{Text: "high"}},
+				{ID: "option 2", Value: CustomFieldItemValue{Text: "low"}},
+			},
+		},
+		{ID: "custom field 2", Name: "Estimate", Type: CustomFieldTypeNumber},
+		{ID: "custom field 3", Name: "Done", Type: CustomFieldTypeCheckbox},
+		{ID: "custom field 4", Name: "Notes", Type: CustomFieldTypeText},
+	}
+	card := Card{
+		ID: "card 1",
+		CustomFieldItems: CustomFieldItems{
+			{IDCustomField: "custom field 1", IDValue: "option 1"},
+			{IDCustomField: "custom field 4", Value: &CustomFieldItemValue{Text: "some notes"}},
+		},
+	}
+	idOption2 := "option 2"
+	noOption := ""
+	var tests = map[string]struct {
+		given         map[string]string
+		expected      []UpdateCustomFieldItem
+		expectedError string
+	}{
+		"no change": {
+			given: customFields.ToEdit(card),
+		},
+		"unchecked checkbox left unset": {
+			given: map[string]string{"Done": "false"},
+		},
+		"all changed": {
+			given: map[string]string{"Priority": "low", "Estimate": " 2 ", "Done": "true", "Notes": ""},
+			expected: []UpdateCustomFieldItem{
+				{IDCard: card.ID, IDCustomField: "custom field 3", Name: "Done", Value: CustomFieldItemValue{Checked: "true"}},
+				{IDCard: card.ID, IDCustomField: "custom field 2", Name: "Estimate", Value: CustomFieldItemValue{Number: "2"}},
+				{IDCard: card.ID, IDCustomField: "custom field 4", Name: "Notes", Value: ""},
+				{IDCard: card.ID, IDCustomField: "custom field 1", Name: "Priority", IDValue: &idOption2},
+			},
+		},
+		"option cleared": {
+			given: map[string]string{"Priority": ""},
+			expected: []UpdateCustomFieldItem{
+				{IDCard: card.ID, IDCustomField: "custom field 1", Name: "Priority", IDValue: &noOption},
+			},
+		},
+		"unknown custom field": {
+			given:         map[string]string{"Size": "XL"},
+			expectedError: "unknown custom field 'Size'",
+		},
+		"unknown option": {
+			given:         map[string]string{"Priority": "urgent"},
+			expectedError: "invalid value 'urgent' for custom field 'Priority', use one of the options: high, low",
+		},
+		"invalid number": {
+			given:         map[string]string{"Estimate": "a lot"},
+			expectedError: "invalid value 'a lot' for custom field 'Estimate', use a number",
+		},
+		"invalid checkbox": {
+			given:         map[string]string{"Done": "maybe"},
+			expectedError: "invalid value 'maybe' for custom field 'Done', use true or false",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := customFields.Changes(card, tt.given)
+			if tt.expectedError != "" {
+				if err == nil || err.Error() != tt.expectedError {
+					t.Errorf("expected error %s, actual %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected no error, actual %v", err)
+				return
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCustomFieldItems_with(t *testing.T) {
+	items := CustomFieldItems{
+		{IDCustomField: "custom field 1", IDValue: "option 1"},
+		{IDCustomField: "custom field 2", Value: &CustomFieldItemValue{Number: "3"}},
+	}
+	var tests = map[string]struct {
+		given    CustomFieldItem
+		expected CustomFieldItems
+	}{
+		"new value": {
+			given: CustomFieldItem{IDCustomField: "custom field 3", Value: &CustomFieldItemValue{Text: "notes"}},
+			expected: CustomFieldItems{
+				items[0],
+				items[1],
+				{IDCustomField: "custom field 3", Value: &CustomFieldItemValue{Text: "notes"}},
+			},
+		},
+		"updated value": {
+			given: CustomFieldItem{IDCustomField: "custom field 1", IDValue: "option 2"},
+			expected: CustomFieldItems{
+				items[1],
+				{IDCustomField: "custom field 1", IDValue: "option 2"},
+			},
+		},
+		"cleared value": {
+			given:    CustomFieldItem{IDCustomField: "custom field 2", Value: &CustomFieldItemValue{}},
+			expected: CustomFieldItems{items[0]},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := items.with(tt.given)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
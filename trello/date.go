@@ -0,0 +1,223 @@
+package trello
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DateTimeLayout is the layout used to display the dates to the user
+	DateTimeLayout   = "2006-01-02 15:04"
+	dateLayout       = "2006-01-02"
+	clockLayout      = "15:04"
+	trelloDateLayout = "2006-01-02T15:04:05.000Z"
+	// hour set on the dates given without time
+	defaultHour = 12
+)
+
+var (
+	dateTimeLayouts = []string{
+		DateTimeLayout,
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04",
+		"2006-01-02T15:04:05",
+	}
+	weekdays = map[string]time.Weekday{
+		"sunday":    time.Sunday,
+		"monday":    time.Monday,
+		"tuesday":   time.Tuesday,
+		"wednesday": time.Wednesday,
+		"thursday":  time.Thursday,
+		"friday":    time.Friday,
+		"saturday":  time.Saturday,
+	}
+)
+
+// FormatDate converts a Trello date into the local date displayed to the user
+// returns the given date if it's not a valid Trello date
+func FormatDate(date string) string {
+	return formatDate(date, time.Local)
+}
+
+func formatDate(date string, loc *time.Location) string {
+	if date == "" {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return date
+	}
+	return t.In(loc).Format(DateTimeLayout)
+}
+
+// toTrelloDate converts the date given by the user into a Trello date
+// an empty date returns nil, so the date is removed
+func toTrelloDate(in string, now time.Time) (*string, error) {
+	if strings.TrimSpace(in) == "" {
+		return nil, nil
+	}
+	t, err := parseDate(in, now)
+	if err != nil {
+		return nil, err
+	}
+	date := t.UTC().Format(trelloDateLayout)
+	return &date, nil
+}
+
+// parseDate parses a date in a natural format:
+//   - an absolute date: "2026-11-01 17:00", "2026-11-01" or RFC3339
+//   - a relative day: "today", "tomorrow", "yesterday", "friday", "next friday", "in 3 days", "in 2 weeks",
+//     optionally followed by a time, e.g. "tomorrow 17:00"
+//   - a relative time: "now", "in 2 hours", "in 30 minutes"
+func parseDate(in string, now time.Time) (time.Time, error) {
+	return parseDateAt(in, now, defaultHour)
+}
+
+// ParseSince parses the start date of a period, like the dates accepted by the cards,
+// or a date in the past: "3 days ago", "2 weeks ago", "5 hours ago"
+// the dates given without time start at midnight
+func ParseSince(in string) (time.Time, error) {
+	return parseSince(in, time.Now())
+}
+
+func parseSince(in string, now time.Time) (time.Time, error) {
+	if t, ok := parseAgo(strings.Fields(strings.ToLower(in)), now); ok {
+		return t, nil
+	}
+	return parseDateAt(in, now, 0)
+}
+
+// parseAgo parses "<n> <unit> ago"
+func parseAgo(fields []string, now time.Time) (time.Time, bool) {
+	if len(fields) != 3 || fields[2] != "ago" {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return time.Time{}, false
+	}
+	switch strings.TrimSuffix(fields[1], "s") {
+	case "minute":
+		return now.Add(-time.Duration(n) * time.Minute), true
+	case "hour":
+		return now.Add(-time.Duration(n) * time.Hour), true
+	case "day":
+		return now.AddDate(0, 0, -n), true
+	case "week":
+		return now.AddDate(0, 0, -7*n), true
+	}
+	return time.Time{}, false
+}
+
+// parseDateAt parses the date, setting the given hour on the dates given without time
+func parseDateAt(in string, now time.Time, hourWithoutTime int) (time.Time, error) {
+	in = strings.TrimSpace(in)
+	if t, err := time.Parse(time.RFC3339, in); err == nil {
+		return t, nil
+	}
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, in, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	fields := strings.Fields(strings.ToLower(in))
+	if len(fields) == 0 {
+		return time.Time{}, newInvalidDateError(in)
+	}
+	if t, ok := parseRelativeTime(fields, now); ok {
+		return t, nil
+	}
+
+	hour, minute := hourWithoutTime, 0
+	if clock, err := time.Parse(clockLayout, fields[len(fields)-1]); err == nil && len(fields) > 1 {
+		hour, minute = clock.Hour(), clock.Minute()
+		fields = fields[:len(fields)-1]
+	}
+	day, ok := parseDay(fields, now)
+	if !ok {
+		return time.Time{}, newInvalidDateError(in)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, now.Location()), nil
+}
+
+func parseRelativeTime(fields []string, now time.Time) (time.Time, bool) {
+	if len(fields) == 1 && fields[0] == "now" {
+		return now, true
+	}
+	n, unit, ok := parseIn(fields)
+	if !ok {
+		return time.Time{}, false
+	}
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute":
+		return now.Add(time.Duration(n) * time.Minute), true
+	case "hour":
+		return now.Add(time.Duration(n) * time.Hour), true
+	}
+	return time.Time{}, false
+}
+
+func parseDay(fields []string, now time.Time) (time.Time, bool) {
+	if len(fields) == 1 {
+		switch fields[0] {
+		case "today":
+			return now, true
+		case "tomorrow":
+			return now.AddDate(0, 0, 1), true
+		case "yesterday":
+			return now.AddDate(0, 0, -1), true
+		}
+		if t, err := time.ParseInLocation(dateLayout, fields[0], now.Location()); err == nil {
+			return t, true
+		}
+	}
+	if len(fields) == 2 && fields[0] == "next" {
+		fields = fields[1:]
+	}
+	if len(fields) == 1 {
+		if weekday, found := weekdays[fields[0]]; found {
+			days := (int(weekday) - int(now.Weekday()) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+			return now.AddDate(0, 0, days), true
+		}
+	}
+	n, unit, ok := parseIn(fields)
+	if !ok {
+		return time.Time{}, false
+	}
+	switch strings.TrimSuffix(unit, "s") {
+	case "day":
+		return now.AddDate(0, 0, n), true
+	case "week":
+		return now.AddDate(0, 0, 7*n), true
+	}
+	return time.Time{}, false
+}
+
+// parseIn parses "in <n> <unit>"
+func parseIn(fields []string) (n int, unit string, ok bool) {
+	if len(fields) != 3 || fields[0] != "in" {
+		return 0, "", false
+	}
+	n, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, "", false
+	}
+	return n, fields[2], true
+}
+
+func toNullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func newInvalidDateError(in string) error {
+	return fmt.Errorf("invalid date '%s', use a date like '2026-11-01 17:00', '2026-11-01', 'tomorrow' or 'in 3 days'", in)
+}
@@ -0,0 +1,207 @@
+package trello
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	// sunday
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	var tests = map[string]struct {
+		given    string
+		expected time.Time
+		hasError bool
+	}{
+		"date and time": {
+			given:    "2026-11-01 17:00",
+			expected: time.Date(2026, 11, 1, 17, 0, 0, 0, time.UTC),
+		},
+		"date only": {
+			given:    "2026-11-01",
+			expected: time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC),
+		},
+		"RFC3339": {
+			given:    "2026-11-01T17:00:00+02:00",
+			expected: time.Date(2026, 11, 1, 15, 0, 0, 0, time.UTC),
+		},
+		"trello date": {
+			given:    "2026-11-01T17:00:00.000Z",
+			expected: time.Date(2026, 11, 1, 17, 0, 0, 0, time.UTC),
+		},
+		"now": {
+			given:    "now",
+			expected: now,
+		},
+		"today": {
+			given:    "today",
+			expected: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		},
+		"tomorrow": {
+			given:    "Tomorrow",
+			expected: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		},
+		"tomorrow with time": {
+			given:    "tomorrow 17:30",
+			expected: time.Date(2026, 10, 19, 17, 30, 0, 0, time.UTC),
+		},
+		"yesterday": {
+			given:    "yesterday",
+			expected: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+		},
+		"weekday": {
+			given:    "friday",
+			expected: time.Date(2026, 10, 23, 12, 0, 0, 0, time.UTC),
+		},
+		"next same weekday": {
+			given:    "next sunday 08:00",
+			expected: time.Date(2026, 10, 25, 8, 0, 0, 0, time.UTC),
+		},
+		"in days": {
+			given:    "in 3 days",
+			expected: time.Date(2026, 10, 21, 12, 0, 0, 0, time.UTC),
+		},
+		"in a week": {
+			given:    "in 1 week",
+			expected: time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC),
+		},
+		"in hours": {
+			given:    "in 2 hours",
+			expected: time.Date(2026, 10, 18, 11, 30, 0, 0, time.UTC),
+		},
+		"unknown format": {
+			given:    "someday",
+			hasError: true,
+		},
+		"unknown unit": {
+			given:    "in 3 months",
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, actualErr := parseDate(tt.given, now)
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.hasError, actualErr)
+				t.FailNow()
+			}
+			if !actual.Equal(tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	var tests = map[string]struct {
+		given    string
+		expected time.Time
+		hasError bool
+	}{
+		"date only starts at midnight": {
+			given:    "2026-10-01",
+			expected: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"date and time": {
+			given:    "2026-10-01 17:00",
+			expected: time.Date(2026, 10, 1, 17, 0, 0, 0, time.UTC),
+		},
+		"yesterday": {
+			given:    "yesterday",
+			expected: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+		},
+		"days ago": {
+			given:    "3 days ago",
+			expected: time.Date(2026, 10, 15, 9, 30, 0, 0, time.UTC),
+		},
+		"a week ago": {
+			given:    "1 week ago",
+			expected: time.Date(2026, 10, 11, 9, 30, 0, 0, time.UTC),
+		},
+		"hours ago": {
+			given:    "5 hours ago",
+			expected: time.Date(2026, 10, 18, 4, 30, 0, 0, time.UTC),
+		},
+		"unknown unit": {
+			given:    "3 months ago",
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, actualErr := parseSince(tt.given, now)
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.hasError, actualErr)
+				t.FailNow()
+			}
+			if !actual.Equal(tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestToTrelloDate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	date := "2026-10-19T12:00:00.000Z"
+	var tests = map[string]struct {
+		given    string
+		expected *string
+		hasError bool
+	}{
+		"natural date": {
+			given:    "tomorrow",
+			expected: &date,
+		},
+		"empty date": {
+			given:    " ",
+			expected: nil,
+		},
+		"invalid date": {
+			given:    "foobar",
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, actualErr := toTrelloDate(tt.given, now)
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected error %v, actual error %v", tt.hasError, actualErr)
+				t.FailNow()
+			}
+			if (actual == nil) != (tt.expected == nil) || actual != nil && *actual != *tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	var tests = map[string]struct {
+		given    string
+		expected string
+	}{
+		"trello date": {
+			given:    "2026-11-01T16:00:00.000Z",
+			expected: "2026-11-01 17:00",
+		},
+		"empty date": {
+			given:    "",
+			expected: "",
+		},
+		"invalid date": {
+			given:    "foobar",
+			expected: "foobar",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := formatDate(tt.given, paris)
+			if actual != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,496 @@
+package trello
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// DryRunToggle enables or disables the dry-run mode of the Repository
+type DryRunToggle interface {
+	IsDryRun() bool
+	SetDryRun(enabled bool)
+}
+
+// DryRun is a decorator that, when enabled, prints the mutations it would send to the proxified Repository
+// as diffs against the current state of the resources, without sending them
+type DryRun struct {
+	*tracker
+	enabled bool
+	w       io.Writer
+}
+
+func NewDryRun(r Repository, enabled bool, w io.Writer) Repository {
+	d := &DryRun{
+		tracker: newTracker(r),
+		enabled: enabled,
+		w:       w,
+	}
+	if h, ok := r.(History); ok {
+		return &dryRunHistory{DryRun: d, history: h}
+	}
+	return d
+}
+
+func (d *DryRun) IsDryRun() bool {
+	return d.enabled
+}
+
+func (d *DryRun) SetDryRun(enabled bool) {
+	d.enabled = enabled
+}
+
+// BOARDS -------------------------------------------------------------------
+
+func (d *DryRun) CreateBoard(createBoard CreateBoard) (*Board, error) {
+	if !d.enabled {
+		return d.Repository.CreateBoard(createBoard)
+	}
+	d.printFields(fmt.Sprintf("create board '%s'", createBoard.Name), createBoard)
+	return &Board{Name: createBoard.Name, Desc: createBoard.Desc}, nil
+}
+
+func (d *DryRun) UpdateBoard(updateBoard UpdateBoard) (*Board, error) {
+	if !d.enabled {
+		return d.Repository.UpdateBoard(updateBoard)
+	}
+	board := Board{}
+	if before, ok := d.boards[updateBoard.ID]; ok {
+		board = before
+		d.printDiff(fmt.Sprintf("update board '%s'", before.Name), NewUpdateBoard(before), updateBoard)
+	} else {
+		d.printFields(fmt.Sprintf("update board '%s' (current state unknown)", updateBoard.Name), updateBoard)
+	}
+	board.ID, board.Name, board.Desc, board.Closed = updateBoard.ID, updateBoard.Name, updateBoard.Desc, updateBoard.Closed
+	d.boards[board.ID] = board
+	return &board, nil
+}
+
+func (d *DryRun) CloseBoard(idBoard string) error {
+	if !d.enabled {
+		return d.Repository.CloseBoard(idBoard)
+	}
+	d.print(fmt.Sprintf("close board %s", d.boardName(idBoard)), nil)
+	return nil
+}
+
+// LABELS -------------------------------------------------------------------
+
+func (d *DryRun) CreateLabel(createLabel CreateLabel) (*Label, error) {
+	if !d.enabled {
+		return d.Repository.CreateLabel(createLabel)
+	}
+	d.printFields(fmt.Sprintf("create label '%s' in board %s", createLabel.Name, d.boardName(createLabel.IDBoard)), createLabel)
+	label := Label{IDBoard: createLabel.IDBoard, Name: createLabel.Name}
+	if createLabel.Color != nil {
+		label.Color = *createLabel.Color
+	}
+	return &label, nil
+}
+
+func (d *DryRun) UpdateLabel(updateLabel UpdateLabel) (*Label, error) {
+	if !d.enabled {
+		return d.Repository.UpdateLabel(updateLabel)
+	}
+	d.printFields(fmt.Sprintf("update label '%s' in board %s", updateLabel.ID, d.boardName(updateLabel.IDBoard)), updateLabel)
+	label := Label{ID: updateLabel.ID, IDBoard: updateLabel.IDBoard, Name: updateLabel.Name}
+	if updateLabel.Color != nil {
+		label.Color = *updateLabel.Color
+	}
+	return &label, nil
+}
+
+func (d *DryRun) DeleteLabel(idBoard, idLabel string) error {
+	if !d.enabled {
+		return d.Repository.DeleteLabel(idBoard, idLabel)
+	}
+	d.print(fmt.Sprintf("delete label '%s' in board %s", idLabel, d.boardName(idBoard)), nil)
+	return nil
+}
+
+// LISTS -------------------------------------------------------------------
+
+func (d *DryRun) CreateList(createList CreateList) (*List, error) {
+	if !d.enabled {
+		return d.Repository.CreateList(createList)
+	}
+	d.printFields(fmt.Sprintf("create list '%s' in board %s", createList.Name, d.boardName(createList.IDBoard)), createList)
+	return &List{Name: createList.Name, IDBoard: createList.IDBoard}, nil
+}
+
+func (d *DryRun) UpdateList(updateList UpdateList) (*List, error) {
+	if !d.enabled {
+		return d.Repository.UpdateList(updateList)
+	}
+	list := List{}
+	if before, ok := d.lists[updateList.ID]; ok {
+		list = before
+		d.printDiff(fmt.Sprintf("update list '%s'", before.Name), NewUpdateList(before), updateList)
+	} else {
+		d.printFields(fmt.Sprintf("update list '%s' (current state unknown)", updateList.Name), updateList)
+	}
+	list.ID, list.Name, list.IDBoard, list.Closed = updateList.ID, updateList.Name, updateList.IDBoard, updateList.Closed
+	d.lists[list.ID] = list
+	return &list, nil
+}
+
+func (d *DryRun) ArchiveList(idBoard, idList string) error {
+	if !d.enabled {
+		return d.Repository.ArchiveList(idBoard, idList)
+	}
+	d.print(fmt.Sprintf("archive list %s", d.listName(idList)), nil)
+	return nil
+}
+
+// CARDS -------------------------------------------------------------------
+
+func (d *DryRun) ArchiveAllCards(idList string) error {
+	if !d.enabled {
+		return d.Repository.ArchiveAllCards(idList)
+	}
+	var lines []string
+	if cards, err := d.Repository.FindCards(idList); err == nil {
+		for _, card := range cards {
+			lines = append(lines, fmt.Sprintf("- '%s'", card.Name))
+		}
+	}
+	d.print(fmt.Sprintf("archive all cards of list %s", d.listName(idList)), lines)
+	return nil
+}
+
+func (d *DryRun) CreateCard(createCard CreateCard) (*Card, error) {
+	if !d.enabled {
+		return d.Repository.CreateCard(createCard)
+	}
+	d.printFields(fmt.Sprintf("create card '%s' in list %s", createCard.Name, d.listName(createCard.IDList)), createCard)
+	return &Card{Name: createCard.Name, Desc: createCard.Desc, IDList: createCard.IDList}, nil
+}
+
+func (d *DryRun) UpdateCard(updateCard UpdateCard) (*Card, error) {
+	if !d.enabled {
+		return d.Repository.UpdateCard(updateCard)
+	}
+	card := Card{}
+	if before, ok := d.cards[updateCard.ID]; ok {
+		card = before
+		d.printDiff(fmt.Sprintf("update card '%s'", before.Name), NewUpdateCard(before), updateCard)
+	} else {
+		d.printFields(fmt.Sprintf("update card '%s' (current state unknown)", updateCard.Name), updateCard)
+	}
+	card.ID, card.Name, card.Desc, card.Closed = updateCard.ID, updateCard.Name, updateCard.Desc, updateCard.Closed
+	card.IDBoard, card.IDList = updateCard.IDBoard, updateCard.IDList
+	d.cards[card.ID] = card
+	return &card, nil
+}
+
+// CHECKLISTS -------------------------------------------------------------------
+
+func (d *DryRun) CreateChecklist(createChecklist CreateChecklist) (*Checklist, error) {
+	if !d.enabled {
+		return d.Repository.CreateChecklist(createChecklist)
+	}
+	d.printFields(fmt.Sprintf("create checklist '%s' in card %s", createChecklist.Name, d.cardName(createChecklist.IDCard)), createChecklist)
+	return &Checklist{IDCard: createChecklist.IDCard, Name: createChecklist.Name}, nil
+}
+
+func (d *DryRun) UpdateChecklist(updateChecklist UpdateChecklist) (*Checklist, error) {
+	if !d.enabled {
+		return d.Repository.UpdateChecklist(updateChecklist)
+	}
+	d.printFields(fmt.Sprintf("update checklist '%s' in card %s", updateChecklist.ID, d.cardName(updateChecklist.IDCard)), updateChecklist)
+	return &Checklist{ID: updateChecklist.ID, IDCard: updateChecklist.IDCard, Name: updateChecklist.Name}, nil
+}
+
+func (d *DryRun) DeleteChecklist(idCard, idChecklist string) error {
+	if !d.enabled {
+		return d.Repository.DeleteChecklist(idCard, idChecklist)
+	}
+	d.print(fmt.Sprintf("delete checklist '%s' in card %s", idChecklist, d.cardName(idCard)), nil)
+	return nil
+}
+
+func (d *DryRun) CreateCheckItem(createCheckItem CreateCheckItem) (*CheckItem, error) {
+	if !d.enabled {
+		return d.Repository.CreateCheckItem(createCheckItem)
+	}
+	d.printFields(fmt.Sprintf("create checklist item '%s' in card %s", createCheckItem.Name, d.cardName(createCheckItem.IDCard)), createCheckItem)
+	return &CheckItem{IDChecklist: createCheckItem.IDChecklist, Name: createCheckItem.Name}, nil
+}
+
+func (d *DryRun) UpdateCheckItem(updateCheckItem UpdateCheckItem) (*CheckItem, error) {
+	if !d.enabled {
+		return d.Repository.UpdateCheckItem(updateCheckItem)
+	}
+	d.printFields(fmt.Sprintf("update checklist item '%s' in card %s", updateCheckItem.Name, d.cardName(updateCheckItem.IDCard)), updateCheckItem)
+	return &CheckItem{
+		ID:          updateCheckItem.ID,
+		IDChecklist: updateCheckItem.IDChecklist,
+		Name:        updateCheckItem.Name,
+		State:       updateCheckItem.State,
+	}, nil
+}
+
+func (d *DryRun) DeleteCheckItem(idCard, idCheckItem string) error {
+	if !d.enabled {
+		return d.Repository.DeleteCheckItem(idCard, idCheckItem)
+	}
+	d.print(fmt.Sprintf("delete checklist item '%s' in card %s", idCheckItem, d.cardName(idCard)), nil)
+	return nil
+}
+
+// CUSTOM FIELDS -------------------------------------------------------------------
+
+func (d *DryRun) UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	if !d.enabled {
+		return d.Repository.UpdateCustomFieldItem(updateCustomFieldItem)
+	}
+	if updateCustomFieldItem.Clears() {
+		d.print(fmt.Sprintf("clear custom field '%s' in card %s", updateCustomFieldItem.Name, d.cardName(updateCustomFieldItem.IDCard)), nil)
+		return &CustomFieldItem{IDCustomField: updateCustomFieldItem.IDCustomField, IDModel: updateCustomFieldItem.IDCard}, nil
+	}
+	d.printFields(fmt.Sprintf("update custom field '%s' in card %s", updateCustomFieldItem.Name, d.cardName(updateCustomFieldItem.IDCard)), updateCustomFieldItem)
+	return &CustomFieldItem{IDCustomField: updateCustomFieldItem.IDCustomField, IDModel: updateCustomFieldItem.IDCard}, nil
+}
+
+// ATTACHMENTS -------------------------------------------------------------------
+
+func (d *DryRun) CreateAttachment(createAttachment CreateAttachment) (*Attachment, error) {
+	if !d.enabled {
+		return d.Repository.CreateAttachment(createAttachment)
+	}
+	d.print(fmt.Sprintf("upload attachment '%s' to card %s", createAttachment.Name, d.cardName(createAttachment.IDCard)), nil)
+	return &Attachment{Name: createAttachment.Name, IsUpload: true}, nil
+}
+
+func (d *DryRun) DeleteAttachment(idCard, idAttachment string) error {
+	if !d.enabled {
+		return d.Repository.DeleteAttachment(idCard, idAttachment)
+	}
+	d.print(fmt.Sprintf("delete attachment '%s' of card %s", idAttachment, d.cardName(idCard)), nil)
+	return nil
+}
+
+// COMMENTS -------------------------------------------------------------------
+
+func (d *DryRun) CreateComment(createComment CreateComment) (*Comment, error) {
+	if !d.enabled {
+		return d.Repository.CreateComment(createComment)
+	}
+	d.printFields(fmt.Sprintf("create comment in card %s", d.cardName(createComment.IDCard)), createComment)
+	return &Comment{Data: CommentData{Card: CommentDataCard{ID: createComment.IDCard}, Text: createComment.Text}}, nil
+}
+
+func (d *DryRun) UpdateComment(updateComment UpdateComment) (*Comment, error) {
+	if !d.enabled {
+		return d.Repository.UpdateComment(updateComment)
+	}
+	header := fmt.Sprintf("update comment '%s' in card %s", updateComment.ID, d.cardName(updateComment.IDCard))
+	if before, ok := d.comments[updateComment.ID]; ok {
+		d.printDiff(header, UpdateComment{ID: before.ID, IDCard: updateComment.IDCard, Text: before.Data.Text}, updateComment)
+	} else {
+		d.printFields(header+" (current state unknown)", updateComment)
+	}
+	comment := Comment{ID: updateComment.ID, Data: CommentData{Card: CommentDataCard{ID: updateComment.IDCard}, Text: updateComment.Text}}
+	d.comments[comment.ID] = comment
+	return &comment, nil
+}
+
+func (d *DryRun) DeleteComment(idCard, idComment string) error {
+	if !d.enabled {
+		return d.Repository.DeleteComment(idCard, idComment)
+	}
+	var lines []string
+	if comment, ok := d.comments[idComment]; ok {
+		lines = append(lines, fmt.Sprintf("text: %s", toJSON(comment.Data.Text)))
+	}
+	d.print(fmt.Sprintf("delete comment '%s' in card %s", idComment, d.cardName(idCard)), lines)
+	return nil
+}
+
+// WEBHOOKS -------------------------------------------------------------------
+
+func (d *DryRun) CreateWebhook(createWebhook CreateWebhook) (*Webhook, error) {
+	if !d.enabled {
+		return d.Repository.CreateWebhook(createWebhook)
+	}
+	d.printFields(fmt.Sprintf("create webhook for board %s", d.boardName(createWebhook.IDModel)), createWebhook)
+	return &Webhook{Description: createWebhook.Description, IDModel: createWebhook.IDModel, CallbackURL: createWebhook.CallbackURL}, nil
+}
+
+func (d *DryRun) DeleteWebhook(idWebhook string) error {
+	if !d.enabled {
+		return d.Repository.DeleteWebhook(idWebhook)
+	}
+	d.print(fmt.Sprintf("delete webhook %s", idWebhook), nil)
+	return nil
+}
+
+// HISTORY -------------------------------------------------------------------
+
+// dryRunHistory is the DryRun of a Repository having a journal, so the undo and redo are not sent either
+type dryRunHistory struct {
+	*DryRun
+	history History
+}
+
+func (d *dryRunHistory) Entries() (JournalEntries, error) {
+	return d.history.Entries()
+}
+
+func (d *dryRunHistory) Undo() (*JournalEntry, error) {
+	if !d.enabled {
+		return d.history.Undo()
+	}
+	entries, err := d.history.Entries()
+	if err != nil {
+		return nil, err
+	}
+	i := entries.lastDone()
+	if i == -1 {
+		return nil, nothingToUndoErr
+	}
+	d.print(fmt.Sprintf("undo %s", entries[i]), d.snapshotDiff(entries[i].After, entries[i].Before))
+	return &entries[i], nil
+}
+
+func (d *dryRunHistory) Redo() (*JournalEntry, error) {
+	if !d.enabled {
+		return d.history.Redo()
+	}
+	entries, err := d.history.Entries()
+	if err != nil {
+		return nil, err
+	}
+	i := entries.firstUndone()
+	if i == -1 {
+		return nil, nothingToRedoErr
+	}
+	d.print(fmt.Sprintf("redo %s", entries[i]), d.snapshotDiff(entries[i].Before, entries[i].After))
+	return &entries[i], nil
+}
+
+// snapshotDiff returns the fields modified when the resource goes from a snapshot to another one,
+// which is only possible for the updates of a single resource
+func (d *DryRun) snapshotDiff(from, to *JournalSnapshot) []string {
+	if from == nil || to == nil {
+		return nil
+	}
+	switch {
+	case from.Board != nil && to.Board != nil:
+		return d.diff(NewUpdateBoard(*from.Board), NewUpdateBoard(*to.Board))
+	case from.List != nil && to.List != nil:
+		return d.diff(NewUpdateList(*from.List), NewUpdateList(*to.List))
+	case from.Card != nil && to.Card != nil:
+		return d.diff(NewUpdateCard(*from.Card), NewUpdateCard(*to.Card))
+	case from.Comment != nil && to.Comment != nil:
+		return d.diff(map[string]string{"text": from.Comment.Data.Text}, map[string]string{"text": to.Comment.Data.Text})
+	}
+	return nil
+}
+
+// PRINT -------------------------------------------------------------------
+
+func (d *DryRun) print(header string, lines []string) {
+	fmt.Fprintf(d.w, "[dry-run] %s\n", header)
+	for _, line := range lines {
+		fmt.Fprintf(d.w, "  %s\n", line)
+	}
+}
+
+// printFields prints the non empty fields of the resource to create
+func (d *DryRun) printFields(header string, v interface{}) {
+	fields := toFields(v)
+	var lines []string
+	for _, key := range sortedKeys(fields) {
+		if key == "id" || isEmptyField(fields[key]) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", key, d.describe(key, fields[key])))
+	}
+	d.print(header, lines)
+}
+
+// printDiff prints the fields of the resource that are modified by the mutation
+func (d *DryRun) printDiff(header string, before, after interface{}) {
+	lines := d.diff(before, after)
+	if lines == nil {
+		lines = []string{"no change"}
+	}
+	d.print(header, lines)
+}
+
+func (d *DryRun) diff(before, after interface{}) []string {
+	beforeFields, afterFields := toFields(before), toFields(after)
+	var lines []string
+	for _, key := range sortedKeys(afterFields) {
+		if toJSON(beforeFields[key]) == toJSON(afterFields[key]) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s -> %s", key, d.describe(key, beforeFields[key]), d.describe(key, afterFields[key])))
+	}
+	return lines
+}
+
+// describe the value of a field, using the names of the boards and lists instead of their IDs when known
+func (d *DryRun) describe(key string, value interface{}) string {
+	if id, ok := value.(string); ok {
+		switch key {
+		case "idBoard":
+			return d.boardName(id)
+		case "idList":
+			return d.listName(id)
+		}
+	}
+	return toJSON(value)
+}
+
+func (d *DryRun) boardName(idBoard string) string {
+	if board, ok := d.boards[idBoard]; ok {
+		return fmt.Sprintf("'%s'", board.Name)
+	}
+	return fmt.Sprintf("'%s'", idBoard)
+}
+
+func (d *DryRun) listName(idList string) string {
+	if list, ok := d.lists[idList]; ok {
+		return fmt.Sprintf("'%s'", list.Name)
+	}
+	return fmt.Sprintf("'%s'", idList)
+}
+
+func (d *DryRun) cardName(idCard string) string {
+	if card, ok := d.cards[idCard]; ok {
+		return fmt.Sprintf("'%s'", card.Name)
+	}
+	return fmt.Sprintf("'%s'", idCard)
+}
+
+func toFields(v interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	if b, err := json.Marshal(v); err == nil {
+		_ = json.Unmarshal(b, &fields)
+	}
+	return fields
+}
+
+func toJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+func isEmptyField(v interface{}) bool {
+	return v == nil || v == "" || v == false
+}
+
+func sortedKeys(fields map[string]interface{}) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
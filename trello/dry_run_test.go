@@ -0,0 +1,169 @@
+package trello
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+func TestDryRun_UpdateCard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	card := Card{ID: "card 1", Name: "card", IDBoard: "board 1", IDList: "list 1"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindLists("board 1").
+		Return(Lists{{ID: "list 1", Name: "todo"}, {ID: "list 2", Name: "doing"}}, nil)
+	tr.EXPECT().
+		FindCard("list 1", "card").
+		Return(&card, nil)
+	buf := bytes.Buffer{}
+	d := NewDryRun(tr, true, &buf)
+
+	if _, err := d.FindLists("board 1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.FindCard("list 1", "card"); err != nil {
+		t.Fatal(err)
+	}
+	updateCard := NewUpdateCard(card)
+	updateCard.IDList = "list 2"
+	updateCard.Desc = "description"
+	actual, err := d.UpdateCard(updateCard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual.IDList != "list 2" || actual.Desc != "description" {
+		t.Errorf("unexpected card %v", actual)
+	}
+	expected := `[dry-run] update card 'card'
+  desc: "" -> "description"
+  idList: 'todo' -> 'doing'
+`
+	if buf.String() != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, buf.String())
+	}
+}
+
+func TestDryRun_CreateCard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buf := bytes.Buffer{}
+	d := NewDryRun(NewMockRepository(ctrl), true, &buf)
+
+	if _, err := d.CreateCard(CreateCard{Name: "card", Desc: "description", IDList: "list 1"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := `[dry-run] create card 'card' in list 'list 1'
+  desc: "description"
+  idList: 'list 1'
+  name: "card"
+`
+	if buf.String() != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, buf.String())
+	}
+}
+
+func TestDryRun_ArchiveAllCards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindCards("list 1").
+		Return(Cards{{ID: "card 1", Name: "card 1"}, {ID: "card 2", Name: "card 2"}}, nil)
+	buf := bytes.Buffer{}
+	d := NewDryRun(tr, true, &buf)
+
+	if err := d.ArchiveAllCards("list 1"); err != nil {
+		t.Fatal(err)
+	}
+	expected := `[dry-run] archive all cards of list 'list 1'
+  - 'card 1'
+  - 'card 2'
+`
+	if buf.String() != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, buf.String())
+	}
+}
+
+func TestDryRun_DeleteComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	comment := Comment{ID: "comment 1", Data: CommentData{Card: CommentDataCard{ID: "card 1"}, Text: "some text"}}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindComment("card 1", "comment 1").
+		Return(&comment, nil)
+	buf := bytes.Buffer{}
+	d := NewDryRun(tr, true, &buf)
+
+	if _, err := d.FindComment("card 1", "comment 1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DeleteComment("card 1", "comment 1"); err != nil {
+		t.Fatal(err)
+	}
+	expected := `[dry-run] delete comment 'comment 1' in card 'card 1'
+  text: "some text"
+`
+	if buf.String() != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, buf.String())
+	}
+}
+
+func TestDryRun_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		CreateCard(CreateCard{Name: "card", IDList: "list 1"}).
+		Return(&Card{ID: "card 1", Name: "card"}, nil)
+	buf := bytes.Buffer{}
+	d := NewDryRun(tr, false, &buf)
+
+	if _, err := d.CreateCard(CreateCard{Name: "card", IDList: "list 1"}); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be printed, actual %v", buf.String())
+	}
+}
+
+func TestDryRun_Undo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewMockHistory(ctrl)
+	h.EXPECT().
+		Entries().
+		Return(JournalEntries{{
+			ID:        1,
+			Operation: updateCardOperation,
+			Before:    &JournalSnapshot{Card: &Card{ID: "card 1", Name: "card"}},
+			After:     &JournalSnapshot{Card: &Card{ID: "card 1", Name: "renamed card"}},
+		}}, nil)
+	buf := bytes.Buffer{}
+	d := NewDryRun(struct {
+		Repository
+		History
+	}{NewMockRepository(ctrl), h}, true, &buf)
+
+	entry, err := d.(History).Undo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.ID != 1 || entry.Undone {
+		t.Errorf("unexpected entry %v", entry)
+	}
+	expected := `[dry-run] undo update card 'renamed card'
+  name: "renamed card" -> "card"
+`
+	if buf.String() != expected {
+		t.Errorf("expected:\n%v\nactual:\n%v", expected, buf.String())
+	}
+}
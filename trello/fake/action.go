@@ -0,0 +1,282 @@
+package fake
+
+import (
+	"github.com/l-lin/tcli/trello"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// types of the actions recorded by the fake server
+// See https://developer.atlassian.com/cloud/trello/guides/rest-api/action-types/ for more info
+const (
+	createCardActionType           = "createCard"
+	updateCardActionType           = "updateCard"
+	commentCardActionType          = "commentCard"
+	addLabelToCardActionType       = "addLabelToCard"
+	removeLabelFromCardActionType  = "removeLabelFromCard"
+	addMemberToCardActionType      = "addMemberToCard"
+	removeMemberFromCardActionType = "removeMemberFromCard"
+	moveCardToBoardActionType      = "moveCardToBoard"
+	moveCardFromBoardActionType    = "moveCardFromBoard"
+	updateBoardActionType          = "updateBoard"
+	createListActionType           = "createList"
+	updateListActionType           = "updateList"
+	moveListToBoardActionType      = "moveListToBoard"
+	moveListFromBoardActionType    = "moveListFromBoard"
+	createLabelActionType          = "createLabel"
+	updateLabelActionType          = "updateLabel"
+	deleteLabelActionType          = "deleteLabel"
+)
+
+// action has the fields of both the trello.Action and the trello.Comment, as the comments are actions
+type action struct {
+	ID              string        `json:"id"`
+	IDMemberCreator string        `json:"idMemberCreator"`
+	Type            string        `json:"type"`
+	Date            string        `json:"date"`
+	Data            actionData    `json:"data"`
+	MemberCreator   actionMember  `json:"memberCreator"`
+	Member          *actionMember `json:"member,omitempty"`
+	idBoard         string
+}
+
+type actionData struct {
+	Card        *actionCard            `json:"card,omitempty"`
+	List        *actionResource        `json:"list,omitempty"`
+	ListBefore  *actionResource        `json:"listBefore,omitempty"`
+	ListAfter   *actionResource        `json:"listAfter,omitempty"`
+	Board       *actionResource        `json:"board,omitempty"`
+	BoardSource *actionResource        `json:"boardSource,omitempty"`
+	BoardTarget *actionResource        `json:"boardTarget,omitempty"`
+	Label       *trello.Label          `json:"label,omitempty"`
+	Old         map[string]interface{} `json:"old,omitempty"`
+	Text        string                 `json:"text,omitempty"`
+}
+
+type actionCard struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IDShort   int    `json:"idShort"`
+	IDList    string `json:"idList,omitempty"`
+	ShortLink string `json:"shortLink"`
+	Closed    bool   `json:"closed"`
+}
+
+type actionResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type actionMember struct {
+	ID       string `json:"id"`
+	FullName string `json:"fullName"`
+	Initials string `json:"initials"`
+	Username string `json:"username"`
+}
+
+func (s *Server) cardResource(c *card) *actionCard {
+	return &actionCard{ID: c.ID, Name: c.Name, IDShort: c.idShort, IDList: c.IDList, ShortLink: c.ShortLink, Closed: c.Closed}
+}
+
+func listResource(l trello.List) *actionResource {
+	return &actionResource{ID: l.ID, Name: l.Name}
+}
+
+func (s *Server) boardResource(idBoard string) *actionResource {
+	b := s.findBoard(idBoard)
+	return &actionResource{ID: b.ID, Name: b.Name}
+}
+
+func (s *Server) actionMember(idMember string) *actionMember {
+	member := s.findMember(idMember)
+	if member == nil {
+		return nil
+	}
+	var initials string
+	for _, word := range strings.Fields(member.FullName) {
+		initials += strings.ToUpper(word[:1])
+	}
+	return &actionMember{ID: member.ID, FullName: member.FullName, Initials: initials, Username: member.Username}
+}
+
+// record the action of the current member on the board, then send it to the webhooks of the board
+func (s *Server) record(actionType, idBoard string, data actionData) *action {
+	if data.Label != nil {
+		// keep the label as it is now, as it may be changed later
+		label := *data.Label
+		data.Label = &label
+	}
+	data.Board = s.boardResource(idBoard)
+	a := &action{
+		ID:              s.newID(),
+		IDMemberCreator: s.me.ID,
+		Type:            actionType,
+		Date:            s.date(),
+		Data:            data,
+		MemberCreator:   *s.actionMember(s.me.ID),
+		idBoard:         idBoard,
+	}
+	s.actions = append([]*action{a}, s.actions...)
+	s.touch(idBoard)
+	s.deliver(a)
+	return a
+}
+
+func (s *Server) findActionByID(idAction string) *action {
+	for _, a := range s.actions {
+		if a.ID == idAction {
+			return a
+		}
+	}
+	return nil
+}
+
+// filterActions returns the actions matching the "filter", "since" and "limit" queries, from the most recent
+// to the oldest
+func filterActions(r *http.Request, actions []*action) (interface{}, error) {
+	q := r.URL.Query()
+	var types []string
+	if filter := q.Get("filter"); filter != "" && filter != "all" {
+		types = strings.Split(filter, ",")
+	}
+	var since time.Time
+	if q.Get("since") != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, q.Get("since")); err != nil {
+			return nil, invalidValue("since")
+		}
+	}
+	limit := 50
+	if l := q.Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 0 || limit > 1000 {
+			return nil, invalidValue("limit")
+		}
+	}
+	filtered := []action{}
+	for _, a := range actions {
+		if len(filtered) == limit {
+			break
+		}
+		if types != nil && !contains(types, a.Type) {
+			continue
+		}
+		if date, _ := time.Parse(dateLayout, a.Date); !since.IsZero() && !date.After(since) {
+			continue
+		}
+		filtered = append(filtered, *a)
+	}
+	return filtered, nil
+}
+
+func (s *Server) findBoardActions(r *http.Request, params []string) (interface{}, error) {
+	if s.findBoard(params[0]) == nil {
+		return nil, errNotFound
+	}
+	var actions []*action
+	for _, a := range s.actions {
+		if a.idBoard == params[0] {
+			actions = append(actions, a)
+		}
+	}
+	return filterActions(r, actions)
+}
+
+func (s *Server) findCardActions(r *http.Request, params []string) (interface{}, error) {
+	if s.findCard(params[0]) == nil {
+		return nil, errNotFound
+	}
+	var actions []*action
+	for _, a := range s.actions {
+		if a.Data.Card != nil && a.Data.Card.ID == params[0] {
+			actions = append(actions, a)
+		}
+	}
+	return filterActions(r, actions)
+}
+
+func (s *Server) findAction(_ *http.Request, params []string) (interface{}, error) {
+	a := s.findActionByID(params[0])
+	if a == nil {
+		return nil, errNotFound
+	}
+	return a, nil
+}
+
+// COMMENTS ----------------------------------------------------------------------------------------------------
+
+func (s *Server) createCommentWithText(c *card, text string) *action {
+	return s.record(commentCardActionType, c.IDBoard, actionData{
+		Card: s.cardResource(c),
+		List: listResource(*s.findList(c.IDList)),
+		Text: text,
+	})
+}
+
+// findComment returns the comment of the card, that can only be changed by its author
+func (s *Server) findComment(idCard, idComment string) (*action, error) {
+	a := s.findActionByID(idComment)
+	if a == nil || a.Type != commentCardActionType || a.Data.Card == nil || a.Data.Card.ID != idCard {
+		return nil, errNotFound
+	}
+	if a.IDMemberCreator != s.me.ID {
+		return nil, apiError{status: http.StatusUnauthorized, message: "unauthorized comment permission requested"}
+	}
+	return a, nil
+}
+
+func (s *Server) createComment(r *http.Request, params []string) (interface{}, error) {
+	c := s.findCard(params[0])
+	if c == nil {
+		return nil, errNotFound
+	}
+	text, err := commentText(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.createCommentWithText(c, text), nil
+}
+
+func (s *Server) updateComment(r *http.Request, params []string) (interface{}, error) {
+	a, err := s.findComment(params[0], params[1])
+	if err != nil {
+		return nil, err
+	}
+	text, err := commentText(r)
+	if err != nil {
+		return nil, err
+	}
+	a.Data.Text = text
+	return a, nil
+}
+
+func (s *Server) deleteComment(_ *http.Request, params []string) (interface{}, error) {
+	if _, err := s.findComment(params[0], params[1]); err != nil {
+		return nil, err
+	}
+	actions := s.actions[:0]
+	for _, a := range s.actions {
+		if a.ID != params[1] {
+			actions = append(actions, a)
+		}
+	}
+	s.actions = actions
+	return struct{}{}, nil
+}
+
+func commentText(r *http.Request) (string, error) {
+	b, err := readBody(r)
+	if err != nil {
+		return "", err
+	}
+	text, err := b.string("text")
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(text) == "" {
+		return "", invalidValue("text")
+	}
+	return text, nil
+}
@@ -0,0 +1,115 @@
+package fake
+
+import (
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+)
+
+// maxAttachmentSize is the maximum size of the uploaded files, like Trello for the free accounts
+const maxAttachmentSize = 10 << 20
+
+type attachment struct {
+	trello.Attachment
+	idCard string
+	data   []byte
+}
+
+func (s *Server) findAttachment(idCard, idAttachment string) *attachment {
+	for _, a := range s.attachments {
+		if a.idCard == idCard && a.ID == idAttachment {
+			return a
+		}
+	}
+	return nil
+}
+
+func (s *Server) createAttachmentWithData(idCard, name string, data []byte) *attachment {
+	mimeType := mime.TypeByExtension(filepath.Ext(name))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+	id := s.newID()
+	a := &attachment{
+		Attachment: trello.Attachment{
+			ID:       id,
+			Name:     name,
+			URL:      fmt.Sprintf("%s/cards/%s/attachments/%s/download/%s", s.BaseURL(), idCard, id, url.PathEscape(name)),
+			Bytes:    int64(len(data)),
+			MimeType: mimeType,
+			Date:     s.date(),
+			IsUpload: true,
+		},
+		idCard: idCard,
+		data:   data,
+	}
+	s.attachments = append(s.attachments, a)
+	return a
+}
+
+func (s *Server) findAttachments(r *http.Request, params []string) (interface{}, error) {
+	if s.findCard(params[0]) == nil {
+		return nil, errNotFound
+	}
+	attachments := []map[string]interface{}{}
+	for _, a := range s.attachments {
+		if a.idCard == params[0] {
+			attachments = append(attachments, selectFields(a.Attachment, r.URL.Query().Get("fields")))
+		}
+	}
+	return attachments, nil
+}
+
+// createAttachment uploads the "file" part of the multipart form, named by its "name" part
+func (s *Server) createAttachment(r *http.Request, params []string) (interface{}, error) {
+	c := s.findCard(params[0])
+	if c == nil {
+		return nil, errNotFound
+	}
+	r.Body = http.MaxBytesReader(nil, r.Body, maxAttachmentSize)
+	if err := r.ParseMultipartForm(maxAttachmentSize); err != nil {
+		return nil, invalidValue("file")
+	}
+	f, header, err := r.FormFile("file")
+	if err != nil {
+		return nil, invalidValue("file")
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, invalidValue("file")
+	}
+	name := r.FormValue("name")
+	if name == "" {
+		name = header.Filename
+	}
+	a := s.createAttachmentWithData(c.ID, name, data)
+	s.touch(c.IDBoard)
+	return a.Attachment, nil
+}
+
+func (s *Server) downloadAttachment(_ *http.Request, params []string) (interface{}, error) {
+	a := s.findAttachment(params[0], params[1])
+	if a == nil || a.Name != params[2] {
+		return nil, errNotFound
+	}
+	return content{mimeType: a.MimeType, data: a.data}, nil
+}
+
+func (s *Server) deleteAttachment(_ *http.Request, params []string) (interface{}, error) {
+	if s.findAttachment(params[0], params[1]) == nil {
+		return nil, errNotFound
+	}
+	attachments := s.attachments[:0]
+	for _, a := range s.attachments {
+		if a.idCard != params[0] || a.ID != params[1] {
+			attachments = append(attachments, a)
+		}
+	}
+	s.attachments = attachments
+	return struct{}{}, nil
+}
@@ -0,0 +1,331 @@
+package fake
+
+import (
+	"github.com/l-lin/tcli/trello"
+	"net/http"
+)
+
+// defaultLabelColors are the colors of the labels created with a board, like Trello does
+var defaultLabelColors = []string{"green", "yellow", "orange", "red", "purple", "blue"}
+
+// defaultListNames are the names of the lists created with a board, like Trello does
+var defaultListNames = []string{"To Do", "Doing", "Done"}
+
+type board struct {
+	trello.Board
+	idMembers []string
+	// lastIDShort is the short ID of the last card created in the board
+	lastIDShort int
+}
+
+func (s *Server) findBoard(idBoard string) *board {
+	for _, b := range s.boards {
+		if b.ID == idBoard {
+			return b
+		}
+	}
+	return nil
+}
+
+func (b *board) hasMember(idMember string) bool {
+	for _, id := range b.idMembers {
+		if id == idMember {
+			return true
+		}
+	}
+	return false
+}
+
+// createBoard creates a board with the current member, along with the default labels and lists if asked
+func (s *Server) createBoard(name, desc string, defaultLabels, defaultLists bool) *board {
+	shortLink := s.newShortLink()
+	b := &board{
+		Board: trello.Board{
+			ID:               s.newID(),
+			Name:             name,
+			Desc:             desc,
+			ShortLink:        shortLink,
+			ShortURL:         s.URL + "/b/" + shortLink,
+			DateLastActivity: s.date(),
+		},
+		idMembers: []string{s.me.ID},
+	}
+	s.boards = append(s.boards, b)
+	if defaultLabels {
+		for _, color := range defaultLabelColors {
+			color := color
+			s.createLabel(b.ID, "", &color)
+		}
+	}
+	if defaultLists {
+		for i, name := range defaultListNames {
+			s.createList(b.ID, name, float64(i+1)*posStep)
+		}
+	}
+	return b
+}
+
+// touch updates the date of the last activity of the board
+func (s *Server) touch(idBoard string) {
+	if b := s.findBoard(idBoard); b != nil {
+		b.DateLastActivity = s.date()
+	}
+}
+
+func (s *Server) findBoards(r *http.Request, _ []string) (interface{}, error) {
+	q := r.URL.Query()
+	boards := []map[string]interface{}{}
+	for _, b := range s.boards {
+		if !b.hasMember(s.me.ID) || (q.Get("filter") == "open" && b.Closed) {
+			continue
+		}
+		boards = append(boards, selectFields(b.Board, q.Get("fields")))
+	}
+	return boards, nil
+}
+
+func (s *Server) createBoardHandler(r *http.Request, _ []string) (interface{}, error) {
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var createBoard trello.CreateBoard
+	if err := firstError(
+		b.decode("name", &createBoard.Name),
+		b.decode("desc", &createBoard.Desc),
+		b.decode("defaultLabels", &createBoard.DefaultLabels),
+		b.decode("defaultLists", &createBoard.DefaultLists),
+	); err != nil {
+		return nil, err
+	}
+	if createBoard.Name == "" {
+		return nil, invalidValue("name")
+	}
+	created := s.createBoard(
+		createBoard.Name,
+		createBoard.Desc,
+		createBoard.DefaultLabels == nil || *createBoard.DefaultLabels,
+		createBoard.DefaultLists == nil || *createBoard.DefaultLists,
+	)
+	return created.Board, nil
+}
+
+func (s *Server) updateBoard(r *http.Request, params []string) (interface{}, error) {
+	bd := s.findBoard(params[0])
+	if bd == nil {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	updated := bd.Board
+	if err := firstError(
+		b.decode("name", &updated.Name),
+		b.decode("desc", &updated.Desc),
+		b.decode("closed", &updated.Closed),
+	); err != nil {
+		return nil, err
+	}
+	if updated.Name == "" {
+		return nil, invalidValue("name")
+	}
+	old := map[string]interface{}{}
+	if updated.Name != bd.Name {
+		old["name"] = bd.Name
+	}
+	if updated.Desc != bd.Desc {
+		old["desc"] = bd.Desc
+	}
+	if updated.Closed != bd.Closed {
+		old["closed"] = bd.Closed
+	}
+	bd.Board = updated
+	if len(old) > 0 {
+		s.record(updateBoardActionType, bd.ID, actionData{Old: old})
+	}
+	return bd.Board, nil
+}
+
+func (s *Server) findMembers(r *http.Request, params []string) (interface{}, error) {
+	b := s.findBoard(params[0])
+	if b == nil {
+		return nil, errNotFound
+	}
+	members := []map[string]interface{}{}
+	for _, idMember := range b.idMembers {
+		if member := s.findMember(idMember); member != nil {
+			members = append(members, selectFields(*member, r.URL.Query().Get("fields")))
+		}
+	}
+	return members, nil
+}
+
+// LABELS ------------------------------------------------------------------------------------------------------
+
+func (s *Server) findLabel(idLabel string) *trello.Label {
+	for _, l := range s.labels {
+		if l.ID == idLabel {
+			return l
+		}
+	}
+	return nil
+}
+
+func (s *Server) createLabel(idBoard, name string, color *string) *trello.Label {
+	label := &trello.Label{ID: s.newID(), IDBoard: idBoard, Name: name}
+	if color != nil {
+		label.Color = *color
+	}
+	s.labels = append(s.labels, label)
+	return label
+}
+
+func (s *Server) findLabels(r *http.Request, params []string) (interface{}, error) {
+	if s.findBoard(params[0]) == nil {
+		return nil, errNotFound
+	}
+	labels := []map[string]interface{}{}
+	for _, l := range s.labels {
+		if l.IDBoard == params[0] {
+			labels = append(labels, selectFields(l, r.URL.Query().Get("fields")))
+		}
+	}
+	return labels, nil
+}
+
+func (s *Server) createLabelHandler(r *http.Request, _ []string) (interface{}, error) {
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var createLabel trello.CreateLabel
+	if err := firstError(
+		b.decode("idBoard", &createLabel.IDBoard),
+		b.decode("name", &createLabel.Name),
+		b.decode("color", &createLabel.Color),
+	); err != nil {
+		return nil, err
+	}
+	if s.findBoard(createLabel.IDBoard) == nil {
+		return nil, invalidValue("idBoard")
+	}
+	if createLabel.Color != nil && !trello.IsLabelColor(*createLabel.Color) {
+		return nil, invalidValue("color")
+	}
+	label := s.createLabel(createLabel.IDBoard, createLabel.Name, createLabel.Color)
+	s.record(createLabelActionType, label.IDBoard, actionData{Label: label})
+	return label, nil
+}
+
+func (s *Server) updateLabel(r *http.Request, params []string) (interface{}, error) {
+	label := s.findLabel(params[0])
+	if label == nil {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	var color *string
+	if err := firstError(b.decode("name", &name), b.decode("color", &color)); err != nil {
+		return nil, err
+	}
+	if color != nil && !trello.IsLabelColor(*color) {
+		return nil, invalidValue("color")
+	}
+	if b.has("name") {
+		label.Name = name
+	}
+	if b.has("color") {
+		label.Color = ""
+		if color != nil {
+			label.Color = *color
+		}
+	}
+	for _, c := range s.cards {
+		for i := range c.Labels {
+			if c.Labels[i].ID == label.ID {
+				c.Labels[i] = *label
+			}
+		}
+	}
+	s.record(updateLabelActionType, label.IDBoard, actionData{Label: label})
+	return label, nil
+}
+
+// deleteLabel deletes the label, removing it from the cards
+func (s *Server) deleteLabel(_ *http.Request, params []string) (interface{}, error) {
+	label := s.findLabel(params[0])
+	if label == nil {
+		return nil, errNotFound
+	}
+	labels := s.labels[:0]
+	for _, l := range s.labels {
+		if l.ID != label.ID {
+			labels = append(labels, l)
+		}
+	}
+	s.labels = labels
+	for _, c := range s.cards {
+		c.Labels = withoutLabel(c.Labels, label.ID)
+	}
+	s.record(deleteLabelActionType, label.IDBoard, actionData{Label: label})
+	return struct{}{}, nil
+}
+
+func withoutLabel(labels trello.Labels, idLabel string) trello.Labels {
+	filtered := trello.Labels{}
+	for _, l := range labels {
+		if l.ID != idLabel {
+			filtered = append(filtered, l)
+		}
+	}
+	return filtered
+}
+
+// CUSTOM FIELDS -----------------------------------------------------------------------------------------------
+
+func (s *Server) findCustomField(idCustomField string) *trello.CustomField {
+	for _, cf := range s.customFields {
+		if cf.ID == idCustomField {
+			return cf
+		}
+	}
+	return nil
+}
+
+// createCustomField creates a custom field on the board, with the given options for the "list" custom fields
+func (s *Server) createCustomField(idBoard, name, fieldType string, options ...string) *trello.CustomField {
+	customField := &trello.CustomField{
+		ID:      s.newID(),
+		IDModel: idBoard,
+		Name:    name,
+		Type:    fieldType,
+		Pos:     float64(len(s.customFields)+1) * posStep,
+	}
+	for i, option := range options {
+		customField.Options = append(customField.Options, trello.CustomFieldOption{
+			ID:            s.newID(),
+			IDCustomField: customField.ID,
+			Value:         trello.CustomFieldItemValue{Text: option},
+			Pos:           float64(i+1) * posStep,
+		})
+	}
+	s.customFields = append(s.customFields, customField)
+	return customField
+}
+
+func (s *Server) findCustomFields(_ *http.Request, params []string) (interface{}, error) {
+	if s.findBoard(params[0]) == nil {
+		return nil, errNotFound
+	}
+	customFields := trello.CustomFields{}
+	for _, cf := range s.customFields {
+		if cf.IDModel == params[0] {
+			customFields = append(customFields, *cf)
+		}
+	}
+	return customFields, nil
+}
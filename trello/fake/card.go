@@ -0,0 +1,529 @@
+package fake
+
+import (
+	"encoding/json"
+	"github.com/l-lin/tcli/trello"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errInvalidCustomFieldValue is returned when the value does not match the type of the custom field
+var errInvalidCustomFieldValue = apiError{status: http.StatusBadRequest, message: "Invalid custom field item value."}
+
+type card struct {
+	trello.Card
+	// idShort is the number of the card in its board, e.g. #12
+	idShort int
+}
+
+func (s *Server) findCard(idCard string) *card {
+	for _, c := range s.cards {
+		if c.ID == idCard {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *Server) createCard(idList, name, desc string, pos float64) *card {
+	list := s.findList(idList)
+	b := s.findBoard(list.IDBoard)
+	b.lastIDShort++
+	shortLink := s.newShortLink()
+	c := &card{
+		Card: trello.Card{
+			ID:        s.newID(),
+			Name:      name,
+			Desc:      desc,
+			IDBoard:   list.IDBoard,
+			IDList:    idList,
+			ShortLink: shortLink,
+			ShortURL:  s.URL + "/c/" + shortLink,
+			Pos:       pos,
+			Labels:    trello.Labels{},
+		},
+		idShort: b.lastIDShort,
+	}
+	s.cards = append(s.cards, c)
+	s.record(createCardActionType, c.IDBoard, actionData{Card: s.cardResource(c), List: listResource(*list)})
+	return c
+}
+
+// cardPositions returns the positions of the cards of the list, except the given one
+func (s *Server) cardPositions(idList, idCard string) []float64 {
+	var positions []float64
+	for _, c := range s.cards {
+		if c.IDList == idList && c.ID != idCard {
+			positions = append(positions, c.Pos)
+		}
+	}
+	return positions
+}
+
+// renderCard keeps the fields of the card given in the "fields" query, along with the values of its custom
+// fields if the "customFieldItems" query is true
+func renderCard(c *card, q map[string][]string, fieldsQuery string) map[string]interface{} {
+	fields := ""
+	if values := q[fieldsQuery]; len(values) > 0 {
+		fields = values[0]
+	}
+	rendered := selectFields(c.Card, fields)
+	delete(rendered, "customFieldItems")
+	if values := q["customFieldItems"]; len(values) > 0 && values[0] == "true" {
+		items := trello.CustomFieldItems{}
+		items = append(items, c.CustomFieldItems...)
+		rendered["customFieldItems"] = items
+	}
+	return rendered
+}
+
+// listCards returns the cards of the list that are archived or not, sorted by position
+func (s *Server) listCards(r *http.Request, idList string, closed bool) (interface{}, error) {
+	if s.findList(idList) == nil {
+		return nil, errNotFound
+	}
+	var cards []*card
+	for _, c := range s.cards {
+		if c.IDList == idList && c.Closed == closed {
+			cards = append(cards, c)
+		}
+	}
+	sort.SliceStable(cards, func(i, j int) bool {
+		return cards[i].Pos < cards[j].Pos
+	})
+	rendered := []map[string]interface{}{}
+	for _, c := range cards {
+		rendered = append(rendered, renderCard(c, r.URL.Query(), "fields"))
+	}
+	return rendered, nil
+}
+
+func (s *Server) findCards(r *http.Request, params []string) (interface{}, error) {
+	return s.listCards(r, params[0], false)
+}
+
+func (s *Server) findArchivedCards(r *http.Request, params []string) (interface{}, error) {
+	return s.listCards(r, params[0], true)
+}
+
+// boardLabels returns the labels with the given IDs, that must be labels of the board
+func (s *Server) boardLabels(idBoard string, idLabels []string) (trello.Labels, error) {
+	labels := trello.Labels{}
+	for _, idLabel := range idLabels {
+		label := s.findLabel(idLabel)
+		if label == nil || label.IDBoard != idBoard {
+			return nil, invalidValue("idLabels")
+		}
+		labels = append(labels, *label)
+	}
+	return labels, nil
+}
+
+// boardMembers checks that the members with the given IDs are members of the board
+func (s *Server) boardMembers(idBoard string, idMembers []string) ([]string, error) {
+	b := s.findBoard(idBoard)
+	for _, idMember := range idMembers {
+		if !b.hasMember(idMember) {
+			return nil, invalidValue("idMembers")
+		}
+	}
+	return idMembers, nil
+}
+
+func (s *Server) createCardHandler(r *http.Request, _ []string) (interface{}, error) {
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var name, desc, idList string
+	var closed bool
+	if err := firstError(
+		b.decode("name", &name),
+		b.decode("desc", &desc),
+		b.decode("idList", &idList),
+		b.decode("closed", &closed),
+	); err != nil {
+		return nil, err
+	}
+	list := s.findList(idList)
+	if list == nil {
+		return nil, invalidValue("idList")
+	}
+	idLabels, err := b.ids("idLabels")
+	if err != nil {
+		return nil, err
+	}
+	labels, err := s.boardLabels(list.IDBoard, idLabels)
+	if err != nil {
+		return nil, err
+	}
+	idMembers, err := b.ids("idMembers")
+	if err != nil {
+		return nil, err
+	}
+	if idMembers, err = s.boardMembers(list.IDBoard, idMembers); err != nil {
+		return nil, err
+	}
+	due, err := b.date("due")
+	if err != nil {
+		return nil, err
+	}
+	start, err := b.date("start")
+	if err != nil {
+		return nil, err
+	}
+	pos, err := b.pos("pos", s.cardPositions(idList, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	c := s.createCard(idList, name, desc, pos)
+	c.Labels = labels
+	c.IDMembers = idMembers
+	c.Closed = closed
+	if due != nil {
+		c.Due = *due
+	}
+	if start != nil {
+		c.Start = *start
+	}
+	s.touch(c.IDBoard)
+	return c.Card, nil
+}
+
+// updateCard updates the given fields of the card, recording an action for each change, like Trello does
+func (s *Server) updateCard(r *http.Request, params []string) (interface{}, error) {
+	c := s.findCard(params[0])
+	if c == nil {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	updated := c.Card
+	if err := firstError(
+		b.decode("name", &updated.Name),
+		b.decode("desc", &updated.Desc),
+		b.decode("idList", &updated.IDList),
+		b.decode("closed", &updated.Closed),
+		b.decode("dueComplete", &updated.DueComplete),
+	); err != nil {
+		return nil, err
+	}
+	list := s.findList(updated.IDList)
+	if list == nil {
+		return nil, invalidValue("idList")
+	}
+	updated.IDBoard = list.IDBoard
+	if updated.IDBoard != c.IDBoard {
+		// the labels and the members of the other board are not the ones of the card
+		updated.Labels = trello.Labels{}
+		updated.IDMembers = nil
+	}
+	if b.has("idLabels") {
+		idLabels, err := b.ids("idLabels")
+		if err != nil {
+			return nil, err
+		}
+		if updated.Labels, err = s.boardLabels(updated.IDBoard, idLabels); err != nil {
+			return nil, err
+		}
+	}
+	if b.has("idMembers") {
+		idMembers, err := b.ids("idMembers")
+		if err != nil {
+			return nil, err
+		}
+		if updated.IDMembers, err = s.boardMembers(updated.IDBoard, idMembers); err != nil {
+			return nil, err
+		}
+	}
+	for _, date := range []struct {
+		field string
+		value *string
+	}{{"due", &updated.Due}, {"start", &updated.Start}} {
+		if !b.has(date.field) {
+			continue
+		}
+		d, err := b.date(date.field)
+		if err != nil {
+			return nil, err
+		}
+		*date.value = ""
+		if d != nil {
+			*date.value = *d
+		}
+	}
+	if b.has("pos") || updated.IDList != c.IDList {
+		if updated.Pos, err = b.pos("pos", s.cardPositions(updated.IDList, c.ID)); err != nil {
+			return nil, err
+		}
+	}
+
+	before := *c
+	c.Card = updated
+	s.recordCardChanges(before, c)
+	s.touch(c.IDBoard)
+	return c.Card, nil
+}
+
+// recordCardChanges records the actions of the update of the card
+func (s *Server) recordCardChanges(before card, c *card) {
+	listAfter := listResource(*s.findList(c.IDList))
+	if before.IDBoard != c.IDBoard {
+		boardSource, boardTarget := s.boardResource(before.IDBoard), s.boardResource(c.IDBoard)
+		s.record(moveCardFromBoardActionType, before.IDBoard, actionData{Card: s.cardResource(c), BoardTarget: boardTarget})
+		s.record(moveCardToBoardActionType, c.IDBoard, actionData{Card: s.cardResource(c), BoardSource: boardSource, List: listAfter})
+	} else if before.IDList != c.IDList {
+		s.record(updateCardActionType, c.IDBoard, actionData{
+			Card:       s.cardResource(c),
+			ListBefore: listResource(*s.findList(before.IDList)),
+			ListAfter:  listAfter,
+			Old:        map[string]interface{}{"idList": before.IDList},
+		})
+	} else if before.Pos != c.Pos {
+		s.recordCardUpdate(c, "pos", before.Pos)
+	}
+	if before.Name != c.Name {
+		s.recordCardUpdate(c, "name", before.Name)
+	}
+	if before.Desc != c.Desc {
+		s.recordCardUpdate(c, "desc", before.Desc)
+	}
+	if before.Closed != c.Closed {
+		s.recordCardUpdate(c, "closed", before.Closed)
+	}
+	if before.Due != c.Due {
+		s.recordCardUpdate(c, "due", before.Due)
+	}
+	if before.Start != c.Start {
+		s.recordCardUpdate(c, "start", before.Start)
+	}
+	if before.DueComplete != c.DueComplete {
+		s.recordCardUpdate(c, "dueComplete", before.DueComplete)
+	}
+	if before.IDBoard != c.IDBoard {
+		// the labels and members removed when moving to the other board are not recorded by Trello
+		return
+	}
+	for _, label := range c.Labels {
+		if !hasLabel(before.Labels, label.ID) {
+			label := label
+			s.record(addLabelToCardActionType, c.IDBoard, actionData{Card: s.cardResource(c), Label: &label})
+		}
+	}
+	for _, label := range before.Labels {
+		if !hasLabel(c.Labels, label.ID) {
+			label := label
+			s.record(removeLabelFromCardActionType, c.IDBoard, actionData{Card: s.cardResource(c), Label: &label})
+		}
+	}
+	for _, idMember := range c.IDMembers {
+		if !contains(before.IDMembers, idMember) {
+			a := s.record(addMemberToCardActionType, c.IDBoard, actionData{Card: s.cardResource(c)})
+			a.Member = s.actionMember(idMember)
+		}
+	}
+	for _, idMember := range before.IDMembers {
+		if !contains(c.IDMembers, idMember) {
+			a := s.record(removeMemberFromCardActionType, c.IDBoard, actionData{Card: s.cardResource(c)})
+			a.Member = s.actionMember(idMember)
+		}
+	}
+}
+
+func (s *Server) recordCardUpdate(c *card, field string, old interface{}) {
+	s.record(updateCardActionType, c.IDBoard, actionData{
+		Card: s.cardResource(c),
+		List: listResource(*s.findList(c.IDList)),
+		Old:  map[string]interface{}{field: old},
+	})
+}
+
+func hasLabel(labels trello.Labels, idLabel string) bool {
+	for _, l := range labels {
+		if l.ID == idLabel {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// SEARCH ------------------------------------------------------------------------------------------------------
+
+// searchCards finds the open cards whose name, description, labels, checklists or comments contain all the
+// words of the query, ignoring the case; the search operators of Trello, e.g. "label:bug", are not supported
+func (s *Server) searchCards(r *http.Request, _ []string) (interface{}, error) {
+	q := r.URL.Query()
+	if q.Get("modelTypes") != "cards" {
+		return nil, invalidValue("modelTypes")
+	}
+	terms := strings.Fields(strings.ToLower(q.Get("query")))
+	if len(terms) == 0 {
+		return nil, invalidValue("query")
+	}
+	limit := 10
+	if l := q.Get("cards_limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 1 || limit > 1000 {
+			return nil, invalidValue("cards_limit")
+		}
+	}
+	var idBoards []string
+	if q.Get("idBoards") != "" {
+		idBoards = strings.Split(q.Get("idBoards"), ",")
+	}
+
+	rendered := []map[string]interface{}{}
+	for _, c := range s.cards {
+		if len(rendered) == limit {
+			break
+		}
+		b := s.findBoard(c.IDBoard)
+		if c.Closed || !b.hasMember(s.me.ID) || (idBoards != nil && !contains(idBoards, c.IDBoard)) {
+			continue
+		}
+		if s.matches(c, terms) {
+			rendered = append(rendered, renderCard(c, q, "card_fields"))
+		}
+	}
+	return struct {
+		Cards []map[string]interface{} `json:"cards"`
+	}{Cards: rendered}, nil
+}
+
+func (s *Server) matches(c *card, terms []string) bool {
+	texts := []string{c.Name, c.Desc}
+	for _, l := range c.Labels {
+		texts = append(texts, l.Name)
+	}
+	for _, checklist := range s.checklists {
+		if checklist.IDCard != c.ID {
+			continue
+		}
+		texts = append(texts, checklist.Name)
+		for _, item := range checklist.CheckItems {
+			texts = append(texts, item.Name)
+		}
+	}
+	for _, a := range s.actions {
+		if a.Type == commentCardActionType && a.Data.Card != nil && a.Data.Card.ID == c.ID {
+			texts = append(texts, a.Data.Text)
+		}
+	}
+	text := strings.ToLower(strings.Join(texts, "\n"))
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// CUSTOM FIELD ITEMS ------------------------------------------------------------------------------------------
+
+func (s *Server) customFieldItem(idCustomField, idCard, idValue string, value *trello.CustomFieldItemValue) trello.CustomFieldItem {
+	return trello.CustomFieldItem{ID: s.newID(), IDCustomField: idCustomField, IDModel: idCard, IDValue: idValue, Value: value}
+}
+
+// updateCustomFieldItem sets the value of the custom field of the card, i.e. the "value" matching the type of
+// the custom field, or the "idValue" of an option of the "list" custom fields, an empty one clearing it
+func (s *Server) updateCustomFieldItem(r *http.Request, params []string) (interface{}, error) {
+	c := s.findCard(params[0])
+	if c == nil {
+		return nil, errNotFound
+	}
+	customField := s.findCustomField(params[1])
+	if customField == nil || customField.IDModel != c.IDBoard {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	item := trello.CustomFieldItem{ID: s.newID(), IDCustomField: customField.ID, IDModel: c.ID}
+	if existing := c.CustomFieldItems.Find(customField.ID); existing != nil {
+		item.ID = existing.ID
+	}
+	cleared, err := customFieldItemValue(*customField, b, &item)
+	if err != nil {
+		return nil, err
+	}
+
+	items := trello.CustomFieldItems{}
+	for _, i := range c.CustomFieldItems {
+		if i.IDCustomField != customField.ID {
+			items = append(items, i)
+		}
+	}
+	if !cleared {
+		items = append(items, item)
+	}
+	c.CustomFieldItems = items
+	s.touch(c.IDBoard)
+	return item, nil
+}
+
+// customFieldItemValue sets the value given in the body to the item, returning true if the value is cleared
+func customFieldItemValue(customField trello.CustomField, b body, item *trello.CustomFieldItem) (bool, error) {
+	if customField.Type == trello.CustomFieldTypeList {
+		var idValue string
+		if err := b.decode("idValue", &idValue); err != nil {
+			return false, err
+		}
+		if idValue == "" {
+			return true, nil
+		}
+		if customField.Options.Find(idValue) == nil {
+			return false, invalidValue("idValue")
+		}
+		item.IDValue = idValue
+		return false, nil
+	}
+	raw, ok := b["value"]
+	if !ok {
+		return false, invalidValue("value")
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		if s != "" {
+			return false, errInvalidCustomFieldValue
+		}
+		return true, nil
+	}
+	var value trello.CustomFieldItemValue
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return false, errInvalidCustomFieldValue
+	}
+	valid := false
+	switch customField.Type {
+	case trello.CustomFieldTypeText:
+		valid = value == trello.CustomFieldItemValue{Text: value.Text} && value.Text != ""
+	case trello.CustomFieldTypeNumber:
+		_, err := strconv.ParseFloat(value.Number, 64)
+		valid = value == trello.CustomFieldItemValue{Number: value.Number} && err == nil
+	case trello.CustomFieldTypeDate:
+		_, err := time.Parse(time.RFC3339, value.Date)
+		valid = value == trello.CustomFieldItemValue{Date: value.Date} && err == nil
+	case trello.CustomFieldTypeCheckbox:
+		valid = value == trello.CustomFieldItemValue{Checked: value.Checked} && (value.Checked == "true" || value.Checked == "false")
+	}
+	if !valid {
+		return false, errInvalidCustomFieldValue
+	}
+	item.Value = &value
+	return false, nil
+}
@@ -0,0 +1,244 @@
+package fake
+
+import (
+	"github.com/l-lin/tcli/trello"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	checkItemStateComplete   = "complete"
+	checkItemStateIncomplete = "incomplete"
+)
+
+func (s *Server) findChecklist(idChecklist string) *trello.Checklist {
+	for _, c := range s.checklists {
+		if c.ID == idChecklist {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *Server) createChecklist(idCard, name string, pos float64) *trello.Checklist {
+	checklist := &trello.Checklist{ID: s.newID(), Name: name, IDCard: idCard, Pos: pos, CheckItems: trello.CheckItems{}}
+	s.checklists = append(s.checklists, checklist)
+	return checklist
+}
+
+func (s *Server) createCheckItem(checklist *trello.Checklist, name string, checked bool, pos float64) trello.CheckItem {
+	checkItem := trello.CheckItem{
+		ID:          s.newID(),
+		Name:        name,
+		IDChecklist: checklist.ID,
+		State:       checkItemStateIncomplete,
+		Pos:         pos,
+	}
+	if checked {
+		checkItem.State = checkItemStateComplete
+	}
+	checklist.CheckItems = append(checklist.CheckItems, checkItem)
+	sortCheckItems(checklist.CheckItems)
+	return checkItem
+}
+
+// findCheckItem returns the check item of the card with its checklist
+func (s *Server) findCheckItem(idCard, idCheckItem string) (*trello.Checklist, *trello.CheckItem) {
+	for _, checklist := range s.checklists {
+		if checklist.IDCard != idCard {
+			continue
+		}
+		for i := range checklist.CheckItems {
+			if checklist.CheckItems[i].ID == idCheckItem {
+				return checklist, &checklist.CheckItems[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+func sortCheckItems(checkItems trello.CheckItems) {
+	sort.SliceStable(checkItems, func(i, j int) bool {
+		return checkItems[i].Pos < checkItems[j].Pos
+	})
+}
+
+// findChecklists returns the checklists of the card sorted by position, along with their check items if the
+// "checkItems" query is "all"
+func (s *Server) findChecklists(r *http.Request, params []string) (interface{}, error) {
+	if s.findCard(params[0]) == nil {
+		return nil, errNotFound
+	}
+	q := r.URL.Query()
+	checklists := trello.Checklists{}
+	for _, c := range s.checklists {
+		if c.IDCard == params[0] {
+			checklists = append(checklists, *c)
+		}
+	}
+	sort.SliceStable(checklists, func(i, j int) bool {
+		return checklists[i].Pos < checklists[j].Pos
+	})
+	rendered := []map[string]interface{}{}
+	for _, c := range checklists {
+		checklist := selectFields(c, q.Get("fields"))
+		delete(checklist, "checkItems")
+		if q.Get("checkItems") == "all" {
+			checkItems := []map[string]interface{}{}
+			for _, item := range c.CheckItems {
+				checkItems = append(checkItems, selectFields(item, q.Get("checkItem_fields")))
+			}
+			checklist["checkItems"] = checkItems
+		}
+		rendered = append(rendered, checklist)
+	}
+	return rendered, nil
+}
+
+func (s *Server) createChecklistHandler(r *http.Request, _ []string) (interface{}, error) {
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var idCard, name string
+	if err := firstError(b.decode("idCard", &idCard), b.decode("name", &name)); err != nil {
+		return nil, err
+	}
+	c := s.findCard(idCard)
+	if c == nil {
+		return nil, invalidValue("idCard")
+	}
+	var positions []float64
+	for _, checklist := range s.checklists {
+		if checklist.IDCard == idCard {
+			positions = append(positions, checklist.Pos)
+		}
+	}
+	pos, err := b.pos("pos", positions)
+	if err != nil {
+		return nil, err
+	}
+	checklist := s.createChecklist(idCard, name, pos)
+	s.touch(c.IDBoard)
+	return checklist, nil
+}
+
+func (s *Server) updateChecklist(r *http.Request, params []string) (interface{}, error) {
+	checklist := s.findChecklist(params[0])
+	if checklist == nil {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	name := checklist.Name
+	if err := b.decode("name", &name); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, invalidValue("name")
+	}
+	checklist.Name = name
+	return checklist, nil
+}
+
+func (s *Server) deleteChecklist(_ *http.Request, params []string) (interface{}, error) {
+	if s.findChecklist(params[0]) == nil {
+		return nil, errNotFound
+	}
+	checklists := s.checklists[:0]
+	for _, c := range s.checklists {
+		if c.ID != params[0] {
+			checklists = append(checklists, c)
+		}
+	}
+	s.checklists = checklists
+	return struct{}{}, nil
+}
+
+func (s *Server) createCheckItemHandler(r *http.Request, params []string) (interface{}, error) {
+	checklist := s.findChecklist(params[0])
+	if checklist == nil {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	var checked bool
+	if err := firstError(b.decode("name", &name), b.decode("checked", &checked)); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, invalidValue("name")
+	}
+	var positions []float64
+	for _, item := range checklist.CheckItems {
+		positions = append(positions, item.Pos)
+	}
+	pos, err := b.pos("pos", positions)
+	if err != nil {
+		return nil, err
+	}
+	return s.createCheckItem(checklist, name, checked, pos), nil
+}
+
+func (s *Server) updateCheckItem(r *http.Request, params []string) (interface{}, error) {
+	checklist, checkItem := s.findCheckItem(params[0], params[1])
+	if checkItem == nil {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	updated := *checkItem
+	if err := firstError(
+		b.decode("name", &updated.Name),
+		b.decode("state", &updated.State),
+		b.decode("idChecklist", &updated.IDChecklist),
+	); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(updated.Name) == "" {
+		return nil, invalidValue("name")
+	}
+	if updated.State != checkItemStateComplete && updated.State != checkItemStateIncomplete {
+		return nil, invalidValue("state")
+	}
+	if updated.IDChecklist == checklist.ID {
+		*checkItem = updated
+		return updated, nil
+	}
+	// the check item is moved to the other checklist of the card
+	target := s.findChecklist(updated.IDChecklist)
+	if target == nil || target.IDCard != checklist.IDCard {
+		return nil, invalidValue("idChecklist")
+	}
+	checklist.CheckItems = withoutCheckItem(checklist.CheckItems, checkItem.ID)
+	target.CheckItems = append(target.CheckItems, updated)
+	sortCheckItems(target.CheckItems)
+	return updated, nil
+}
+
+func (s *Server) deleteCheckItem(_ *http.Request, params []string) (interface{}, error) {
+	checklist, checkItem := s.findCheckItem(params[0], params[1])
+	if checkItem == nil {
+		return nil, errNotFound
+	}
+	checklist.CheckItems = withoutCheckItem(checklist.CheckItems, checkItem.ID)
+	return struct{}{}, nil
+}
+
+func withoutCheckItem(checkItems trello.CheckItems, idCheckItem string) trello.CheckItems {
+	filtered := trello.CheckItems{}
+	for _, item := range checkItems {
+		if item.ID != idCheckItem {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
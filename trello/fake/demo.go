@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"github.com/l-lin/tcli/trello"
+)
+
+// NewDemoServer starts a fake Trello server with a few boards, to demo tcli without a Trello account
+func NewDemoServer() *Server {
+	s := NewServer()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	alice := s.createMember("alice", "Alice Martin")
+	bob := s.createMember("bob", "Bob Durand")
+
+	b := s.createBoard("tcli", "Roadmap of tcli", false, false)
+	b.idMembers = append(b.idMembers, alice.ID, bob.ID)
+	feature := s.createLabel(b.ID, "feature", stringPtr("green"))
+	bug := s.createLabel(b.ID, "bug", stringPtr("red"))
+	docs := s.createLabel(b.ID, "docs", stringPtr("blue"))
+	priority := s.createCustomField(b.ID, "Priority", "list", "high", "medium", "low")
+	estimate := s.createCustomField(b.ID, "Estimate", "number")
+
+	todo := s.createList(b.ID, "To Do", posStep)
+	doing := s.createList(b.ID, "Doing", 2*posStep)
+	done := s.createList(b.ID, "Done", 3*posStep)
+
+	c := s.createCard(todo.ID, "Export boards to Markdown", "Render the lists as headings and the cards as items.", posStep)
+	c.Labels = append(c.Labels, *feature)
+	c.IDMembers = []string{alice.ID}
+	c.Due = s.now().UTC().AddDate(0, 0, 7).Format(dateLayout)
+	c.CustomFieldItems = append(c.CustomFieldItems, s.customFieldItem(priority.ID, c.ID, priority.Options[1].ID, nil))
+	s.createCommentWithText(c, "Should the descriptions be exported too?")
+
+	c = s.createCard(todo.ID, "Completion is slow on big boards", "", 2*posStep)
+	c.Labels = append(c.Labels, *bug)
+	c.CustomFieldItems = append(c.CustomFieldItems, s.customFieldItem(priority.ID, c.ID, priority.Options[0].ID, nil))
+
+	c = s.createCard(doing.ID, "Support custom fields", "Show the custom fields in `ls` and `cat`, and edit them with `edit`.", posStep)
+	c.Labels = append(c.Labels, *feature)
+	c.IDMembers = []string{s.me.ID, bob.ID}
+	c.CustomFieldItems = append(c.CustomFieldItems, s.customFieldItem(estimate.ID, c.ID, "", &trello.CustomFieldItemValue{Number: "3"}))
+	checklist := s.createChecklist(c.ID, "Commands", posStep)
+	s.createCheckItem(checklist, "ls", true, posStep)
+	s.createCheckItem(checklist, "cat", true, 2*posStep)
+	s.createCheckItem(checklist, "edit", false, 3*posStep)
+	s.createAttachmentWithData(c.ID, "notes.md", []byte("# Custom fields\n\n- checkbox\n- date\n- list\n- number\n- text\n"))
+	s.createCommentWithText(c, "The dropdown options are listed in the template.")
+
+	c = s.createCard(done.ID, "Write the README", "", posStep)
+	c.Labels = append(c.Labels, *docs)
+	c.DueComplete = true
+
+	personal := s.createBoard("personal", "", true, true)
+	ideas := s.createList(personal.ID, "Ideas", 4*posStep)
+	s.createCard(ideas.ID, "Read more books", "", posStep)
+	return s
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,161 @@
+package fake
+
+import (
+	"github.com/l-lin/tcli/trello"
+	"net/http"
+	"sort"
+)
+
+func (s *Server) findList(idList string) *trello.List {
+	for _, l := range s.lists {
+		if l.ID == idList {
+			return l
+		}
+	}
+	return nil
+}
+
+func (s *Server) createList(idBoard, name string, pos float64) *trello.List {
+	list := &trello.List{ID: s.newID(), Name: name, IDBoard: idBoard, Pos: pos}
+	s.lists = append(s.lists, list)
+	return list
+}
+
+// listPositions returns the positions of the lists of the board, except the given one
+func (s *Server) listPositions(idBoard, idList string) []float64 {
+	var positions []float64
+	for _, l := range s.lists {
+		if l.IDBoard == idBoard && l.ID != idList {
+			positions = append(positions, l.Pos)
+		}
+	}
+	return positions
+}
+
+// boardLists returns the lists of the board that are archived or not, sorted by position
+func (s *Server) boardLists(r *http.Request, idBoard string, closed bool) (interface{}, error) {
+	if s.findBoard(idBoard) == nil {
+		return nil, errNotFound
+	}
+	lists := trello.Lists{}
+	for _, l := range s.lists {
+		if l.IDBoard == idBoard && l.Closed == closed {
+			lists = append(lists, *l)
+		}
+	}
+	sort.SliceStable(lists, func(i, j int) bool {
+		return lists[i].Pos < lists[j].Pos
+	})
+	selected := []map[string]interface{}{}
+	for _, l := range lists {
+		selected = append(selected, selectFields(l, r.URL.Query().Get("fields")))
+	}
+	return selected, nil
+}
+
+func (s *Server) findLists(r *http.Request, params []string) (interface{}, error) {
+	return s.boardLists(r, params[0], false)
+}
+
+func (s *Server) findArchivedLists(r *http.Request, params []string) (interface{}, error) {
+	return s.boardLists(r, params[0], true)
+}
+
+func (s *Server) createListHandler(r *http.Request, _ []string) (interface{}, error) {
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var name, idBoard string
+	if err := firstError(b.decode("name", &name), b.decode("idBoard", &idBoard)); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, invalidValue("name")
+	}
+	if s.findBoard(idBoard) == nil {
+		return nil, invalidValue("idBoard")
+	}
+	pos, err := b.pos("pos", s.listPositions(idBoard, ""))
+	if err != nil {
+		return nil, err
+	}
+	list := s.createList(idBoard, name, pos)
+	s.record(createListActionType, idBoard, actionData{List: listResource(*list)})
+	return list, nil
+}
+
+func (s *Server) updateList(r *http.Request, params []string) (interface{}, error) {
+	list := s.findList(params[0])
+	if list == nil {
+		return nil, errNotFound
+	}
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	updated := *list
+	if err := firstError(
+		b.decode("name", &updated.Name),
+		b.decode("idBoard", &updated.IDBoard),
+		b.decode("closed", &updated.Closed),
+	); err != nil {
+		return nil, err
+	}
+	if updated.Name == "" {
+		return nil, invalidValue("name")
+	}
+	if s.findBoard(updated.IDBoard) == nil {
+		return nil, invalidValue("idBoard")
+	}
+	if b.has("pos") || updated.IDBoard != list.IDBoard {
+		if updated.Pos, err = b.pos("pos", s.listPositions(updated.IDBoard, list.ID)); err != nil {
+			return nil, err
+		}
+	}
+	old := map[string]interface{}{}
+	if updated.Name != list.Name {
+		old["name"] = list.Name
+	}
+	if updated.Closed != list.Closed {
+		old["closed"] = list.Closed
+	}
+	if updated.Pos != list.Pos {
+		old["pos"] = list.Pos
+	}
+	if updated.IDBoard != list.IDBoard {
+		// the cards follow their list to the other board
+		for _, c := range s.cards {
+			if c.IDList == list.ID {
+				c.IDBoard = updated.IDBoard
+				c.Labels = trello.Labels{}
+			}
+		}
+		s.record(moveListFromBoardActionType, list.IDBoard, actionData{List: listResource(updated)})
+		s.record(moveListToBoardActionType, updated.IDBoard, actionData{List: listResource(updated)})
+	}
+	*list = updated
+	if len(old) > 0 {
+		s.record(updateListActionType, list.IDBoard, actionData{List: listResource(*list), Old: old})
+	}
+	return list, nil
+}
+
+// archiveAllCards archives the cards of the list
+func (s *Server) archiveAllCards(_ *http.Request, params []string) (interface{}, error) {
+	list := s.findList(params[0])
+	if list == nil {
+		return nil, errNotFound
+	}
+	for _, c := range s.cards {
+		if c.IDList == list.ID && !c.Closed {
+			c.Closed = true
+			s.record(updateCardActionType, c.IDBoard, actionData{
+				Card: s.cardResource(c),
+				List: listResource(*list),
+				Old:  map[string]interface{}{"closed": false},
+			})
+		}
+	}
+	return struct{}{}, nil
+}
@@ -0,0 +1,454 @@
+// Package fake provides an in-process fake of the subset of the Trello REST API used by tcli, keeping the
+// resources in memory, to exercise the HttpRepository with real requests and responses, or to demo tcli
+// without a Trello account
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/l-lin/tcli/conf"
+	"github.com/l-lin/tcli/trello"
+	"github.com/rs/zerolog/log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ApiKey is the only API key accepted by the fake server
+	ApiKey = "fake-api-key"
+	// AccessToken is the only access token accepted by the fake server
+	AccessToken = "fake-access-token"
+	// AppSecret signs the payloads sent to the webhooks, like the secret of a Trello application
+	AppSecret = "fake-app-secret"
+
+	// apiVersion prefixes the paths of the Trello API, e.g. https://trello.com/1/members/me
+	apiVersion = "/1"
+	// posStep is the gap between the positions of the resources put at the bottom
+	posStep = 65536
+	// dateLayout is the format of the dates returned by Trello
+	dateLayout = "2006-01-02T15:04:05.000Z"
+)
+
+// Server is a fake Trello server listening on a local address, e.g. to give its base URL to the HttpRepository
+type Server struct {
+	*httptest.Server
+	// now gives the date of the actions and of the last activity of the boards
+	now    func() time.Time
+	routes []route
+	// client sends the requests to the callback URLs of the webhooks
+	client *http.Client
+	// deliveries waits for the payloads being sent to the webhooks
+	deliveries sync.WaitGroup
+
+	mu           sync.Mutex
+	lastID       int
+	me           trello.Member
+	members      []trello.Member
+	boards       []*board
+	labels       []*trello.Label
+	lists        []*trello.List
+	cards        []*card
+	checklists   []*trello.Checklist
+	customFields []*trello.CustomField
+	attachments  []*attachment
+	// actions from the most recent to the oldest, like Trello returns them
+	actions  []*action
+	webhooks []*trello.Webhook
+}
+
+// NewServer starts a fake Trello server without any board, whose current member is "me"
+func NewServer() *Server {
+	s := &Server{
+		now:    time.Now,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	s.me = s.createMember("me", "Me")
+	s.routes = s.buildRoutes()
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Close waits for the payloads being sent to the webhooks, then shuts the server down
+func (s *Server) Close() {
+	s.deliveries.Wait()
+	s.Server.Close()
+}
+
+// BaseURL of the Trello API served by the fake server
+func (s *Server) BaseURL() string {
+	return s.URL + apiVersion
+}
+
+// TrelloConf returns the configuration to reach the fake server
+func (s *Server) TrelloConf() conf.Trello {
+	return conf.Trello{
+		ApiKey:      ApiKey,
+		AccessToken: AccessToken,
+		AppSecret:   AppSecret,
+		BaseURL:     s.BaseURL(),
+	}
+}
+
+// ROUTING -----------------------------------------------------------------------------------------------------
+
+// handler returns the resource to write as JSON in the response, or an apiError
+type handler func(r *http.Request, params []string) (interface{}, error)
+
+type route struct {
+	method string
+	// segments of the path, the ones starting with ':' being the parameters given to the handler
+	segments []string
+	handle   handler
+	// authorized by the Authorization header instead of the key and token queries, like the downloads
+	oauth bool
+}
+
+func newRoute(method, path string, handle handler) route {
+	return route{method: method, segments: strings.Split(path, "/"), handle: handle}
+}
+
+func (rt route) match(method string, segments []string) ([]string, bool) {
+	if rt.method != method || len(rt.segments) != len(segments) {
+		return nil, false
+	}
+	var params []string
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, ":") {
+			params = append(params, segments[i])
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (s *Server) buildRoutes() []route {
+	download := newRoute(http.MethodGet, "cards/:id/attachments/:id/download/:name", s.downloadAttachment)
+	download.oauth = true
+	return []route{
+		newRoute(http.MethodGet, "members/me", s.findCurrentMember),
+		newRoute(http.MethodGet, "members/me/boards", s.findBoards),
+		newRoute(http.MethodPost, "boards", s.createBoardHandler),
+		newRoute(http.MethodPut, "boards/:id", s.updateBoard),
+		newRoute(http.MethodGet, "boards/:id/labels", s.findLabels),
+		newRoute(http.MethodGet, "boards/:id/members", s.findMembers),
+		newRoute(http.MethodGet, "boards/:id/lists", s.findLists),
+		newRoute(http.MethodGet, "boards/:id/lists/closed", s.findArchivedLists),
+		newRoute(http.MethodGet, "boards/:id/customFields", s.findCustomFields),
+		newRoute(http.MethodGet, "boards/:id/actions", s.findBoardActions),
+		newRoute(http.MethodPost, "labels", s.createLabelHandler),
+		newRoute(http.MethodPut, "labels/:id", s.updateLabel),
+		newRoute(http.MethodDelete, "labels/:id", s.deleteLabel),
+		newRoute(http.MethodPost, "lists", s.createListHandler),
+		newRoute(http.MethodPut, "lists/:id", s.updateList),
+		newRoute(http.MethodGet, "lists/:id/cards", s.findCards),
+		newRoute(http.MethodGet, "lists/:id/cards/closed", s.findArchivedCards),
+		newRoute(http.MethodPost, "lists/:id/archiveAllCards", s.archiveAllCards),
+		newRoute(http.MethodPost, "cards", s.createCardHandler),
+		newRoute(http.MethodPut, "cards/:id", s.updateCard),
+		newRoute(http.MethodGet, "search", s.searchCards),
+		newRoute(http.MethodGet, "cards/:id/checklists", s.findChecklists),
+		newRoute(http.MethodPost, "checklists", s.createChecklistHandler),
+		newRoute(http.MethodPut, "checklists/:id", s.updateChecklist),
+		newRoute(http.MethodDelete, "checklists/:id", s.deleteChecklist),
+		newRoute(http.MethodPost, "checklists/:id/checkItems", s.createCheckItemHandler),
+		newRoute(http.MethodPut, "cards/:id/checkItem/:id", s.updateCheckItem),
+		newRoute(http.MethodDelete, "cards/:id/checkItem/:id", s.deleteCheckItem),
+		newRoute(http.MethodPut, "cards/:id/customField/:id/item", s.updateCustomFieldItem),
+		newRoute(http.MethodGet, "cards/:id/attachments", s.findAttachments),
+		newRoute(http.MethodPost, "cards/:id/attachments", s.createAttachment),
+		newRoute(http.MethodDelete, "cards/:id/attachments/:id", s.deleteAttachment),
+		download,
+		newRoute(http.MethodGet, "cards/:id/actions", s.findCardActions),
+		newRoute(http.MethodGet, "actions/:id", s.findAction),
+		newRoute(http.MethodPost, "cards/:id/actions/comments", s.createComment),
+		newRoute(http.MethodPut, "cards/:id/actions/:id/comments", s.updateComment),
+		newRoute(http.MethodDelete, "cards/:id/actions/:id/comments", s.deleteComment),
+		newRoute(http.MethodGet, "tokens/:token/webhooks", s.findWebhooks),
+		newRoute(http.MethodPost, "webhooks", s.createWebhook),
+		newRoute(http.MethodDelete, "webhooks/:id", s.deleteWebhook),
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.EscapedPath(), apiVersion+"/")
+	if path == r.URL.EscapedPath() {
+		writeError(w, errNotFound)
+		return
+	}
+	// the segments are unescaped after splitting the path, as the names of the attachments may contain '/'
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = unescaped
+		}
+	}
+	for _, rt := range s.routes {
+		params, ok := rt.match(r.Method, segments)
+		if !ok {
+			continue
+		}
+		if err := authorize(r, rt.oauth); err != nil {
+			writeError(w, err)
+			return
+		}
+		s.mu.Lock()
+		resource, err := rt.handle(r, params)
+		s.mu.Unlock()
+		if err != nil {
+			log.Debug().Err(err).Str("method", r.Method).Str("path", r.URL.Path).Msg("fake Trello request failed")
+			writeError(w, err)
+			return
+		}
+		write(w, resource)
+		return
+	}
+	writeError(w, apiError{status: http.StatusNotFound, message: fmt.Sprintf("Cannot %s %s", r.Method, r.URL.Path)})
+}
+
+// authorize checks the credentials given as queries, or in the Authorization header for the downloads
+func authorize(r *http.Request, oauth bool) error {
+	if oauth {
+		header := r.Header.Get("Authorization")
+		if !strings.Contains(header, fmt.Sprintf(`oauth_consumer_key="%s"`, ApiKey)) ||
+			!strings.Contains(header, fmt.Sprintf(`oauth_token="%s"`, AccessToken)) {
+			return apiError{status: http.StatusUnauthorized, message: "unauthorized permission requested"}
+		}
+		return nil
+	}
+	q := r.URL.Query()
+	if q.Get("key") != ApiKey {
+		return apiError{status: http.StatusUnauthorized, message: "invalid key"}
+	}
+	if q.Get("token") != AccessToken {
+		return apiError{status: http.StatusUnauthorized, message: "invalid token"}
+	}
+	return nil
+}
+
+// RESPONSES ---------------------------------------------------------------------------------------------------
+
+// apiError is written as a plain text body with its status, like the errors of Trello
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e apiError) Error() string {
+	return fmt.Sprintf("%d %s", e.status, e.message)
+}
+
+var errNotFound = apiError{status: http.StatusNotFound, message: "The requested resource was not found."}
+
+func invalidValue(name string) apiError {
+	return apiError{status: http.StatusBadRequest, message: fmt.Sprintf("invalid value for %s", name)}
+}
+
+// content is written as is in the response, instead of as JSON
+type content struct {
+	mimeType string
+	data     []byte
+}
+
+func write(w http.ResponseWriter, resource interface{}) {
+	if c, ok := resource.(content); ok {
+		w.Header().Set("Content-Type", c.mimeType)
+		w.WriteHeader(http.StatusOK)
+		w.Write(c.data)
+		return
+	}
+	b, err := json.Marshal(resource)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var e apiError
+	if !errors.As(err, &e) {
+		e = apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(e.status)
+	w.Write([]byte(e.message))
+}
+
+// selectFields keeps the given comma separated fields of the resource, and its ID, like the "fields" query of
+// Trello; all the fields are kept if none is given
+func selectFields(resource interface{}, fields string) map[string]interface{} {
+	b, _ := json.Marshal(resource)
+	var m map[string]interface{}
+	_ = json.Unmarshal(b, &m)
+	if fields == "" || fields == "all" {
+		return m
+	}
+	selected := map[string]interface{}{"id": m["id"]}
+	for _, field := range strings.Split(fields, ",") {
+		if value, ok := m[field]; ok {
+			selected[field] = value
+		}
+	}
+	return selected
+}
+
+// REQUESTS ----------------------------------------------------------------------------------------------------
+
+// body of a request, whose fields are decoded one by one, as the updates only change the given fields
+type body map[string]json.RawMessage
+
+func readBody(r *http.Request) (body, error) {
+	b := body{}
+	if r.ContentLength == 0 {
+		return b, nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+		return nil, apiError{status: http.StatusBadRequest, message: "invalid JSON body"}
+	}
+	return b, nil
+}
+
+// firstError returns the first of the errors of decoding the fields of a body that is not nil
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// has returns true if the field is given, even if it is null
+func (b body) has(field string) bool {
+	_, ok := b[field]
+	return ok
+}
+
+// decode the field into v if it is given, returning an invalid value error if it cannot be decoded
+func (b body) decode(field string, v interface{}) error {
+	raw, ok := b[field]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return invalidValue(field)
+	}
+	return nil
+}
+
+// string returns the value of the field, or "" if it is not given
+func (b body) string(field string) (string, error) {
+	var s string
+	err := b.decode(field, &s)
+	return s, err
+}
+
+// date returns the date given in the field, nil to remove the date
+func (b body) date(field string) (*string, error) {
+	var date *string
+	if err := b.decode(field, &date); err != nil {
+		return nil, err
+	}
+	if date == nil || *date == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *date)
+	if err != nil {
+		return nil, invalidValue(field)
+	}
+	formatted := t.UTC().Format(dateLayout)
+	return &formatted, nil
+}
+
+// ids returns the IDs given as a comma separated list in the field
+func (b body) ids(field string) ([]string, error) {
+	s, err := b.string(field)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	return strings.Split(s, ","), nil
+}
+
+// pos returns the position given in the field, i.e. "top", "bottom" or a positive float, among the positions
+// of the sibling resources; the resource is put at the bottom if no position is given
+func (b body) pos(field string, positions []float64) (float64, error) {
+	var pos interface{}
+	if err := b.decode(field, &pos); err != nil {
+		return 0, err
+	}
+	if s, ok := pos.(string); ok {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			pos = f
+		}
+	}
+	switch p := pos.(type) {
+	case float64:
+		if p < 0 {
+			return 0, invalidValue(field)
+		}
+		return p, nil
+	case nil:
+		pos = "bottom"
+	}
+	sort.Float64s(positions)
+	switch pos {
+	case "top":
+		if len(positions) == 0 {
+			return posStep, nil
+		}
+		return positions[0] / 2, nil
+	case "bottom":
+		if len(positions) == 0 {
+			return posStep, nil
+		}
+		return positions[len(positions)-1] + posStep, nil
+	}
+	return 0, invalidValue(field)
+}
+
+// STATE -------------------------------------------------------------------------------------------------------
+
+// newID returns a new ID looking like the ones of Trello, i.e. 24 hexadecimal characters
+func (s *Server) newID() string {
+	s.lastID++
+	return fmt.Sprintf("%024x", s.lastID)
+}
+
+// newShortLink returns a new short link, i.e. 8 characters identifying the boards and cards in their URL
+func (s *Server) newShortLink() string {
+	s.lastID++
+	return fmt.Sprintf("fk%06d", s.lastID)
+}
+
+func (s *Server) date() string {
+	return s.now().UTC().Format(dateLayout)
+}
+
+func (s *Server) createMember(username, fullName string) trello.Member {
+	member := trello.Member{ID: s.newID(), Username: username, FullName: fullName}
+	s.members = append(s.members, member)
+	return member
+}
+
+func (s *Server) findMember(idMember string) *trello.Member {
+	for i := range s.members {
+		if s.members[i].ID == idMember {
+			return &s.members[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) findCurrentMember(r *http.Request, _ []string) (interface{}, error) {
+	return selectFields(s.me, r.URL.Query().Get("fields")), nil
+}
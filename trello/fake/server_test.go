@@ -0,0 +1,646 @@
+package fake
+
+import (
+	"bytes"
+	"errors"
+	"github.com/l-lin/tcli/conf"
+	wrappedhttp "github.com/l-lin/tcli/http"
+	"github.com/l-lin/tcli/trello"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newRepository returns the HttpRepository sending its requests to the fake server
+func newRepository(s *Server) trello.Repository {
+	return trello.NewHttpRepository(conf.Conf{Trello: s.TrelloConf()}, false)
+}
+
+// clock returns dates one second apart, starting from 2026-10-01 09:00:00
+func clock() func() time.Time {
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	return func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+}
+
+// statusError returns the status code and the body of the error returned by the HttpRepository
+func statusError(err error) (int, string) {
+	var statusErr *wrappedhttp.StatusError
+	if !errors.As(err, &statusErr) {
+		return 0, ""
+	}
+	return statusErr.StatusCode, statusErr.Body
+}
+
+func TestServer_Boards(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+
+	// WHEN
+	created, err1 := tr.CreateBoard(trello.CreateBoard{Name: "board", Desc: "description"})
+	updated, err2 := tr.UpdateBoard(trello.UpdateBoard{ID: created.ID, Name: "renamed board", Desc: "description"})
+	lists, err3 := tr.FindLists(created.ID)
+	labels, err4 := tr.FindLabels(created.ID)
+	members, err5 := tr.FindMembers(created.ID)
+	me, err6 := tr.FindCurrentMember()
+	boards, err7 := tr.FindBoards()
+	err8 := tr.CloseBoard(created.ID)
+	openBoards, err9 := tr.FindBoards()
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4, err5, err6, err7, err8, err9} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if created.Name != "board" || created.Desc != "description" || created.ShortLink == "" || created.ShortURL == "" {
+		t.Errorf("unexpected created board %+v", created)
+	}
+	if updated.ID != created.ID || updated.Name != "renamed board" || updated.Desc != "description" {
+		t.Errorf("unexpected updated board %+v", updated)
+	}
+	var listNames []string
+	for _, l := range lists {
+		listNames = append(listNames, l.Name)
+		if l.IDBoard != created.ID {
+			t.Errorf("expected list %s to be in board %s, actual %s", l.Name, created.ID, l.IDBoard)
+		}
+	}
+	if !reflect.DeepEqual(defaultListNames, listNames) {
+		t.Errorf("expected the default lists %v, actual %v", defaultListNames, listNames)
+	}
+	if len(labels) != len(defaultLabelColors) {
+		t.Errorf("expected the default labels, actual %v", labels)
+	}
+	expectedMembers := trello.Members{{ID: me.ID, Username: "me", FullName: "Me"}}
+	if !reflect.DeepEqual(expectedMembers, members) {
+		t.Errorf("expected members %v, actual %v", expectedMembers, members)
+	}
+	if len(boards) != 1 || boards[0].Name != "renamed board" || boards[0].DateLastActivity == "" {
+		t.Errorf("expected the created board, actual %v", boards)
+	}
+	if len(openBoards) != 0 {
+		t.Errorf("expected no open board, actual %v", openBoards)
+	}
+}
+
+func TestServer_BoardWithoutDefaults(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	noDefault := false
+
+	board, err := tr.CreateBoard(trello.CreateBoard{Name: "board", DefaultLabels: &noDefault, DefaultLists: &noDefault})
+	if err != nil {
+		t.Fatalf("expected no error, actual %v", err)
+	}
+	lists, _ := tr.FindLists(board.ID)
+	labels, _ := tr.FindLabels(board.ID)
+	if len(lists) != 0 || len(labels) != 0 {
+		t.Errorf("expected no list and no label, actual %v and %v", lists, labels)
+	}
+}
+
+func TestServer_Lists(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	otherBoard, _ := tr.CreateBoard(trello.CreateBoard{Name: "other board"})
+	lists, _ := tr.FindLists(board.ID)
+
+	// WHEN
+	top, err1 := tr.CreateList(trello.CreateList{Name: "top", IDBoard: board.ID, Pos: "top"})
+	bottom, err2 := tr.CreateList(trello.CreateList{Name: "bottom", IDBoard: board.ID})
+	renamed, err3 := tr.UpdateList(trello.UpdateList{ID: lists[0].ID, Name: "renamed", IDBoard: board.ID, Pos: 1.0})
+	moved, err4 := tr.UpdateList(trello.UpdateList{ID: lists[1].ID, Name: lists[1].Name, IDBoard: otherBoard.ID})
+	err5 := tr.ArchiveList(board.ID, lists[2].ID)
+	actualLists, err6 := tr.FindLists(board.ID)
+	archivedLists, err7 := tr.FindArchivedLists(board.ID)
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4, err5, err6, err7} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if top.Pos >= lists[0].Pos || bottom.Pos <= lists[2].Pos {
+		t.Errorf("expected the lists at the top and at the bottom, actual %v and %v", top.Pos, bottom.Pos)
+	}
+	if renamed.Name != "renamed" || renamed.Pos != 1.0 || moved.IDBoard != otherBoard.ID {
+		t.Errorf("unexpected updated lists %+v and %+v", renamed, moved)
+	}
+	var names []string
+	for _, l := range actualLists {
+		names = append(names, l.Name)
+	}
+	if expected := []string{"renamed", "top", "bottom"}; !reflect.DeepEqual(expected, names) {
+		t.Errorf("expected the lists sorted by position %v, actual %v", expected, names)
+	}
+	if len(archivedLists) != 1 || archivedLists[0].ID != lists[2].ID || !archivedLists[0].Closed {
+		t.Errorf("expected the archived list, actual %v", archivedLists)
+	}
+}
+
+func TestServer_Labels(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	noDefault := false
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board", DefaultLabels: &noDefault})
+	lists, _ := tr.FindLists(board.ID)
+	red, blue := "red", "blue"
+
+	// WHEN
+	label, err1 := tr.CreateLabel(trello.CreateLabel{IDBoard: board.ID, Name: "bug", Color: &red})
+	card, err2 := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID, IDLabels: label.ID})
+	updated, err3 := tr.UpdateLabel(trello.UpdateLabel{ID: label.ID, IDBoard: board.ID, Name: "defect", Color: &blue})
+	cardsWithLabel, err4 := tr.FindCards(lists[0].ID)
+	err5 := tr.DeleteLabel(board.ID, label.ID)
+	labels, err6 := tr.FindLabels(board.ID)
+	cardsWithoutLabel, err7 := tr.FindCards(lists[0].ID)
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4, err5, err6, err7} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if len(card.Labels) != 1 || card.Labels[0].Name != "bug" {
+		t.Errorf("expected the card to have the label, actual %v", card.Labels)
+	}
+	expectedLabel := trello.Label{ID: label.ID, IDBoard: board.ID, Name: "defect", Color: "blue"}
+	if *updated != expectedLabel {
+		t.Errorf("expected label %v, actual %v", expectedLabel, *updated)
+	}
+	if !reflect.DeepEqual(trello.Labels{expectedLabel}, cardsWithLabel[0].Labels) {
+		t.Errorf("expected the label of the card to be updated, actual %v", cardsWithLabel[0].Labels)
+	}
+	if len(labels) != 0 || len(cardsWithoutLabel[0].Labels) != 0 {
+		t.Errorf("expected the label to be deleted, actual %v and %v", labels, cardsWithoutLabel[0].Labels)
+	}
+}
+
+func TestServer_Cards(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	labels, _ := tr.FindLabels(board.ID)
+	me, _ := tr.FindCurrentMember()
+	due := "2026-11-01T17:00:00Z"
+
+	// WHEN
+	card, err1 := tr.CreateCard(trello.CreateCard{
+		Name:      "card",
+		Desc:      "description",
+		IDList:    lists[0].ID,
+		IDLabels:  labels[0].ID,
+		IDMembers: me.ID,
+		Due:       &due,
+	})
+	top, err2 := tr.CreateCard(trello.CreateCard{Name: "top", IDList: lists[0].ID, Pos: "top"})
+	moved, err3 := tr.UpdateCard(trello.UpdateCard{
+		ID:          card.ID,
+		Name:        "moved card",
+		Desc:        card.Desc,
+		IDBoard:     board.ID,
+		IDList:      lists[1].ID,
+		IDLabels:    labels[1].ID,
+		IDMembers:   "",
+		Due:         nil,
+		DueComplete: true,
+	})
+	cards1, err4 := tr.FindCards(lists[0].ID)
+	cards2, err5 := tr.FindCards(lists[1].ID)
+	err6 := tr.ArchiveAllCards(lists[0].ID)
+	archived, err7 := tr.FindArchivedCards(lists[0].ID)
+	found, err8 := tr.SearchCards("MOVED", board.ID)
+	notFound, err9 := tr.SearchCards("moved unknown")
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4, err5, err6, err7, err8, err9} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if card.IDBoard != board.ID || card.Due != "2026-11-01T17:00:00.000Z" || !reflect.DeepEqual([]string{me.ID}, card.IDMembers) ||
+		len(card.Labels) != 1 || card.Labels[0].ID != labels[0].ID {
+		t.Errorf("unexpected created card %+v", card)
+	}
+	if top.Pos >= card.Pos {
+		t.Errorf("expected the card to be at the top, actual %v", top.Pos)
+	}
+	if moved.Name != "moved card" || moved.IDList != lists[1].ID || moved.Due != "" || !moved.DueComplete ||
+		len(moved.IDMembers) != 0 || len(moved.Labels) != 1 || moved.Labels[0].ID != labels[1].ID {
+		t.Errorf("unexpected updated card %+v", moved)
+	}
+	if len(cards1) != 1 || cards1[0].ID != top.ID || len(cards2) != 1 || cards2[0].ID != card.ID {
+		t.Errorf("expected the card to be moved, actual %v and %v", cards1, cards2)
+	}
+	if len(archived) != 1 || archived[0].ID != top.ID || !archived[0].Closed {
+		t.Errorf("expected the cards of the list to be archived, actual %v", archived)
+	}
+	if len(found) != 1 || found[0].ID != card.ID || len(notFound) != 0 {
+		t.Errorf("expected the card to be found, actual %v and %v", found, notFound)
+	}
+}
+
+func TestServer_Actions(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	s.now = clock()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	since := s.now()
+
+	// WHEN
+	card, _ := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID})
+	_, _ = tr.UpdateCard(trello.UpdateCard{ID: card.ID, Name: "card", IDBoard: board.ID, IDList: lists[1].ID})
+	_, _ = tr.CreateComment(trello.CreateComment{IDCard: card.ID, Text: "comment"})
+	_, _ = tr.UpdateCard(trello.UpdateCard{ID: card.ID, Name: "card", IDBoard: board.ID, IDList: lists[1].ID, Closed: true})
+	boardActions, err1 := tr.FindBoardActions(board.ID, since)
+	cardActions, err2 := tr.FindCardActions(card.ID, time.Time{})
+	recentActions, err3 := tr.FindBoardActions(board.ID, s.now())
+
+	// THEN
+	for _, err := range []error{err1, err2, err3} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	expected := []string{
+		"archived card 'card'",
+		"commented card 'card': comment",
+		"moved card 'card' from list 'To Do' to list 'Doing'",
+		"created card 'card' in list 'To Do'",
+	}
+	var descriptions []string
+	for _, action := range boardActions {
+		descriptions = append(descriptions, action.Description())
+		if action.MemberCreator.Username != "me" {
+			t.Errorf("expected the actions to be performed by the current member, actual %v", action.MemberCreator)
+		}
+	}
+	if !reflect.DeepEqual(expected, descriptions) {
+		t.Errorf("expected actions %v, actual %v", expected, descriptions)
+	}
+	if !reflect.DeepEqual(boardActions, cardActions) {
+		t.Errorf("expected the actions of the card %v, actual %v", boardActions, cardActions)
+	}
+	if len(recentActions) != 0 {
+		t.Errorf("expected no action since now, actual %v", recentActions)
+	}
+}
+
+func TestServer_Comments(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	card, _ := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID})
+
+	// WHEN
+	created, err1 := tr.CreateComment(trello.CreateComment{IDCard: card.ID, Text: "comment"})
+	updated, err2 := tr.UpdateComment(trello.UpdateComment{ID: created.ID, IDCard: card.ID, Text: "updated comment"})
+	found, err3 := tr.FindComment(card.ID, created.ID)
+	comments, err4 := tr.FindComments(card.ID)
+	err5 := tr.DeleteComment(card.ID, created.ID)
+	deleted, err6 := tr.FindComments(card.ID)
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4, err5, err6} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if created.Data.Text != "comment" || created.Data.Card.ID != card.ID || created.Data.Card.ShortLink != card.ShortLink ||
+		created.MemberCreator.Username != "me" || created.MemberCreator.Initials != "M" || created.Date == "" {
+		t.Errorf("unexpected created comment %+v", created)
+	}
+	if updated.Data.Text != "updated comment" || !reflect.DeepEqual(updated, found) {
+		t.Errorf("unexpected updated comment %+v, found %+v", updated, found)
+	}
+	if len(comments) != 1 || !reflect.DeepEqual(*found, comments[0]) {
+		t.Errorf("expected the comment of the card, actual %v", comments)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("expected the comment to be deleted, actual %v", deleted)
+	}
+}
+
+func TestServer_Checklists(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	card, _ := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID})
+
+	// WHEN
+	checklist, err1 := tr.CreateChecklist(trello.CreateChecklist{IDCard: card.ID, Name: "checklist", Pos: "bottom"})
+	other, err2 := tr.CreateChecklist(trello.CreateChecklist{IDCard: card.ID, Name: "other", Pos: "top"})
+	item1, err3 := tr.CreateCheckItem(trello.CreateCheckItem{IDCard: card.ID, IDChecklist: checklist.ID, Name: "item 1", Pos: "bottom"})
+	item2, err4 := tr.CreateCheckItem(trello.CreateCheckItem{IDCard: card.ID, IDChecklist: checklist.ID, Name: "item 2", Checked: true, Pos: "bottom"})
+	_, err5 := tr.UpdateChecklist(trello.UpdateChecklist{ID: other.ID, IDCard: card.ID, Name: "renamed"})
+	updatedItem, err6 := tr.UpdateCheckItem(trello.UpdateCheckItem{ID: item1.ID, IDCard: card.ID, IDChecklist: checklist.ID, Name: "item 1", State: "complete"})
+	err7 := tr.DeleteCheckItem(card.ID, item2.ID)
+	err8 := tr.DeleteChecklist(card.ID, "unknown")
+	checklists, err9 := tr.FindChecklists(card.ID)
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err4, err5, err6, err7} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if !errors.Is(err8, wrappedhttp.ErrNotFound) || err9 != nil {
+		t.Errorf("expected not found error, actual %v and %v", err8, err9)
+	}
+	if item2.State != "complete" || updatedItem.State != "complete" {
+		t.Errorf("expected the items to be complete, actual %v and %v", item2, updatedItem)
+	}
+	expected := trello.Checklists{
+		{ID: other.ID, Name: "renamed", IDCard: card.ID, Pos: other.Pos, CheckItems: trello.CheckItems{}},
+		{ID: checklist.ID, Name: "checklist", IDCard: card.ID, Pos: checklist.Pos, CheckItems: trello.CheckItems{
+			{ID: item1.ID, Name: "item 1", IDChecklist: checklist.ID, State: "complete", Pos: item1.Pos},
+		}},
+	}
+	if !reflect.DeepEqual(expected, checklists) {
+		t.Errorf("expected %v, actual %v", expected, checklists)
+	}
+}
+
+func TestServer_CustomFields(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	card, _ := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID})
+	s.mu.Lock()
+	priority := s.createCustomField(board.ID, "Priority", trello.CustomFieldTypeList, "high", "low")
+	estimate := s.createCustomField(board.ID, "Estimate", trello.CustomFieldTypeNumber)
+	s.mu.Unlock()
+	customFields, err := tr.FindCustomFields(board.ID)
+	if err != nil || len(customFields) != 2 {
+		t.Fatalf("expected the custom fields of the board, actual %v %v", customFields, err)
+	}
+
+	type expected struct {
+		values trello.CustomFieldValues
+		err    string
+	}
+	var tests = map[string]struct {
+		given    map[string]string
+		expected expected
+	}{
+		"set values": {
+			given: map[string]string{"Priority": "low", "Estimate": "3"},
+			expected: expected{values: trello.CustomFieldValues{
+				{Name: "Priority", Type: priority.Type, Value: "low"},
+				{Name: "Estimate", Type: estimate.Type, Value: "3"},
+			}},
+		},
+		"clear values": {
+			given: map[string]string{"Priority": "", "Estimate": ""},
+			expected: expected{values: trello.CustomFieldValues{
+				{Name: "Priority", Type: priority.Type},
+				{Name: "Estimate", Type: estimate.Type},
+			}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cards, _ := tr.FindCards(lists[0].ID)
+			updates, err := customFields.Changes(cards[0], tt.given)
+			if err != nil {
+				t.Fatalf("expected no error, actual %v", err)
+			}
+			for _, update := range updates {
+				if _, err := tr.UpdateCustomFieldItem(update); err != nil {
+					t.Fatalf("expected no error, actual %v", err)
+				}
+			}
+			cards, _ = tr.FindCards(lists[0].ID)
+			actual := customFields.Values(cards[0])
+			if !reflect.DeepEqual(tt.expected.values, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected.values, actual)
+			}
+		})
+	}
+
+	_, err = tr.UpdateCustomFieldItem(trello.UpdateCustomFieldItem{
+		IDCard:        card.ID,
+		IDCustomField: estimate.ID,
+		Value:         trello.CustomFieldItemValue{Text: "three"},
+	})
+	if status, body := statusError(err); status != http.StatusBadRequest || body != "Invalid custom field item value." {
+		t.Errorf("expected invalid value error, actual %v", err)
+	}
+}
+
+func TestServer_Attachments(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	card, _ := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID})
+
+	// WHEN
+	created, err1 := tr.CreateAttachment(trello.CreateAttachment{IDCard: card.ID, Name: "my notes.txt", File: strings.NewReader("content")})
+	attachments, err2 := tr.FindAttachments(card.ID)
+	buf := bytes.Buffer{}
+	err3 := tr.DownloadAttachment(*created, &buf)
+	unauthorized := trello.NewHttpRepository(conf.Conf{Trello: conf.Trello{BaseURL: s.BaseURL()}}, false)
+	err4 := unauthorized.DownloadAttachment(*created, &bytes.Buffer{})
+	err5 := tr.DeleteAttachment(card.ID, created.ID)
+	deleted, err6 := tr.FindAttachments(card.ID)
+
+	// THEN
+	for _, err := range []error{err1, err2, err3, err5, err6} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if created.Name != "my notes.txt" || created.Bytes != 7 || !created.IsUpload || !strings.HasPrefix(created.MimeType, "text/plain") {
+		t.Errorf("unexpected created attachment %+v", created)
+	}
+	if !reflect.DeepEqual(trello.Attachments{*created}, attachments) {
+		t.Errorf("expected %v, actual %v", trello.Attachments{*created}, attachments)
+	}
+	if buf.String() != "content" {
+		t.Errorf("expected the content of the attachment, actual %s", buf.String())
+	}
+	if !errors.Is(err4, wrappedhttp.ErrUnauthorized) {
+		t.Errorf("expected unauthorized error, actual %v", err4)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("expected the attachment to be deleted, actual %v", deleted)
+	}
+}
+
+func TestServer_Webhooks(t *testing.T) {
+	// GIVEN
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	var receiver *trello.WebhookReceiver
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		receiver.ServeHTTP(rw, r)
+	}))
+	defer ts.Close()
+	receiver = trello.NewWebhookReceiver(AppSecret, ts.URL)
+
+	// WHEN
+	webhooks, err1 := trello.RegisterWebhooks(tr, ts.URL, trello.Boards{*board})
+	_, err2 := tr.CreateWebhook(trello.CreateWebhook{IDModel: board.ID, CallbackURL: ts.URL})
+	card, err3 := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID})
+	s.deliveries.Wait()
+	received := receiver.Apply(tr)
+	deleted, err4 := trello.UnregisterWebhooks(tr, ts.URL)
+	remaining, err5 := tr.FindWebhooks()
+
+	// THEN
+	for _, err := range []error{err1, err3, err4, err5} {
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+	}
+	if status, body := statusError(err2); status != http.StatusBadRequest || !strings.Contains(body, "already exists") {
+		t.Errorf("expected the webhook to already exist, actual %v", err2)
+	}
+	if len(webhooks) != 1 || webhooks[0].IDModel != board.ID || !webhooks[0].Active {
+		t.Errorf("unexpected registered webhooks %v", webhooks)
+	}
+	if len(received) != 1 || received[0].Type != "createCard" || received[0].Data.Card.ID != card.ID {
+		t.Errorf("expected the creation of the card to be received, actual %v", received)
+	}
+	if !reflect.DeepEqual(webhooks, deleted) || len(remaining) != 0 {
+		t.Errorf("expected the webhooks to be deleted, actual %v and %v", deleted, remaining)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	tr := newRepository(s)
+	board, _ := tr.CreateBoard(trello.CreateBoard{Name: "board"})
+	lists, _ := tr.FindLists(board.ID)
+	purple := "violet"
+
+	type expected struct {
+		status int
+		body   string
+	}
+	var tests = map[string]struct {
+		given    func() error
+		expected expected
+	}{
+		"invalid token": {
+			given: func() error {
+				_, err := trello.NewHttpRepository(conf.Conf{Trello: conf.Trello{
+					BaseURL:     s.BaseURL(),
+					ApiKey:      ApiKey,
+					AccessToken: "invalid token",
+				}}, false).FindBoards()
+				return err
+			},
+			expected: expected{status: http.StatusUnauthorized, body: "invalid token"},
+		},
+		"unknown board": {
+			given: func() error {
+				_, err := tr.FindLists("unknown")
+				return err
+			},
+			expected: expected{status: http.StatusNotFound, body: "The requested resource was not found."},
+		},
+		"list without name": {
+			given: func() error {
+				_, err := tr.CreateList(trello.CreateList{IDBoard: board.ID})
+				return err
+			},
+			expected: expected{status: http.StatusBadRequest, body: "invalid value for name"},
+		},
+		"invalid label color": {
+			given: func() error {
+				_, err := tr.CreateLabel(trello.CreateLabel{IDBoard: board.ID, Name: "label", Color: &purple})
+				return err
+			},
+			expected: expected{status: http.StatusBadRequest, body: "invalid value for color"},
+		},
+		"label of another board": {
+			given: func() error {
+				otherBoard, _ := tr.CreateBoard(trello.CreateBoard{Name: "other board"})
+				otherLabels, _ := tr.FindLabels(otherBoard.ID)
+				_, err := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID, IDLabels: otherLabels[0].ID})
+				return err
+			},
+			expected: expected{status: http.StatusBadRequest, body: "invalid value for idLabels"},
+		},
+		"invalid position": {
+			given: func() error {
+				_, err := tr.CreateCard(trello.CreateCard{Name: "card", IDList: lists[0].ID, Pos: "middle"})
+				return err
+			},
+			expected: expected{status: http.StatusBadRequest, body: "invalid value for pos"},
+		},
+		"unreachable callback URL": {
+			given: func() error {
+				_, err := tr.CreateWebhook(trello.CreateWebhook{IDModel: board.ID, CallbackURL: s.URL + "/unknown"})
+				return err
+			},
+			expected: expected{status: http.StatusBadRequest, body: "URL (" + s.URL + "/unknown) did not return 200 status code, got 404"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			status, body := statusError(tt.given())
+			if status != tt.expected.status || body != tt.expected.body {
+				t.Errorf("expected status %d and body '%s', actual status %d and body '%s'", tt.expected.status, tt.expected.body, status, body)
+			}
+		})
+	}
+}
+
+func TestNewDemoServer(t *testing.T) {
+	s := NewDemoServer()
+	defer s.Close()
+	tr := newRepository(s)
+
+	boards, err := tr.FindBoards()
+	if err != nil || len(boards) != 2 {
+		t.Fatalf("expected the demo boards, actual %v %v", boards, err)
+	}
+	lists, _ := tr.FindLists(boards[0].ID)
+	customFields, _ := tr.FindCustomFields(boards[0].ID)
+	cards, _ := tr.FindCards(lists[0].ID)
+	if len(lists) != 3 || len(cards) != 2 || len(customFields) != 2 {
+		t.Fatalf("expected the lists, cards and custom fields of the demo board, actual %v, %v and %v", lists, cards, customFields)
+	}
+	expected := trello.CustomFieldValues{{Name: "Priority", Type: "list", Value: "medium"}, {Name: "Estimate", Type: "number"}}
+	if actual := customFields.Values(cards[0]); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
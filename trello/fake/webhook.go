@@ -0,0 +1,122 @@
+package fake
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/l-lin/tcli/trello"
+	"github.com/rs/zerolog/log"
+	"net/http"
+)
+
+func (s *Server) findWebhooks(_ *http.Request, params []string) (interface{}, error) {
+	if params[0] != AccessToken {
+		return nil, errNotFound
+	}
+	webhooks := trello.Webhooks{}
+	for _, w := range s.webhooks {
+		webhooks = append(webhooks, *w)
+	}
+	return webhooks, nil
+}
+
+// createWebhook creates a webhook for a board, once its callback URL answers to a HEAD request, like Trello does
+func (s *Server) createWebhook(r *http.Request, _ []string) (interface{}, error) {
+	b, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	var createWebhook trello.CreateWebhook
+	if err := firstError(
+		b.decode("description", &createWebhook.Description),
+		b.decode("idModel", &createWebhook.IDModel),
+		b.decode("callbackURL", &createWebhook.CallbackURL),
+	); err != nil {
+		return nil, err
+	}
+	if s.findBoard(createWebhook.IDModel) == nil {
+		return nil, invalidValue("idModel")
+	}
+	for _, w := range s.webhooks {
+		if w.IDModel == createWebhook.IDModel && w.CallbackURL == createWebhook.CallbackURL {
+			return nil, apiError{status: http.StatusBadRequest, message: "A webhook with that callback, model, and token already exists"}
+		}
+	}
+	response, err := s.client.Head(createWebhook.CallbackURL)
+	if err != nil {
+		return nil, apiError{status: http.StatusBadRequest, message: fmt.Sprintf("URL (%s) did not return 200 status code", createWebhook.CallbackURL)}
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, apiError{
+			status:  http.StatusBadRequest,
+			message: fmt.Sprintf("URL (%s) did not return 200 status code, got %d", createWebhook.CallbackURL, response.StatusCode),
+		}
+	}
+	webhook := &trello.Webhook{
+		ID:          s.newID(),
+		Description: createWebhook.Description,
+		IDModel:     createWebhook.IDModel,
+		CallbackURL: createWebhook.CallbackURL,
+		Active:      true,
+	}
+	s.webhooks = append(s.webhooks, webhook)
+	return webhook, nil
+}
+
+func (s *Server) deleteWebhook(_ *http.Request, params []string) (interface{}, error) {
+	webhooks := s.webhooks[:0]
+	found := false
+	for _, w := range s.webhooks {
+		if w.ID == params[0] {
+			found = true
+			continue
+		}
+		webhooks = append(webhooks, w)
+	}
+	if !found {
+		return nil, errNotFound
+	}
+	s.webhooks = webhooks
+	return struct{}{}, nil
+}
+
+// deliver sends the action to the callback URL of the webhooks of its board, signed with the AppSecret
+func (s *Server) deliver(a *action) {
+	for _, w := range s.webhooks {
+		if w.IDModel != a.idBoard || !w.Active {
+			continue
+		}
+		payload, err := json.Marshal(struct {
+			Action action          `json:"action"`
+			Model  *actionResource `json:"model"`
+		}{Action: *a, Model: s.boardResource(a.idBoard)})
+		if err != nil {
+			continue
+		}
+		mac := hmac.New(sha1.New, []byte(AppSecret))
+		mac.Write(payload)
+		mac.Write([]byte(w.CallbackURL))
+		signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+		s.deliveries.Add(1)
+		go func(callbackURL string) {
+			defer s.deliveries.Done()
+			request, err := http.NewRequest(http.MethodPost, callbackURL, bytes.NewReader(payload))
+			if err != nil {
+				return
+			}
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-Trello-Webhook", signature)
+			response, err := s.client.Do(request)
+			if err != nil {
+				log.Debug().Err(err).Str("callbackURL", callbackURL).Msg("could not send action to webhook")
+				return
+			}
+			response.Body.Close()
+		}(w.CallbackURL)
+	}
+}
@@ -0,0 +1,9 @@
+//go:generate mockgen -source history.go -package trello -destination history_mock.go
+package trello
+
+// History gives access to the mutations recorded in the journal, to undo and redo them
+type History interface {
+	Entries() (JournalEntries, error)
+	Undo() (*JournalEntry, error)
+	Redo() (*JournalEntry, error)
+}
@@ -6,9 +6,20 @@ import (
 	"github.com/l-lin/tcli/conf"
 	wrappedhttp "github.com/l-lin/tcli/http"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
+)
+
+const (
+	cardFields = "id,name,desc,idBoard,idList,labels,closed,shortLink,shortUrl,pos,due,dueComplete,start,idMembers"
+	// maximum number of cards returned by Trello search API
+	searchCardsLimit = 1000
+	// maximum number of actions returned by Trello API
+	actionsLimit = 1000
 )
 
 func NewHttpRepository(c conf.Conf, debug bool) Repository {
@@ -26,8 +37,18 @@ func (h HttpRepository) Refresh() {
 	// it's violating interface segregation principle, but who cares
 }
 
+func (h HttpRepository) Prefetch(_ string) error {
+	// do nothing, as nothing is kept without cache
+	return nil
+}
+
+func (h HttpRepository) Forget(_ Actions) {
+	// do nothing, as nothing is kept without cache
+}
+
 func (h HttpRepository) FindBoards() (Boards, error) {
-	v := h.buildQueries("id,name,shortLink,shortUrl,dateLastActivity")
+	v := h.buildQueries("id,name,desc,closed,shortLink,shortUrl,dateLastActivity")
+	v.Set("filter", "open")
 	u := fmt.Sprintf("%s/members/me/boards?%v", h.BaseURL, v.Encode())
 
 	var boards Boards
@@ -48,6 +69,36 @@ func (h HttpRepository) FindBoard(query string) (*Board, error) {
 	return nil, fmt.Errorf("no board found with query %s", query)
 }
 
+func (h HttpRepository) CreateBoard(createBoard CreateBoard) (*Board, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/boards?%v", h.BaseURL, v.Encode())
+	var board Board
+	if err := h.post(u, createBoard, &board); err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+func (h HttpRepository) UpdateBoard(updateBoard UpdateBoard) (*Board, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/boards/%s?%v", h.BaseURL, updateBoard.ID, v.Encode())
+	var board Board
+	if err := h.put(u, updateBoard, &board); err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+func (h HttpRepository) CloseBoard(idBoard string) error {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/boards/%s?%v", h.BaseURL, idBoard, v.Encode())
+	closeBoard := struct {
+		Closed bool `json:"closed"`
+	}{Closed: true}
+	var board Board
+	return h.put(u, closeBoard, &board)
+}
+
 func (h HttpRepository) FindLabels(idBoard string) (Labels, error) {
 	v := h.buildQueries("id,idBoard,color,name")
 	u := fmt.Sprintf("%s/boards/%s/labels?%v", h.BaseURL, idBoard, v.Encode())
@@ -59,8 +110,56 @@ func (h HttpRepository) FindLabels(idBoard string) (Labels, error) {
 	return labels, nil
 }
 
+func (h HttpRepository) CreateLabel(createLabel CreateLabel) (*Label, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/labels?%v", h.BaseURL, v.Encode())
+	var label Label
+	if err := h.post(u, createLabel, &label); err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+func (h HttpRepository) UpdateLabel(updateLabel UpdateLabel) (*Label, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/labels/%s?%v", h.BaseURL, updateLabel.ID, v.Encode())
+	var label Label
+	if err := h.put(u, updateLabel, &label); err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+func (h HttpRepository) DeleteLabel(_, idLabel string) error {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/labels/%s?%v", h.BaseURL, idLabel, v.Encode())
+	return h.delete(u)
+}
+
+func (h HttpRepository) FindMembers(idBoard string) (Members, error) {
+	v := h.buildQueries("id,username,fullName")
+	u := fmt.Sprintf("%s/boards/%s/members?%v", h.BaseURL, idBoard, v.Encode())
+
+	var members Members
+	if err := h.get(u, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (h HttpRepository) FindCurrentMember() (*Member, error) {
+	v := h.buildQueries("id,username,fullName")
+	u := fmt.Sprintf("%s/members/me?%v", h.BaseURL, v.Encode())
+
+	var member Member
+	if err := h.get(u, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
 func (h HttpRepository) FindLists(idBoard string) (Lists, error) {
-	v := h.buildQueries("id,name,idBoard")
+	v := h.buildQueries("id,name,idBoard,closed,pos")
 	u := fmt.Sprintf("%s/boards/%s/lists?%v", h.BaseURL, idBoard, v.Encode())
 
 	var lists Lists
@@ -83,8 +182,50 @@ func (h HttpRepository) FindList(idBoard string, query string) (*List, error) {
 	return nil, fmt.Errorf("no list found with query %s", query)
 }
 
+func (h HttpRepository) CreateList(createList CreateList) (*List, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/lists?%v", h.BaseURL, v.Encode())
+	var list List
+	if err := h.post(u, createList, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (h HttpRepository) UpdateList(updateList UpdateList) (*List, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/lists/%s?%v", h.BaseURL, updateList.ID, v.Encode())
+	var list List
+	if err := h.put(u, updateList, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (h HttpRepository) ArchiveList(_, idList string) error {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/lists/%s?%v", h.BaseURL, idList, v.Encode())
+	archiveList := struct {
+		Closed bool `json:"closed"`
+	}{Closed: true}
+	var list List
+	return h.put(u, archiveList, &list)
+}
+
+func (h HttpRepository) FindArchivedLists(idBoard string) (Lists, error) {
+	v := h.buildQueries("id,name,idBoard,closed,pos")
+	u := fmt.Sprintf("%s/boards/%s/lists/closed?%v", h.BaseURL, idBoard, v.Encode())
+
+	var lists Lists
+	if err := h.get(u, &lists); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
 func (h HttpRepository) FindCards(idList string) (Cards, error) {
-	v := h.buildQueries("id,name,desc,idBoard,idList,labels,closed,shortLink,shortUrl,pos")
+	v := h.buildQueries(cardFields)
+	v.Set("customFieldItems", "true")
 	u := fmt.Sprintf("%s/lists/%s/cards?%v", h.BaseURL, idList, v.Encode())
 
 	var cards Cards
@@ -107,6 +248,18 @@ func (h HttpRepository) FindCard(idList string, query string) (*Card, error) {
 	return nil, fmt.Errorf("no card found with query %s", query)
 }
 
+func (h HttpRepository) FindArchivedCards(idList string) (Cards, error) {
+	v := h.buildQueries(cardFields)
+	v.Set("customFieldItems", "true")
+	u := fmt.Sprintf("%s/lists/%s/cards/closed?%v", h.BaseURL, idList, v.Encode())
+
+	var cards Cards
+	if err := h.get(u, &cards); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
 func (h HttpRepository) ArchiveAllCards(idList string) error {
 	v := h.buildQueries("")
 	u := fmt.Sprintf("%s/lists/%s/archiveAllCards?%v", h.BaseURL, idList, v.Encode())
@@ -134,6 +287,196 @@ func (h HttpRepository) UpdateCard(updateCard UpdateCard) (*Card, error) {
 	return &card, nil
 }
 
+// SearchCards finds the cards matching the query in their name, description, labels, checklists or comments
+// in the given boards, or in all the boards if none is given
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-search/#api-search-get for more info
+func (h HttpRepository) SearchCards(query string, idBoards ...string) (Cards, error) {
+	v := h.buildQueries("")
+	v.Set("query", query)
+	v.Set("modelTypes", "cards")
+	v.Set("card_fields", cardFields)
+	v.Set("cards_limit", strconv.Itoa(searchCardsLimit))
+	v.Set("partial", "true")
+	if len(idBoards) > 0 {
+		v.Set("idBoards", strings.Join(idBoards, ","))
+	}
+	u := fmt.Sprintf("%s/search?%v", h.BaseURL, v.Encode())
+
+	var result struct {
+		Cards Cards `json:"cards"`
+	}
+	if err := h.get(u, &result); err != nil {
+		return nil, err
+	}
+	return result.Cards, nil
+}
+
+func (h HttpRepository) FindChecklists(idCard string) (Checklists, error) {
+	v := h.buildQueries("id,name,idCard,pos")
+	v.Set("checkItems", "all")
+	v.Set("checkItem_fields", "id,name,idChecklist,state,pos")
+	u := fmt.Sprintf("%s/cards/%s/checklists?%v", h.BaseURL, idCard, v.Encode())
+
+	var checklists Checklists
+	if err := h.get(u, &checklists); err != nil {
+		return nil, err
+	}
+	return checklists, nil
+}
+
+func (h HttpRepository) CreateChecklist(createChecklist CreateChecklist) (*Checklist, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/checklists?%v", h.BaseURL, v.Encode())
+	var checklist Checklist
+	if err := h.post(u, createChecklist, &checklist); err != nil {
+		return nil, err
+	}
+	return &checklist, nil
+}
+
+func (h HttpRepository) UpdateChecklist(updateChecklist UpdateChecklist) (*Checklist, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/checklists/%s?%v", h.BaseURL, updateChecklist.ID, v.Encode())
+	var checklist Checklist
+	if err := h.put(u, updateChecklist, &checklist); err != nil {
+		return nil, err
+	}
+	return &checklist, nil
+}
+
+func (h HttpRepository) DeleteChecklist(_, idChecklist string) error {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/checklists/%s?%v", h.BaseURL, idChecklist, v.Encode())
+	return h.delete(u)
+}
+
+func (h HttpRepository) CreateCheckItem(createCheckItem CreateCheckItem) (*CheckItem, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/checklists/%s/checkItems?%v", h.BaseURL, createCheckItem.IDChecklist, v.Encode())
+	var checkItem CheckItem
+	if err := h.post(u, createCheckItem, &checkItem); err != nil {
+		return nil, err
+	}
+	return &checkItem, nil
+}
+
+func (h HttpRepository) UpdateCheckItem(updateCheckItem UpdateCheckItem) (*CheckItem, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/cards/%s/checkItem/%s?%v", h.BaseURL, updateCheckItem.IDCard, updateCheckItem.ID, v.Encode())
+	var checkItem CheckItem
+	if err := h.put(u, updateCheckItem, &checkItem); err != nil {
+		return nil, err
+	}
+	return &checkItem, nil
+}
+
+func (h HttpRepository) DeleteCheckItem(idCard, idCheckItem string) error {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/cards/%s/checkItem/%s?%v", h.BaseURL, idCard, idCheckItem, v.Encode())
+	return h.delete(u)
+}
+
+func (h HttpRepository) FindCustomFields(idBoard string) (CustomFields, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/boards/%s/customFields?%v", h.BaseURL, idBoard, v.Encode())
+
+	var customFields CustomFields
+	if err := h.get(u, &customFields); err != nil {
+		return nil, err
+	}
+	return customFields, nil
+}
+
+func (h HttpRepository) UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/cards/%s/customField/%s/item?%v", h.BaseURL, updateCustomFieldItem.IDCard, updateCustomFieldItem.IDCustomField, v.Encode())
+	var customFieldItem CustomFieldItem
+	if err := h.put(u, updateCustomFieldItem, &customFieldItem); err != nil {
+		return nil, err
+	}
+	return &customFieldItem, nil
+}
+
+func (h HttpRepository) FindAttachments(idCard string) (Attachments, error) {
+	v := h.buildQueries("id,name,url,bytes,mimeType,date,isUpload")
+	u := fmt.Sprintf("%s/cards/%s/attachments?%v", h.BaseURL, idCard, v.Encode())
+
+	var attachments Attachments
+	if err := h.get(u, &attachments); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func (h HttpRepository) CreateAttachment(createAttachment CreateAttachment) (*Attachment, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/cards/%s/attachments?%v", h.BaseURL, createAttachment.IDCard, v.Encode())
+
+	// stream the file instead of loading it in memory, as attachments can be up to 250MB
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := mw.WriteField("name", createAttachment.Name)
+		if err == nil {
+			var part io.Writer
+			if part, err = mw.CreateFormFile("file", createAttachment.Name); err == nil {
+				_, err = io.Copy(part, createAttachment.File)
+			}
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	request, err := http.NewRequest(http.MethodPost, u, pr)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Content-Type", mw.FormDataContentType())
+
+	response, err := h.client.DoOnlyOk(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	respBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	var attachment Attachment
+	if err = json.Unmarshal(respBody, &attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (h HttpRepository) DownloadAttachment(attachment Attachment, w io.Writer) error {
+	request, err := http.NewRequest(http.MethodGet, attachment.URL, nil)
+	if err != nil {
+		return err
+	}
+	if attachment.IsUpload {
+		// uploaded files can only be downloaded when authenticated
+		// do not send the credentials to the external resources though
+		request.Header.Add("Authorization", fmt.Sprintf(`OAuth oauth_consumer_key="%s", oauth_token="%s"`, h.ApiKey, h.AccessToken))
+	}
+
+	response, err := h.client.DoOnlyOk(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+func (h HttpRepository) DeleteAttachment(idCard, idAttachment string) error {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/cards/%s/attachments/%s?%v", h.BaseURL, idCard, idAttachment, v.Encode())
+	return h.delete(u)
+}
+
 func (h HttpRepository) FindComments(idCard string) (Comments, error) {
 	v := h.buildQueries("")
 	v.Set("filter", "commentCard")
@@ -183,6 +526,65 @@ func (h HttpRepository) DeleteComment(idCard, idComment string) error {
 	return h.delete(u)
 }
 
+func (h HttpRepository) FindCardActions(idCard string, since time.Time) (Actions, error) {
+	v := h.buildActionQueries(since)
+	u := fmt.Sprintf("%s/cards/%s/actions?%v", h.BaseURL, idCard, v.Encode())
+
+	var actions Actions
+	if err := h.get(u, &actions); err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+func (h HttpRepository) FindBoardActions(idBoard string, since time.Time) (Actions, error) {
+	v := h.buildActionQueries(since)
+	u := fmt.Sprintf("%s/boards/%s/actions?%v", h.BaseURL, idBoard, v.Encode())
+
+	var actions Actions
+	if err := h.get(u, &actions); err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+func (h HttpRepository) FindWebhooks() (Webhooks, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/tokens/%s/webhooks?%v", h.BaseURL, h.AccessToken, v.Encode())
+
+	var webhooks Webhooks
+	if err := h.get(u, &webhooks); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (h HttpRepository) CreateWebhook(createWebhook CreateWebhook) (*Webhook, error) {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/webhooks?%v", h.BaseURL, v.Encode())
+	var webhook Webhook
+	if err := h.post(u, createWebhook, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (h HttpRepository) DeleteWebhook(idWebhook string) error {
+	v := h.buildQueries("")
+	u := fmt.Sprintf("%s/webhooks/%s?%v", h.BaseURL, idWebhook, v.Encode())
+	return h.delete(u)
+}
+
+func (h HttpRepository) buildActionQueries(since time.Time) url.Values {
+	v := h.buildQueries("")
+	v.Set("filter", strings.Join(cardActionTypes, ","))
+	v.Set("limit", strconv.Itoa(actionsLimit))
+	if !since.IsZero() {
+		v.Set("since", since.UTC().Format(time.RFC3339))
+	}
+	return v
+}
+
 func (h HttpRepository) get(url string, ret interface{}) error {
 	request, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -1,13 +1,16 @@
 package trello
 
 import (
+	"bytes"
 	"encoding/json"
 	"github.com/l-lin/tcli/conf"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestHttpRepository_FindBoards(t *testing.T) {
@@ -178,57 +181,39 @@ func TestHttpRepository_FindBoard(t *testing.T) {
 	}
 }
 
-func TestHttpRepository_FindLabels(t *testing.T) {
+func TestHttpRepository_CreateBoard(t *testing.T) {
 	type given struct {
 		tsFn func() *httptest.Server
 	}
-
+	type expected struct {
+		hasError bool
+		board    *Board
+	}
 	var tests = map[string]struct {
-		given given
-		test  func(actual Labels, err error)
+		given    given
+		expected expected
 	}{
 		"happy path": {
 			given: given{
 				tsFn: func() *httptest.Server {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						w.WriteHeader(http.StatusOK)
-						w.Write([]byte(`
-[{
-  "id": "label 1",
-  "idBoard": "board",
-  "color": "red",
-  "name": "label name 1"
-}, {
-  "id": "label 2",
-  "idBoard": "board",
-  "color": "sky",
-  "name": "label name 2"
-}]`))
+						if r.Method != "POST" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							reqBody, _ := io.ReadAll(r.Body)
+							var cb CreateBoard
+							json.Unmarshal(reqBody, &cb)
+							board := Board{ID: "board 1", Name: cb.Name}
+							respBody, _ := json.Marshal(&board)
+							w.WriteHeader(http.StatusOK)
+							w.Write(respBody)
+						}
 					}))
 				},
 			},
-			test: func(actual Labels, err error) {
-				if err != nil {
-					t.Errorf("expected no error, got: %v", err)
-					t.FailNow()
-				}
-				if actual == nil {
-					t.Error("expected not nil labels")
-					t.FailNow()
-				}
-				expected := Labels{
-					{ID: "label 1", IDBoard: "board", Color: "red", Name: "label name 1"},
-					{ID: "label 2", IDBoard: "board", Color: "sky", Name: "label name 2"},
-				}
-				if len(expected) != len(actual) {
-					t.Errorf("expected %v, actual %v", expected, actual)
-					t.FailNow()
-				}
-				for i := 0; i < len(expected); i++ {
-					if actual[i] != expected[i] {
-						t.Errorf("%d: expected %v, actual %v", i, expected[i], actual[i])
-					}
-				}
+			expected: expected{
+				hasError: false,
+				board:    &Board{ID: "board 1", Name: "created board"},
 			},
 		},
 		"server error": {
@@ -239,13 +224,9 @@ func TestHttpRepository_FindLabels(t *testing.T) {
 					}))
 				},
 			},
-			test: func(actual Labels, err error) {
-				if err == nil {
-					t.Error("expected error")
-				}
-				if actual != nil {
-					t.Error("expected nil labels")
-				}
+			expected: expected{
+				hasError: true,
+				board:    nil,
 			},
 		},
 	}
@@ -257,52 +238,53 @@ func TestHttpRepository_FindLabels(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			tt.test(repository.FindLabels("board"))
+			actual, actualErr := repository.CreateBoard(CreateBoard{Name: "created board"})
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
+			}
+			if tt.expected.board != nil && actual == nil || tt.expected.board == nil && actual != nil {
+				t.Errorf("expected %v, actual %v", tt.expected.board, actual)
+			}
+			if tt.expected.board != nil && *tt.expected.board != *actual {
+				t.Errorf("expected %v, actual %v", tt.expected.board, actual)
+			}
 		})
 	}
 }
 
-func TestHttpRepository_FindLists(t *testing.T) {
+func TestHttpRepository_UpdateBoard(t *testing.T) {
 	type given struct {
 		tsFn func() *httptest.Server
 	}
-
+	type expected struct {
+		hasError bool
+		board    *Board
+	}
 	var tests = map[string]struct {
-		given given
-		test  func(actual Lists, err error)
+		given    given
+		expected expected
 	}{
 		"happy path": {
 			given: given{
 				tsFn: func() *httptest.Server {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						w.WriteHeader(http.StatusOK)
-						w.Write([]byte(`
-[{
-  "id": "list 1",
-  "name": "list"
-}, {
-  "id": "list 2",
-  "name": "another list"
-}]`))
+						if r.Method != "PUT" || r.URL.Path != "/boards/board 1" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							reqBody, _ := io.ReadAll(r.Body)
+							var ub UpdateBoard
+							json.Unmarshal(reqBody, &ub)
+							board := Board{ID: ub.ID, Name: ub.Name, Desc: ub.Desc}
+							respBody, _ := json.Marshal(&board)
+							w.WriteHeader(http.StatusOK)
+							w.Write(respBody)
+						}
 					}))
 				},
 			},
-			test: func(actual Lists, err error) {
-				if err != nil {
-					t.Errorf("expected no error, got: %v", err)
-					t.FailNow()
-				}
-				if actual == nil {
-					t.Error("expected not nil lists")
-					t.FailNow()
-				}
-				expected := Lists{
-					{ID: "list 1", Name: "list"},
-					{ID: "list 2", Name: "another list"},
-				}
-				if !reflect.DeepEqual(expected, actual) {
-					t.Errorf("expected %v, actual %v", expected, actual)
-				}
+			expected: expected{
+				hasError: false,
+				board:    &Board{ID: "board 1", Name: "updated board", Desc: "updated description"},
 			},
 		},
 		"server error": {
@@ -313,13 +295,9 @@ func TestHttpRepository_FindLists(t *testing.T) {
 					}))
 				},
 			},
-			test: func(actual Lists, err error) {
-				if err == nil {
-					t.Error("expected error")
-				}
-				if actual != nil {
-					t.Error("expected nil lists")
-				}
+			expected: expected{
+				hasError: true,
+				board:    nil,
 			},
 		},
 	}
@@ -331,49 +309,47 @@ func TestHttpRepository_FindLists(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			tt.test(repository.FindLists("board 1"))
+			actual, actualErr := repository.UpdateBoard(UpdateBoard{ID: "board 1", Name: "updated board", Desc: "updated description"})
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
+			}
+			if tt.expected.board != nil && actual == nil || tt.expected.board == nil && actual != nil {
+				t.Errorf("expected %v, actual %v", tt.expected.board, actual)
+			}
+			if tt.expected.board != nil && *tt.expected.board != *actual {
+				t.Errorf("expected %v, actual %v", tt.expected.board, actual)
+			}
 		})
 	}
 }
 
-func TestHttpRepository_FindList(t *testing.T) {
+func TestHttpRepository_CloseBoard(t *testing.T) {
 	type given struct {
 		tsFn func() *httptest.Server
 	}
-
+	type expected struct {
+		hasError bool
+	}
 	var tests = map[string]struct {
-		given given
-		test  func(actual *List, err error)
+		given    given
+		expected expected
 	}{
 		"happy path": {
 			given: given{
 				tsFn: func() *httptest.Server {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						w.WriteHeader(http.StatusOK)
-						w.Write([]byte(`
-[{
-  "id": "list 1",
-  "name": "list"
-}, {
-  "id": "list 2",
-  "name": "another list"
-}]`))
+						reqBody, _ := io.ReadAll(r.Body)
+						if r.Method != "PUT" || string(reqBody) != `{"closed":true}` {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							w.WriteHeader(http.StatusOK)
+							w.Write([]byte(`{"id": "board 1", "closed": true}`))
+						}
 					}))
 				},
 			},
-			test: func(actual *List, err error) {
-				if err != nil {
-					t.Errorf("expected no error, got: %v", err)
-					t.FailNow()
-				}
-				if actual == nil {
-					t.Error("expected not nil boards")
-					t.FailNow()
-				}
-				expected := &List{ID: "list 1", Name: "list"}
-				if *expected != *actual {
-					t.Errorf("expected %v, actual %v", expected, actual)
-				}
+			expected: expected{
+				hasError: false,
 			},
 		},
 		"server error": {
@@ -384,36 +360,8 @@ func TestHttpRepository_FindList(t *testing.T) {
 					}))
 				},
 			},
-			test: func(actual *List, err error) {
-				if err == nil {
-					t.Error("expected error")
-				}
-				if actual != nil {
-					t.Error("expected nil board")
-				}
-			},
-		},
-		"no list found": {
-			given: given{
-				tsFn: func() *httptest.Server {
-					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						w.WriteHeader(http.StatusOK)
-						w.Write([]byte(`
-[{
-  "id": "list 2",
-  "name": "another list"
-}]`))
-					}))
-				},
-			},
-			test: func(actual *List, err error) {
-				if err == nil {
-					t.Errorf("expected error")
-					t.FailNow()
-				}
-				if actual != nil {
-					t.Error("expected nil list")
-				}
+			expected: expected{
+				hasError: true,
 			},
 		},
 	}
@@ -425,19 +373,22 @@ func TestHttpRepository_FindList(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			tt.test(repository.FindList("board 1", "list"))
+			actualErr := repository.CloseBoard("board 1")
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr)
+			}
 		})
 	}
 }
 
-func TestHttpRepository_FindCards(t *testing.T) {
+func TestHttpRepository_FindLabels(t *testing.T) {
 	type given struct {
 		tsFn func() *httptest.Server
 	}
 
 	var tests = map[string]struct {
 		given given
-		test  func(actual Cards, err error)
+		test  func(actual Labels, err error)
 	}{
 		"happy path": {
 			given: given{
@@ -446,30 +397,40 @@ func TestHttpRepository_FindCards(t *testing.T) {
 						w.WriteHeader(http.StatusOK)
 						w.Write([]byte(`
 [{
-  "id": "card 1",
-  "name": "card"
+  "id": "label 1",
+  "idBoard": "board",
+  "color": "red",
+  "name": "label name 1"
 }, {
-  "id": "card 2",
-  "name": "another card"
+  "id": "label 2",
+  "idBoard": "board",
+  "color": "sky",
+  "name": "label name 2"
 }]`))
 					}))
 				},
 			},
-			test: func(actual Cards, err error) {
+			test: func(actual Labels, err error) {
 				if err != nil {
 					t.Errorf("expected no error, got: %v", err)
 					t.FailNow()
 				}
 				if actual == nil {
-					t.Error("expected not nil boards")
+					t.Error("expected not nil labels")
 					t.FailNow()
 				}
-				expected := Cards{
-					{ID: "card 1", Name: "card"},
-					{ID: "card 2", Name: "another card"},
+				expected := Labels{
+					{ID: "label 1", IDBoard: "board", Color: "red", Name: "label name 1"},
+					{ID: "label 2", IDBoard: "board", Color: "sky", Name: "label name 2"},
 				}
-				if !reflect.DeepEqual(expected, actual) {
+				if len(expected) != len(actual) {
 					t.Errorf("expected %v, actual %v", expected, actual)
+					t.FailNow()
+				}
+				for i := 0; i < len(expected); i++ {
+					if actual[i] != expected[i] {
+						t.Errorf("%d: expected %v, actual %v", i, expected[i], actual[i])
+					}
 				}
 			},
 		},
@@ -481,12 +442,12 @@ func TestHttpRepository_FindCards(t *testing.T) {
 					}))
 				},
 			},
-			test: func(actual Cards, err error) {
+			test: func(actual Labels, err error) {
 				if err == nil {
 					t.Error("expected error")
 				}
 				if actual != nil {
-					t.Error("expected nil boards")
+					t.Error("expected nil labels")
 				}
 			},
 		},
@@ -499,49 +460,45 @@ func TestHttpRepository_FindCards(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			tt.test(repository.FindCards("list 1"))
+			tt.test(repository.FindLabels("board"))
 		})
 	}
 }
 
-func TestHttpRepository_FindCard(t *testing.T) {
+func TestHttpRepository_CreateLabel(t *testing.T) {
+	red := "red"
 	type given struct {
 		tsFn func() *httptest.Server
 	}
-
+	type expected struct {
+		hasError bool
+		label    *Label
+	}
 	var tests = map[string]struct {
-		given given
-		test  func(actual *Card, err error)
+		given    given
+		expected expected
 	}{
 		"happy path": {
 			given: given{
 				tsFn: func() *httptest.Server {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.Method != "POST" || r.URL.Path != "/labels" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+							return
+						}
+						reqBody, _ := io.ReadAll(r.Body)
+						var cl CreateLabel
+						json.Unmarshal(reqBody, &cl)
+						label := Label{ID: "label 1", IDBoard: cl.IDBoard, Name: cl.Name, Color: *cl.Color}
+						respBody, _ := json.Marshal(&label)
 						w.WriteHeader(http.StatusOK)
-						w.Write([]byte(`
-[{
-  "id": "card 1",
-  "name": "card"
-}, {
-  "id": "card 2",
-  "name": "another card"
-}]`))
+						w.Write(respBody)
 					}))
 				},
 			},
-			test: func(actual *Card, err error) {
-				if err != nil {
-					t.Errorf("expected no error, got: %v", err)
-					t.FailNow()
-				}
-				if actual == nil {
-					t.Error("expected not nil boards")
-					t.FailNow()
-				}
-				expected := &Card{ID: "card 1", Name: "card"}
-				if expected.ID != actual.ID || expected.Name != actual.Name {
-					t.Errorf("expected %v, actual %v", expected, actual)
-				}
+			expected: expected{
+				hasError: false,
+				label:    &Label{ID: "label 1", IDBoard: "board 1", Name: "bug", Color: "red"},
 			},
 		},
 		"server error": {
@@ -552,36 +509,9 @@ func TestHttpRepository_FindCard(t *testing.T) {
 					}))
 				},
 			},
-			test: func(actual *Card, err error) {
-				if err == nil {
-					t.Error("expected error")
-				}
-				if actual != nil {
-					t.Error("expected nil card")
-				}
-			},
-		},
-		"no card found": {
-			given: given{
-				tsFn: func() *httptest.Server {
-					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						w.WriteHeader(http.StatusOK)
-						w.Write([]byte(`
-[{
-  "id": "card 2",
-  "name": "another card"
-}]`))
-					}))
-				},
-			},
-			test: func(actual *Card, err error) {
-				if err == nil {
-					t.Errorf("expected error")
-					t.FailNow()
-				}
-				if actual != nil {
-					t.Error("expected nil board")
-				}
+			expected: expected{
+				hasError: true,
+				label:    nil,
 			},
 		},
 	}
@@ -593,18 +523,24 @@ func TestHttpRepository_FindCard(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			tt.test(repository.FindCard("list 1", "card"))
+			actual, actualErr := repository.CreateLabel(CreateLabel{IDBoard: "board 1", Name: "bug", Color: &red})
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr)
+			}
+			if !reflect.DeepEqual(tt.expected.label, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected.label, actual)
+			}
 		})
 	}
 }
 
-func TestHttpRepository_ArchiveAllCards(t *testing.T) {
+func TestHttpRepository_UpdateLabel(t *testing.T) {
 	type given struct {
 		tsFn func() *httptest.Server
 	}
 	type expected struct {
 		hasError bool
-		card     *Card
+		label    *Label
 	}
 	var tests = map[string]struct {
 		given    given
@@ -614,16 +550,27 @@ func TestHttpRepository_ArchiveAllCards(t *testing.T) {
 			given: given{
 				tsFn: func() *httptest.Server {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						if r.Method != "POST" {
+						if r.Method != "PUT" || r.URL.Path != "/labels/label 1" {
 							w.WriteHeader(http.StatusMethodNotAllowed)
-						} else {
-							w.WriteHeader(http.StatusOK)
+							return
+						}
+						reqBody, _ := io.ReadAll(r.Body)
+						var body map[string]interface{}
+						json.Unmarshal(reqBody, &body)
+						if body["color"] != nil {
+							// the color must be sent as null to be removed
+							w.WriteHeader(http.StatusBadRequest)
+							return
 						}
+						respBody, _ := json.Marshal(&Label{ID: "label 1", IDBoard: "board 1", Name: body["name"].(string)})
+						w.WriteHeader(http.StatusOK)
+						w.Write(respBody)
 					}))
 				},
 			},
 			expected: expected{
 				hasError: false,
+				label:    &Label{ID: "label 1", IDBoard: "board 1", Name: "feature"},
 			},
 		},
 		"server error": {
@@ -636,6 +583,7 @@ func TestHttpRepository_ArchiveAllCards(t *testing.T) {
 			},
 			expected: expected{
 				hasError: true,
+				label:    nil,
 			},
 		},
 	}
@@ -647,121 +595,108 @@ func TestHttpRepository_ArchiveAllCards(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			actualErr := repository.ArchiveAllCards("list")
-			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+			actual, actualErr := repository.UpdateLabel(UpdateLabel{ID: "label 1", IDBoard: "board 1", Name: "feature"})
+			if tt.expected.hasError != (actualErr != nil) {
 				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr)
 			}
+			if !reflect.DeepEqual(tt.expected.label, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected.label, actual)
+			}
 		})
 	}
 }
 
-func TestHttpRepository_CreateCard(t *testing.T) {
-	type given struct {
-		tsFn func() *httptest.Server
-	}
-	type expected struct {
-		hasError bool
-		card     *Card
-	}
+func TestHttpRepository_DeleteLabel(t *testing.T) {
 	var tests = map[string]struct {
-		given    given
-		expected expected
+		tsFn     func() *httptest.Server
+		hasError bool
 	}{
 		"happy path": {
-			given: given{
-				tsFn: func() *httptest.Server {
-					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						if r.Method != "POST" {
-							w.WriteHeader(http.StatusMethodNotAllowed)
-						} else {
-							reqBody, _ := io.ReadAll(r.Body)
-							var cc CreateCard
-							json.Unmarshal(reqBody, &cc)
-							card := Card{ID: "card 1", Name: cc.Name}
-							respBody, _ := json.Marshal(&card)
-							w.WriteHeader(http.StatusOK)
-							w.Write(respBody)
-						}
-					}))
-				},
-			},
-			expected: expected{
-				hasError: false,
-				card:     &Card{ID: "card 1", Name: "created card"},
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method != "DELETE" || r.URL.Path != "/labels/label 1" {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+				}))
 			},
+			hasError: false,
 		},
 		"server error": {
-			given: given{
-				tsFn: func() *httptest.Server {
-					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						w.WriteHeader(http.StatusInternalServerError)
-					}))
-				},
-			},
-			expected: expected{
-				hasError: true,
-				card:     nil,
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
 			},
+			hasError: true,
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			ts := tt.given.tsFn()
+			ts := tt.tsFn()
 			repository := NewHttpRepository(conf.Conf{
 				Trello: conf.Trello{
 					BaseURL: ts.URL,
 				},
 			}, false)
-			actual, actualErr := repository.CreateCard(CreateCard{Name: "created card"})
-			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
-				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
-			}
-			if tt.expected.card != nil && actual == nil || tt.expected.card == nil && actual != nil {
-				t.Errorf("expected %v, actual %v", tt.expected.card, actual)
-			}
-			if tt.expected.card != nil {
-				if tt.expected.card.ID != actual.ID && tt.expected.card.Name != actual.Name {
-					t.Errorf("expected %v, actual %v", tt.expected.card, actual)
-				}
+			actualErr := repository.DeleteLabel("board 1", "label 1")
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.hasError, actualErr)
 			}
 		})
 	}
 }
 
-func TestHttpRepository_UpdateCard(t *testing.T) {
+func TestHttpRepository_FindMembers(t *testing.T) {
 	type given struct {
 		tsFn func() *httptest.Server
 	}
-	type expected struct {
-		hasError bool
-		card     *Card
-	}
+
 	var tests = map[string]struct {
-		given    given
-		expected expected
+		given given
+		test  func(actual Members, err error)
 	}{
 		"happy path": {
 			given: given{
 				tsFn: func() *httptest.Server {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						if r.Method != "PUT" {
-							w.WriteHeader(http.StatusMethodNotAllowed)
-						} else {
-							reqBody, _ := io.ReadAll(r.Body)
-							var uc UpdateCard
-							json.Unmarshal(reqBody, &uc)
-							card := Card{ID: uc.ID, Name: uc.Name}
-							respBody, _ := json.Marshal(&card)
-							w.WriteHeader(http.StatusOK)
-							w.Write(respBody)
+						if r.URL.Path != "/boards/board/members" {
+							w.WriteHeader(http.StatusNotFound)
+							return
 						}
 						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "member 1",
+  "username": "member1",
+  "fullName": "Member 1"
+}, {
+  "id": "member 2",
+  "username": "member2",
+  "fullName": "Member 2"
+}]`))
 					}))
 				},
 			},
-			expected: expected{
-				hasError: false,
-				card:     &Card{ID: "card 1", Name: "updated card"},
+			test: func(actual Members, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				expected := Members{
+					{ID: "member 1", Username: "member1", FullName: "Member 1"},
+					{ID: "member 2", Username: "member2", FullName: "Member 2"},
+				}
+				if len(expected) != len(actual) {
+					t.Errorf("expected %v, actual %v", expected, actual)
+					t.FailNow()
+				}
+				for i := 0; i < len(expected); i++ {
+					if actual[i] != expected[i] {
+						t.Errorf("%d: expected %v, actual %v", i, expected[i], actual[i])
+					}
+				}
 			},
 		},
 		"server error": {
@@ -772,9 +707,13 @@ func TestHttpRepository_UpdateCard(t *testing.T) {
 					}))
 				},
 			},
-			expected: expected{
-				hasError: true,
-				card:     nil,
+			test: func(actual Members, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil members")
+				}
 			},
 		},
 	}
@@ -786,74 +725,87 @@ func TestHttpRepository_UpdateCard(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			actual, actualErr := repository.UpdateCard(UpdateCard{ID: "card 1", Name: "updated card"})
-			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
-				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
-			}
-			if tt.expected.card != nil && actual == nil || tt.expected.card == nil && actual != nil {
-				t.Errorf("expected %v, actual %v", tt.expected.card, actual)
-			}
-			if tt.expected.card != nil {
-				if tt.expected.card.ID != actual.ID && tt.expected.card.Name != actual.Name {
-					t.Errorf("expected %v, actual %v", tt.expected.card, actual)
-				}
-			}
+			tt.test(repository.FindMembers("board"))
 		})
 	}
 }
 
-func TestHttpRepository_FindComments(t *testing.T) {
+func TestHttpRepository_FindCurrentMember(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/members/me" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "member 1", "username": "member1", "fullName": "Member 1"}`))
+	}))
+	defer ts.Close()
+	repository := NewHttpRepository(conf.Conf{
+		Trello: conf.Trello{
+			BaseURL: ts.URL,
+		},
+	}, false)
+
+	actual, err := repository.FindCurrentMember()
+
+	if err != nil {
+		t.Errorf("expected no error, got: %v", err)
+		t.FailNow()
+	}
+	expected := Member{ID: "member 1", Username: "member1", FullName: "Member 1"}
+	if *actual != expected {
+		t.Errorf("expected %v, actual %v", expected, *actual)
+	}
+}
+
+func TestHttpRepository_SearchCards(t *testing.T) {
 	type given struct {
-		tsFn func() *httptest.Server
+		idBoards []string
+		tsFn     func() *httptest.Server
 	}
 
 	var tests = map[string]struct {
 		given given
-		test  func(actual Comments, err error)
+		test  func(actual Cards, err error)
 	}{
 		"happy path": {
 			given: given{
+				idBoards: []string{"board 1", "board 2"},
 				tsFn: func() *httptest.Server {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						q := r.URL.Query()
+						if r.URL.Path != "/search" ||
+							q.Get("query") != "some query" ||
+							q.Get("modelTypes") != "cards" ||
+							q.Get("idBoards") != "board 1,board 2" {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
 						w.WriteHeader(http.StatusOK)
 						w.Write([]byte(`
-[{
-  "id": "comment 1",
-  "date": "2021-02-02T16:18:41.228Z",
-  "data": {
-    "text": "text comment 1"
-  },
-  "memberCreator": {
-    "fullName": "foobar"
-  }
-}, {
-  "id": "comment 2",
-  "date": "2021-02-02T18:17:41.228Z",
-  "data": {
-    "text": "text comment 2"
-  },
-  "memberCreator": {
-    "fullName": "foobar"
-  }
-}]`))
+{
+  "options": {"terms": [{"text": "some"}, {"text": "query"}]},
+  "cards": [{
+    "id": "card 1",
+    "name": "card",
+    "idBoard": "board 1",
+    "idList": "list 1"
+  }]
+}`))
 					}))
 				},
 			},
-			test: func(actual Comments, err error) {
+			test: func(actual Cards, err error) {
 				if err != nil {
 					t.Errorf("expected no error, got: %v", err)
 					t.FailNow()
 				}
-				if actual == nil {
-					t.Error("expected not nil comments")
+				if len(actual) != 1 {
+					t.Errorf("expected 1 card, actual %v", actual)
 					t.FailNow()
 				}
-				expected := Comments{
-					{ID: "comment 1", Date: "2021-02-02T16:18:41.228Z", Data: CommentData{Text: "text comment 1"}, MemberCreator: CommentMemberCreator{FullName: "foobar"}},
-					{ID: "comment 2", Date: "2021-02-02T18:17:41.228Z", Data: CommentData{Text: "text comment 2"}, MemberCreator: CommentMemberCreator{FullName: "foobar"}},
-				}
-				if !reflect.DeepEqual(expected, actual) {
-					t.Errorf("expected %v, actual %v", expected, actual)
+				if actual[0].ID != "card 1" || actual[0].IDBoard != "board 1" || actual[0].IDList != "list 1" {
+					t.Errorf("unexpected card %v", actual[0])
 				}
 			},
 		},
@@ -865,12 +817,12 @@ func TestHttpRepository_FindComments(t *testing.T) {
 					}))
 				},
 			},
-			test: func(actual Comments, err error) {
+			test: func(actual Cards, err error) {
 				if err == nil {
 					t.Error("expected error")
 				}
 				if actual != nil {
-					t.Error("expected nil comments")
+					t.Error("expected nil cards")
 				}
 			},
 		},
@@ -878,24 +830,25 @@ func TestHttpRepository_FindComments(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			ts := tt.given.tsFn()
+			defer ts.Close()
 			repository := NewHttpRepository(conf.Conf{
 				Trello: conf.Trello{
 					BaseURL: ts.URL,
 				},
 			}, false)
-			tt.test(repository.FindComments("card 1"))
+			tt.test(repository.SearchCards("some query", tt.given.idBoards...))
 		})
 	}
 }
 
-func TestHttpRepository_FindComment(t *testing.T) {
+func TestHttpRepository_FindLists(t *testing.T) {
 	type given struct {
 		tsFn func() *httptest.Server
 	}
 
 	var tests = map[string]struct {
 		given given
-		test  func(actual *Comment, err error)
+		test  func(actual Lists, err error)
 	}{
 		"happy path": {
 			given: given{
@@ -903,29 +856,29 @@ func TestHttpRepository_FindComment(t *testing.T) {
 					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 						w.WriteHeader(http.StatusOK)
 						w.Write([]byte(`
-{
-  "id": "comment 1",
-  "date": "2021-02-02T16:18:41.228Z",
-  "data": {
-    "text": "text comment 1"
-  },
-  "memberCreator": {
-    "fullName": "foobar"
-  }
-}`))
+[{
+  "id": "list 1",
+  "name": "list"
+}, {
+  "id": "list 2",
+  "name": "another list"
+}]`))
 					}))
 				},
 			},
-			test: func(actual *Comment, err error) {
+			test: func(actual Lists, err error) {
 				if err != nil {
 					t.Errorf("expected no error, got: %v", err)
 					t.FailNow()
 				}
 				if actual == nil {
-					t.Error("expected not nil comment")
+					t.Error("expected not nil lists")
 					t.FailNow()
 				}
-				expected := &Comment{ID: "comment 1", Date: "2021-02-02T16:18:41.228Z", Data: CommentData{Text: "text comment 1"}, MemberCreator: CommentMemberCreator{FullName: "foobar"}}
+				expected := Lists{
+					{ID: "list 1", Name: "list"},
+					{ID: "list 2", Name: "another list"},
+				}
 				if !reflect.DeepEqual(expected, actual) {
 					t.Errorf("expected %v, actual %v", expected, actual)
 				}
@@ -939,12 +892,1318 @@ func TestHttpRepository_FindComment(t *testing.T) {
 					}))
 				},
 			},
-			test: func(actual *Comment, err error) {
+			test: func(actual Lists, err error) {
 				if err == nil {
 					t.Error("expected error")
 				}
 				if actual != nil {
-					t.Error("expected nil comment")
+					t.Error("expected nil lists")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindLists("board 1"))
+		})
+	}
+}
+
+func TestHttpRepository_FindList(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual *List, err error)
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "list 1",
+  "name": "list"
+}, {
+  "id": "list 2",
+  "name": "another list"
+}]`))
+					}))
+				},
+			},
+			test: func(actual *List, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				if actual == nil {
+					t.Error("expected not nil boards")
+					t.FailNow()
+				}
+				expected := &List{ID: "list 1", Name: "list"}
+				if *expected != *actual {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual *List, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil board")
+				}
+			},
+		},
+		"no list found": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "list 2",
+  "name": "another list"
+}]`))
+					}))
+				},
+			},
+			test: func(actual *List, err error) {
+				if err == nil {
+					t.Errorf("expected error")
+					t.FailNow()
+				}
+				if actual != nil {
+					t.Error("expected nil list")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindList("board 1", "list"))
+		})
+	}
+}
+
+func TestHttpRepository_CreateList(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+	type expected struct {
+		hasError bool
+		list     *List
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.Method != "POST" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							reqBody, _ := io.ReadAll(r.Body)
+							var cl CreateList
+							json.Unmarshal(reqBody, &cl)
+							list := List{ID: "list 1", Name: cl.Name, IDBoard: cl.IDBoard}
+							respBody, _ := json.Marshal(&list)
+							w.WriteHeader(http.StatusOK)
+							w.Write(respBody)
+						}
+					}))
+				},
+			},
+			expected: expected{
+				hasError: false,
+				list:     &List{ID: "list 1", Name: "created list", IDBoard: "board 1"},
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			expected: expected{
+				hasError: true,
+				list:     nil,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.CreateList(CreateList{Name: "created list", IDBoard: "board 1"})
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
+			}
+			if tt.expected.list != nil && actual == nil || tt.expected.list == nil && actual != nil {
+				t.Errorf("expected %v, actual %v", tt.expected.list, actual)
+			}
+			if tt.expected.list != nil && *tt.expected.list != *actual {
+				t.Errorf("expected %v, actual %v", tt.expected.list, actual)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_UpdateList(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+	type expected struct {
+		hasError bool
+		list     *List
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.Method != "PUT" || r.URL.Path != "/lists/list 1" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							reqBody, _ := io.ReadAll(r.Body)
+							var ul UpdateList
+							json.Unmarshal(reqBody, &ul)
+							list := List{ID: ul.ID, Name: ul.Name, IDBoard: ul.IDBoard, Pos: ul.Pos.(float64)}
+							respBody, _ := json.Marshal(&list)
+							w.WriteHeader(http.StatusOK)
+							w.Write(respBody)
+						}
+					}))
+				},
+			},
+			expected: expected{
+				hasError: false,
+				list:     &List{ID: "list 1", Name: "updated list", IDBoard: "board 1", Pos: 1024},
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			expected: expected{
+				hasError: true,
+				list:     nil,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.UpdateList(UpdateList{ID: "list 1", Name: "updated list", IDBoard: "board 1", Pos: float64(1024)})
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
+			}
+			if tt.expected.list != nil && actual == nil || tt.expected.list == nil && actual != nil {
+				t.Errorf("expected %v, actual %v", tt.expected.list, actual)
+			}
+			if tt.expected.list != nil && *tt.expected.list != *actual {
+				t.Errorf("expected %v, actual %v", tt.expected.list, actual)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_ArchiveList(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+	type expected struct {
+		hasError bool
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						reqBody, _ := io.ReadAll(r.Body)
+						if r.Method != "PUT" || r.URL.Path != "/lists/list 1" || string(reqBody) != `{"closed":true}` {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							w.WriteHeader(http.StatusOK)
+							w.Write([]byte(`{"id": "list 1", "closed": true}`))
+						}
+					}))
+				},
+			},
+			expected: expected{
+				hasError: false,
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			expected: expected{
+				hasError: true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actualErr := repository.ArchiveList("board 1", "list 1")
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_FindCards(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual Cards, err error)
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "card 1",
+  "name": "card"
+}, {
+  "id": "card 2",
+  "name": "another card"
+}]`))
+					}))
+				},
+			},
+			test: func(actual Cards, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				if actual == nil {
+					t.Error("expected not nil boards")
+					t.FailNow()
+				}
+				expected := Cards{
+					{ID: "card 1", Name: "card"},
+					{ID: "card 2", Name: "another card"},
+				}
+				if !reflect.DeepEqual(expected, actual) {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual Cards, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil boards")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindCards("list 1"))
+		})
+	}
+}
+
+func TestHttpRepository_FindCard(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual *Card, err error)
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "card 1",
+  "name": "card"
+}, {
+  "id": "card 2",
+  "name": "another card"
+}]`))
+					}))
+				},
+			},
+			test: func(actual *Card, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				if actual == nil {
+					t.Error("expected not nil boards")
+					t.FailNow()
+				}
+				expected := &Card{ID: "card 1", Name: "card"}
+				if expected.ID != actual.ID || expected.Name != actual.Name {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual *Card, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil card")
+				}
+			},
+		},
+		"no card found": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "card 2",
+  "name": "another card"
+}]`))
+					}))
+				},
+			},
+			test: func(actual *Card, err error) {
+				if err == nil {
+					t.Errorf("expected error")
+					t.FailNow()
+				}
+				if actual != nil {
+					t.Error("expected nil board")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindCard("list 1", "card"))
+		})
+	}
+}
+
+func TestHttpRepository_FindArchivedLists(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/boards/board 1/lists/closed" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id": "list 1", "name": "list", "closed": true}]`))
+	}))
+	repository := NewHttpRepository(conf.Conf{
+		Trello: conf.Trello{
+			BaseURL: ts.URL,
+		},
+	}, false)
+	actual, err := repository.FindArchivedLists("board 1")
+	if err != nil {
+		t.Errorf("expected no error, got: %v", err)
+		t.FailNow()
+	}
+	expected := Lists{{ID: "list 1", Name: "list", Closed: true}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
+func TestHttpRepository_FindArchivedCards(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/lists/list 1/cards/closed" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id": "card 1", "name": "card", "closed": true}]`))
+	}))
+	repository := NewHttpRepository(conf.Conf{
+		Trello: conf.Trello{
+			BaseURL: ts.URL,
+		},
+	}, false)
+	actual, err := repository.FindArchivedCards("list 1")
+	if err != nil {
+		t.Errorf("expected no error, got: %v", err)
+		t.FailNow()
+	}
+	expected := Cards{{ID: "card 1", Name: "card", Closed: true}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
+func TestHttpRepository_ArchiveAllCards(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+	type expected struct {
+		hasError bool
+		card     *Card
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.Method != "POST" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							w.WriteHeader(http.StatusOK)
+						}
+					}))
+				},
+			},
+			expected: expected{
+				hasError: false,
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			expected: expected{
+				hasError: true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actualErr := repository.ArchiveAllCards("list")
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_CreateCard(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+	type expected struct {
+		hasError bool
+		card     *Card
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.Method != "POST" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							reqBody, _ := io.ReadAll(r.Body)
+							var cc CreateCard
+							json.Unmarshal(reqBody, &cc)
+							card := Card{ID: "card 1", Name: cc.Name}
+							respBody, _ := json.Marshal(&card)
+							w.WriteHeader(http.StatusOK)
+							w.Write(respBody)
+						}
+					}))
+				},
+			},
+			expected: expected{
+				hasError: false,
+				card:     &Card{ID: "card 1", Name: "created card"},
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			expected: expected{
+				hasError: true,
+				card:     nil,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.CreateCard(CreateCard{Name: "created card"})
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
+			}
+			if tt.expected.card != nil && actual == nil || tt.expected.card == nil && actual != nil {
+				t.Errorf("expected %v, actual %v", tt.expected.card, actual)
+			}
+			if tt.expected.card != nil {
+				if tt.expected.card.ID != actual.ID && tt.expected.card.Name != actual.Name {
+					t.Errorf("expected %v, actual %v", tt.expected.card, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestHttpRepository_UpdateCard(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+	type expected struct {
+		hasError bool
+		card     *Card
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.Method != "PUT" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+						} else {
+							reqBody, _ := io.ReadAll(r.Body)
+							var uc UpdateCard
+							json.Unmarshal(reqBody, &uc)
+							card := Card{ID: uc.ID, Name: uc.Name}
+							respBody, _ := json.Marshal(&card)
+							w.WriteHeader(http.StatusOK)
+							w.Write(respBody)
+						}
+						w.WriteHeader(http.StatusOK)
+					}))
+				},
+			},
+			expected: expected{
+				hasError: false,
+				card:     &Card{ID: "card 1", Name: "updated card"},
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			expected: expected{
+				hasError: true,
+				card:     nil,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.UpdateCard(UpdateCard{ID: "card 1", Name: "updated card"})
+			if tt.expected.hasError && actualErr == nil || !tt.expected.hasError && actualErr != nil {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr != nil)
+			}
+			if tt.expected.card != nil && actual == nil || tt.expected.card == nil && actual != nil {
+				t.Errorf("expected %v, actual %v", tt.expected.card, actual)
+			}
+			if tt.expected.card != nil {
+				if tt.expected.card.ID != actual.ID && tt.expected.card.Name != actual.Name {
+					t.Errorf("expected %v, actual %v", tt.expected.card, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestHttpRepository_FindComments(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual Comments, err error)
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "comment 1",
+  "date": "2021-02-02T16:18:41.228Z",
+  "data": {
+    "text": "text comment 1"
+  },
+  "memberCreator": {
+    "fullName": "foobar"
+  }
+}, {
+  "id": "comment 2",
+  "date": "2021-02-02T18:17:41.228Z",
+  "data": {
+    "text": "text comment 2"
+  },
+  "memberCreator": {
+    "fullName": "foobar"
+  }
+}]`))
+					}))
+				},
+			},
+			test: func(actual Comments, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				if actual == nil {
+					t.Error("expected not nil comments")
+					t.FailNow()
+				}
+				expected := Comments{
+					{ID: "comment 1", Date: "2021-02-02T16:18:41.228Z", Data: CommentData{Text: "text comment 1"}, MemberCreator: CommentMemberCreator{FullName: "foobar"}},
+					{ID: "comment 2", Date: "2021-02-02T18:17:41.228Z", Data: CommentData{Text: "text comment 2"}, MemberCreator: CommentMemberCreator{FullName: "foobar"}},
+				}
+				if !reflect.DeepEqual(expected, actual) {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual Comments, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil comments")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindComments("card 1"))
+		})
+	}
+}
+
+func TestHttpRepository_FindComment(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual *Comment, err error)
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+{
+  "id": "comment 1",
+  "date": "2021-02-02T16:18:41.228Z",
+  "data": {
+    "text": "text comment 1"
+  },
+  "memberCreator": {
+    "fullName": "foobar"
+  }
+}`))
+					}))
+				},
+			},
+			test: func(actual *Comment, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				if actual == nil {
+					t.Error("expected not nil comment")
+					t.FailNow()
+				}
+				expected := &Comment{ID: "comment 1", Date: "2021-02-02T16:18:41.228Z", Data: CommentData{Text: "text comment 1"}, MemberCreator: CommentMemberCreator{FullName: "foobar"}}
+				if !reflect.DeepEqual(expected, actual) {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual *Comment, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil comment")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindComment("card 1", "comment 1"))
+		})
+	}
+}
+
+func TestHttpRepository_FindCardActions(t *testing.T) {
+	type given struct {
+		since time.Time
+		tsFn  func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual Actions, err error)
+	}{
+		"happy path": {
+			given: given{
+				since: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path != "/cards/card 1/actions" {
+							t.Errorf("unexpected path %s", r.URL.Path)
+						}
+						if r.URL.Query().Get("since") != "2026-10-01T00:00:00Z" {
+							t.Errorf("unexpected since %s", r.URL.Query().Get("since"))
+						}
+						if !strings.Contains(r.URL.Query().Get("filter"), "updateCard") {
+							t.Errorf("unexpected filter %s", r.URL.Query().Get("filter"))
+						}
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "action 1",
+  "type": "updateCard",
+  "date": "2026-10-02T16:18:41.228Z",
+  "data": {
+    "card": {"id": "card 1", "name": "card", "idShort": 1},
+    "listBefore": {"id": "list 1", "name": "todo"},
+    "listAfter": {"id": "list 2", "name": "doing"}
+  },
+  "memberCreator": {
+    "fullName": "foobar"
+  }
+}]`))
+					}))
+				},
+			},
+			test: func(actual Actions, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				expected := Actions{
+					{
+						ID:   "action 1",
+						Type: "updateCard",
+						Date: "2026-10-02T16:18:41.228Z",
+						Data: ActionData{
+							Card:       &ActionCard{ID: "card 1", Name: "card", IDShort: 1},
+							ListBefore: &ActionResource{ID: "list 1", Name: "todo"},
+							ListAfter:  &ActionResource{ID: "list 2", Name: "doing"},
+						},
+						MemberCreator: ActionMember{FullName: "foobar"},
+					},
+				}
+				if !reflect.DeepEqual(expected, actual) {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"without since": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if _, ok := r.URL.Query()["since"]; ok {
+							t.Error("expected no since")
+						}
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`[]`))
+					}))
+				},
+			},
+			test: func(actual Actions, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				if len(actual) != 0 {
+					t.Errorf("expected no action, actual %v", actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual Actions, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil actions")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindCardActions("card 1", tt.given.since))
+		})
+	}
+}
+
+func TestHttpRepository_FindBoardActions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/boards/board 1/actions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id": "action 1", "type": "createCard", "data": {"card": {"id": "card 1", "name": "card"}}}]`))
+	}))
+	repository := NewHttpRepository(conf.Conf{
+		Trello: conf.Trello{
+			BaseURL: ts.URL,
+		},
+	}, false)
+	actual, err := repository.FindBoardActions("board 1", time.Time{})
+	if err != nil {
+		t.Errorf("expected no error, got: %v", err)
+		t.FailNow()
+	}
+	if len(actual) != 1 || actual[0].ID != "action 1" {
+		t.Errorf("unexpected actions %v", actual)
+	}
+}
+
+func TestHttpRepository_FindChecklists(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual Checklists, err error)
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path != "/cards/card 1/checklists" || r.URL.Query().Get("checkItems") != "all" {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "checklist 1",
+  "name": "checklist",
+  "idCard": "card 1",
+  "pos": 16384,
+  "checkItems": [{
+    "id": "item 1",
+    "name": "item",
+    "idChecklist": "checklist 1",
+    "state": "complete",
+    "pos": 16384
+  }]
+}]`))
+					}))
+				},
+			},
+			test: func(actual Checklists, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				expected := Checklists{
+					{
+						ID:     "checklist 1",
+						Name:   "checklist",
+						IDCard: "card 1",
+						Pos:    16384,
+						CheckItems: CheckItems{
+							{ID: "item 1", Name: "item", IDChecklist: "checklist 1", State: "complete", Pos: 16384},
+						},
+					},
+				}
+				if !reflect.DeepEqual(expected, actual) {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual Checklists, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil checklists")
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			tt.test(repository.FindChecklists("card 1"))
+		})
+	}
+}
+
+func TestHttpRepository_UpdateCheckItem(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+	type expected struct {
+		hasError  bool
+		checkItem *CheckItem
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.Method != "PUT" || r.URL.Path != "/cards/card 1/checkItem/item 1" {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+							return
+						}
+						reqBody, _ := io.ReadAll(r.Body)
+						var uci UpdateCheckItem
+						json.Unmarshal(reqBody, &uci)
+						checkItem := CheckItem{ID: uci.ID, Name: uci.Name, IDChecklist: uci.IDChecklist, State: uci.State}
+						respBody, _ := json.Marshal(&checkItem)
+						w.WriteHeader(http.StatusOK)
+						w.Write(respBody)
+					}))
+				},
+			},
+			expected: expected{
+				hasError:  false,
+				checkItem: &CheckItem{ID: "item 1", Name: "item", IDChecklist: "checklist 1", State: "complete"},
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			expected: expected{
+				hasError:  true,
+				checkItem: nil,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.UpdateCheckItem(UpdateCheckItem{
+				ID:          "item 1",
+				IDCard:      "card 1",
+				IDChecklist: "checklist 1",
+				Name:        "item",
+				State:       "complete",
+			})
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr)
+			}
+			if !reflect.DeepEqual(tt.expected.checkItem, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected.checkItem, actual)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_DeleteCheckItem(t *testing.T) {
+	var tests = map[string]struct {
+		tsFn     func() *httptest.Server
+		hasError bool
+	}{
+		"happy path": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method != "DELETE" || r.URL.Path != "/cards/card 1/checkItem/item 1" {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+				}))
+			},
+			hasError: false,
+		},
+		"server error": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+			},
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actualErr := repository.DeleteCheckItem("card 1", "item 1")
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.hasError, actualErr)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_FindCustomFields(t *testing.T) {
+	type given struct {
+		tsFn func() *httptest.Server
+	}
+
+	var tests = map[string]struct {
+		given given
+		test  func(actual CustomFields, err error)
+	}{
+		"happy path": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path != "/boards/board 1/customFields" {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`
+[{
+  "id": "custom field 1",
+  "idModel": "board 1",
+  "name": "Priority",
+  "type": "list",
+  "pos": 16384,
+  "options": [{
+    "id": "option 1",
+    "idCustomField": "custom field 1",
+    "value": {"text": "high"},
+    "color": "red",
+    "pos": 16384
+  }]
+}]`))
+					}))
+				},
+			},
+			test: func(actual CustomFields, err error) {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+					t.FailNow()
+				}
+				expected := CustomFields{
+					{
+						ID:      "custom field 1",
+						IDModel: "board 1",
+						Name:    "Priority",
+						Type:    CustomFieldTypeList,
+						Pos:     16384,
+						Options: CustomFieldOptions{
+							{ID: "option 1", IDCustomField: "custom field 1", Value: CustomFieldItemValue{Text: "high"}, Color: "red", Pos: 16384},
+						},
+					},
+				}
+				if !reflect.DeepEqual(expected, actual) {
+					t.Errorf("expected %v, actual %v", expected, actual)
+				}
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+			},
+			test: func(actual CustomFields, err error) {
+				if err == nil {
+					t.Error("expected error")
+				}
+				if actual != nil {
+					t.Error("expected nil custom fields")
 				}
 			},
 		},
@@ -957,7 +2216,456 @@ func TestHttpRepository_FindComment(t *testing.T) {
 					BaseURL: ts.URL,
 				},
 			}, false)
-			tt.test(repository.FindComment("card 1", "comment 1"))
+			tt.test(repository.FindCustomFields("board 1"))
+		})
+	}
+}
+
+func TestHttpRepository_UpdateCustomFieldItem(t *testing.T) {
+	type given struct {
+		tsFn                  func() *httptest.Server
+		updateCustomFieldItem UpdateCustomFieldItem
+	}
+	type expected struct {
+		hasError        bool
+		customFieldItem *CustomFieldItem
+	}
+	idOption := "option 1"
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"set a value": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						reqBody, _ := io.ReadAll(r.Body)
+						if r.Method != "PUT" || r.URL.Path != "/cards/card 1/customField/custom field 1/item" || string(reqBody) != `{"value":{"number":"3"}}` {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`{"id": "item 1", "idCustomField": "custom field 1", "idModel": "card 1", "value": {"number": "3"}}`))
+					}))
+				},
+				updateCustomFieldItem: UpdateCustomFieldItem{
+					IDCard:        "card 1",
+					IDCustomField: "custom field 1",
+					Value:         CustomFieldItemValue{Number: "3"},
+				},
+			},
+			expected: expected{
+				hasError:        false,
+				customFieldItem: &CustomFieldItem{ID: "item 1", IDCustomField: "custom field 1", IDModel: "card 1", Value: &CustomFieldItemValue{Number: "3"}},
+			},
+		},
+		"set an option": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						reqBody, _ := io.ReadAll(r.Body)
+						if r.Method != "PUT" || r.URL.Path != "/cards/card 1/customField/custom field 1/item" || string(reqBody) != `{"idValue":"option 1"}` {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`{"id": "item 1", "idCustomField": "custom field 1", "idModel": "card 1", "idValue": "option 1"}`))
+					}))
+				},
+				updateCustomFieldItem: UpdateCustomFieldItem{
+					IDCard:        "card 1",
+					IDCustomField: "custom field 1",
+					IDValue:       &idOption,
+				},
+			},
+			expected: expected{
+				hasError:        false,
+				customFieldItem: &CustomFieldItem{ID: "item 1", IDCustomField: "custom field 1", IDModel: "card 1", IDValue: "option 1"},
+			},
+		},
+		"clear a value": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						reqBody, _ := io.ReadAll(r.Body)
+						if r.Method != "PUT" || r.URL.Path != "/cards/card 1/customField/custom field 1/item" || string(reqBody) != `{"value":""}` {
+							w.WriteHeader(http.StatusMethodNotAllowed)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`{}`))
+					}))
+				},
+				updateCustomFieldItem: UpdateCustomFieldItem{
+					IDCard:        "card 1",
+					IDCustomField: "custom field 1",
+					Value:         "",
+				},
+			},
+			expected: expected{
+				hasError:        false,
+				customFieldItem: &CustomFieldItem{},
+			},
+		},
+		"server error": {
+			given: given{
+				tsFn: func() *httptest.Server {
+					return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+				},
+				updateCustomFieldItem: UpdateCustomFieldItem{IDCard: "card 1", IDCustomField: "custom field 1", Value: ""},
+			},
+			expected: expected{
+				hasError:        true,
+				customFieldItem: nil,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.given.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.UpdateCustomFieldItem(tt.given.updateCustomFieldItem)
+			if tt.expected.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.expected.hasError, actualErr)
+			}
+			if !reflect.DeepEqual(tt.expected.customFieldItem, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected.customFieldItem, actual)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_FindAttachments(t *testing.T) {
+	var tests = map[string]struct {
+		tsFn     func() *httptest.Server
+		expected Attachments
+		hasError bool
+	}{
+		"happy path": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/cards/card 1/attachments" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`
+[{
+  "id": "attachment 1",
+  "name": "file.pdf",
+  "url": "https://trello.com/file.pdf",
+  "bytes": 2048,
+  "mimeType": "application/pdf",
+  "isUpload": true
+}]`))
+				}))
+			},
+			expected: Attachments{
+				{ID: "attachment 1", Name: "file.pdf", URL: "https://trello.com/file.pdf", Bytes: 2048, MimeType: "application/pdf", IsUpload: true},
+			},
+		},
+		"server error": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+			},
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.FindAttachments("card 1")
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.hasError, actualErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_CreateAttachment(t *testing.T) {
+	var tests = map[string]struct {
+		tsFn     func() *httptest.Server
+		expected *Attachment
+		hasError bool
+	}{
+		"happy path": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method != "POST" || r.URL.Path != "/cards/card 1/attachments" {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					f, header, err := r.FormFile("file")
+					if err != nil {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					content, _ := io.ReadAll(f)
+					if r.FormValue("name") != "file.txt" || header.Filename != "file.txt" || string(content) != "content" {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"id": "attachment 1", "name": "file.txt", "bytes": 7, "isUpload": true}`))
+				}))
+			},
+			expected: &Attachment{ID: "attachment 1", Name: "file.txt", Bytes: 7, IsUpload: true},
+		},
+		"server error": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+			},
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.CreateAttachment(CreateAttachment{
+				IDCard: "card 1",
+				Name:   "file.txt",
+				File:   strings.NewReader("content"),
+			})
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.hasError, actualErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_DownloadAttachment(t *testing.T) {
+	var tests = map[string]struct {
+		isUpload bool
+		tsFn     func() *httptest.Server
+		expected string
+		hasError bool
+	}{
+		"uploaded file": {
+			isUpload: true,
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("Authorization") == "" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte("content"))
+				}))
+			},
+			expected: "content",
+		},
+		"link to an external resource": {
+			isUpload: false,
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("Authorization") != "" {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte("external content"))
+				}))
+			},
+			expected: "external content",
+		},
+		"server error": {
+			isUpload: true,
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+			},
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			buf := bytes.Buffer{}
+			actualErr := repository.DownloadAttachment(Attachment{URL: ts.URL + "/file.pdf", IsUpload: tt.isUpload}, &buf)
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.hasError, actualErr)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestHttpRepository_DeleteAttachment(t *testing.T) {
+	var tests = map[string]struct {
+		tsFn     func() *httptest.Server
+		hasError bool
+	}{
+		"happy path": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method != "DELETE" || r.URL.Path != "/cards/card 1/attachments/attachment 1" {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+				}))
+			},
+			hasError: false,
+		},
+		"server error": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+			},
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actualErr := repository.DeleteAttachment("card 1", "attachment 1")
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.hasError, actualErr)
+			}
+		})
+	}
+}
+
+func TestHttpRepository_FindWebhooks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/tokens/access token/webhooks" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id": "webhook 1", "description": "tcli: board 'board'", "idModel": "board 1", "callbackURL": "https://example.ngrok.io/", "active": true}]`))
+	}))
+	defer ts.Close()
+	repository := NewHttpRepository(conf.Conf{
+		Trello: conf.Trello{
+			BaseURL:     ts.URL,
+			AccessToken: "access token",
+		},
+	}, false)
+
+	actual, err := repository.FindWebhooks()
+	if err != nil {
+		t.Errorf("expected no error, actual %v", err)
+	}
+	expected := Webhooks{{ID: "webhook 1", Description: "tcli: board 'board'", IDModel: "board 1", CallbackURL: "https://example.ngrok.io/", Active: true}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
+func TestHttpRepository_CreateWebhook(t *testing.T) {
+	var tests = map[string]struct {
+		tsFn     func() *httptest.Server
+		expected *Webhook
+		hasError bool
+	}{
+		"happy path": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method != "POST" || r.URL.Path != "/webhooks" {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					var createWebhook CreateWebhook
+					if err := json.NewDecoder(r.Body).Decode(&createWebhook); err != nil || createWebhook.IDModel != "board 1" {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"id": "webhook 1", "description": "tcli: board 'board'", "idModel": "board 1", "callbackURL": "https://example.ngrok.io/", "active": true}`))
+				}))
+			},
+			expected: &Webhook{ID: "webhook 1", Description: "tcli: board 'board'", IDModel: "board 1", CallbackURL: "https://example.ngrok.io/", Active: true},
+		},
+		"callback URL not reachable": {
+			tsFn: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte("URL (https://example.ngrok.io/) did not return 200 status code, got 502"))
+				}))
+			},
+			hasError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := tt.tsFn()
+			repository := NewHttpRepository(conf.Conf{
+				Trello: conf.Trello{
+					BaseURL: ts.URL,
+				},
+			}, false)
+			actual, actualErr := repository.CreateWebhook(CreateWebhook{
+				Description: "tcli: board 'board'",
+				IDModel:     "board 1",
+				CallbackURL: "https://example.ngrok.io/",
+			})
+			if tt.hasError != (actualErr != nil) {
+				t.Errorf("expected err %v, actual err %v", tt.hasError, actualErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
 		})
 	}
 }
+
+func TestHttpRepository_DeleteWebhook(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/webhooks/webhook 1" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+	repository := NewHttpRepository(conf.Conf{
+		Trello: conf.Trello{
+			BaseURL: ts.URL,
+		},
+	}, false)
+
+	if err := repository.DeleteWebhook("webhook 1"); err != nil {
+		t.Errorf("expected no error, actual %v", err)
+	}
+}
@@ -0,0 +1,959 @@
+package trello
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/l-lin/tcli/conf"
+	"github.com/rs/zerolog/log"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	createBoardOperation       = "create board"
+	updateBoardOperation       = "update board"
+	closeBoardOperation        = "close board"
+	createListOperation        = "create list"
+	updateListOperation        = "update list"
+	archiveListOperation       = "archive list"
+	createCardOperation        = "create card"
+	updateCardOperation        = "update card"
+	archiveCardOperation       = "archive card"
+	archiveAllCardsOperation   = "archive all cards"
+	createLabelOperation       = "create label"
+	updateLabelOperation       = "update label"
+	deleteLabelOperation       = "delete label"
+	createChecklistOperation   = "create checklist"
+	updateChecklistOperation   = "update checklist"
+	deleteChecklistOperation   = "delete checklist"
+	createCheckItemOperation   = "create check item"
+	updateCheckItemOperation   = "update check item"
+	deleteCheckItemOperation   = "delete check item"
+	updateCustomFieldOperation = "update custom field"
+	createAttachmentOperation  = "create attachment"
+	deleteAttachmentOperation  = "delete attachment"
+	createCommentOperation     = "create comment"
+	updateCommentOperation     = "update comment"
+	deleteCommentOperation     = "delete comment"
+)
+
+var nothingToUndoErr = errors.New("nothing to undo")
+var nothingToRedoErr = errors.New("nothing to redo")
+var deletedAttachmentErr = errors.New("the content of the attachment is not kept, it cannot be attached again")
+
+// JournalEntries are sorted from the oldest to the most recent mutation
+type JournalEntries []JournalEntry
+
+// JournalEntry is a mutation performed on a Trello resource, with the state of the resource before and after it
+type JournalEntry struct {
+	ID        int              `json:"id"`
+	Date      time.Time        `json:"date"`
+	Operation string           `json:"operation"`
+	Before    *JournalSnapshot `json:"before,omitempty"`
+	After     *JournalSnapshot `json:"after,omitempty"`
+	Undone    bool             `json:"undone"`
+}
+
+// JournalSnapshot is the state of the Trello resource targeted by a mutation
+type JournalSnapshot struct {
+	Board           *Board           `json:"board,omitempty"`
+	Label           *Label           `json:"label,omitempty"`
+	List            *List            `json:"list,omitempty"`
+	Card            *Card            `json:"card,omitempty"`
+	Cards           Cards            `json:"cards,omitempty"`
+	Checklist       *Checklist       `json:"checklist,omitempty"`
+	CheckItem       *CheckItem       `json:"checkItem,omitempty"`
+	CustomFieldItem *CustomFieldItem `json:"customFieldItem,omitempty"`
+	Attachment      *Attachment      `json:"attachment,omitempty"`
+	Comment         *Comment         `json:"comment,omitempty"`
+	// IDCard is the card of the check item or of the attachment, as they do not reference it
+	IDCard string `json:"idCard,omitempty"`
+	// CustomFieldName is the name of the custom field of the custom field item
+	CustomFieldName string `json:"customFieldName,omitempty"`
+}
+
+// Resource returns the name of the resource targeted by the mutation
+func (e JournalEntry) Resource() string {
+	snapshot := e.After
+	if snapshot == nil {
+		snapshot = e.Before
+	}
+	if snapshot == nil {
+		return ""
+	}
+	switch {
+	case snapshot.Board != nil:
+		return fmt.Sprintf("'%s'", snapshot.Board.Name)
+	case snapshot.List != nil:
+		return fmt.Sprintf("'%s'", snapshot.List.Name)
+	case snapshot.Card != nil:
+		return fmt.Sprintf("'%s'", snapshot.Card.Name)
+	case snapshot.Cards != nil:
+		names := make([]string, len(snapshot.Cards))
+		for i, card := range snapshot.Cards {
+			names[i] = fmt.Sprintf("'%s'", card.Name)
+		}
+		return strings.Join(names, ", ")
+	case snapshot.Label != nil:
+		if snapshot.Label.Name == "" {
+			return fmt.Sprintf("'%s'", snapshot.Label.Color)
+		}
+		return fmt.Sprintf("'%s'", snapshot.Label.Name)
+	case snapshot.Checklist != nil:
+		return fmt.Sprintf("'%s'", snapshot.Checklist.Name)
+	case snapshot.CheckItem != nil:
+		return fmt.Sprintf("'%s'", snapshot.CheckItem.Name)
+	case snapshot.CustomFieldItem != nil:
+		return fmt.Sprintf("'%s'", snapshot.CustomFieldName)
+	case snapshot.Attachment != nil:
+		return fmt.Sprintf("'%s'", snapshot.Attachment.Name)
+	case snapshot.Comment != nil:
+		return fmt.Sprintf("'%s'", snapshot.Comment.ID)
+	}
+	return ""
+}
+
+func (e JournalEntry) String() string {
+	resource := e.Resource()
+	if resource == "" {
+		return e.Operation
+	}
+	return fmt.Sprintf("%s %s", e.Operation, resource)
+}
+
+// lastDone returns the index of the next entry to undo, -1 if there is none
+func (entries JournalEntries) lastDone() int {
+	for i := len(entries) - 1; i >= 0; i-- {
+		if !entries[i].Undone {
+			return i
+		}
+	}
+	return -1
+}
+
+// firstUndone returns the index of the next entry to redo, -1 if there is none
+func (entries JournalEntries) firstUndone() int {
+	// the undone entries are always at the end of the journal, the first one being the last undone
+	for i := range entries {
+		if entries[i].Undone {
+			return i
+		}
+	}
+	return -1
+}
+
+// rename replaces the IDs of the resources recreated when undoing or redoing a mutation by their new IDs,
+// so the entries referencing them, e.g. the check items of a restored checklist, can still be undone and redone
+func (entries JournalEntries) rename(renamedIDs map[string]string) error {
+	if len(renamedIDs) == 0 {
+		return nil
+	}
+	for i := range entries {
+		b, err := json.Marshal(entries[i])
+		if err != nil {
+			return err
+		}
+		var raw interface{}
+		if err = json.Unmarshal(b, &raw); err != nil {
+			return err
+		}
+		if b, err = json.Marshal(renameIDs(raw, false, renamedIDs)); err != nil {
+			return err
+		}
+		var entry JournalEntry
+		if err = json.Unmarshal(b, &entry); err != nil {
+			return err
+		}
+		entries[i] = entry
+	}
+	return nil
+}
+
+// renameIDs replaces the renamed IDs in the values of the JSON fields starting with "id", e.g. "id" or "idChecklist"
+func renameIDs(v interface{}, isID bool, renamedIDs map[string]string) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			value[key] = renameIDs(field, strings.HasPrefix(key, "id"), renamedIDs)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = renameIDs(item, isID, renamedIDs)
+		}
+	case string:
+		if newID, ok := renamedIDs[value]; ok && isID {
+			return newID
+		}
+	}
+	return v
+}
+
+// Journal is a decorator that records the mutations performed through the proxified Repository
+// in a file, or in memory if it has no file, so they can be undone and redone afterward.
+// The state of the resources before their mutations are taken from the last time they were fetched.
+type Journal struct {
+	*tracker
+	file string
+	// entries are the journal entries kept in memory when the journal has no file
+	entries JournalEntries
+	size    int
+	now     func() time.Time
+	// renamedIDs are the IDs of the resources recreated by the current undo or redo, with their new IDs
+	renamedIDs map[string]string
+}
+
+// NewJournal creates a Journal stored next to the disk cache, but not purged with it
+func NewJournal(r Repository, c conf.Conf) Repository {
+	size := c.Journal.Size
+	if size < 0 {
+		return r
+	}
+	if size == 0 {
+		size = conf.DefaultJournalSize
+	}
+	return &Journal{
+		tracker: newTracker(r),
+		file:    filepath.Join(cacheDir(c), "journal", account(c)+".json"),
+		size:    size,
+		now:     time.Now,
+	}
+}
+
+// NewJournalInMemory creates a Journal that is lost when tcli exits
+func NewJournalInMemory(r Repository, c conf.Conf) Repository {
+	journal := NewJournal(r, c)
+	if j, ok := journal.(*Journal); ok {
+		j.file = ""
+	}
+	return journal
+}
+
+// WRITE -------------------------------------------------------------------
+
+func (j *Journal) CreateBoard(createBoard CreateBoard) (*Board, error) {
+	board, err := j.Repository.CreateBoard(createBoard)
+	if err == nil && board != nil {
+		j.record(createBoardOperation, nil, &JournalSnapshot{Board: board})
+	}
+	return board, err
+}
+
+func (j *Journal) UpdateBoard(updateBoard UpdateBoard) (*Board, error) {
+	board, err := j.Repository.UpdateBoard(updateBoard)
+	if err == nil && board != nil {
+		j.record(updateBoardOperation, j.boardSnapshot(updateBoard.ID), &JournalSnapshot{Board: board})
+		j.boards[board.ID] = *board
+	}
+	return board, err
+}
+
+func (j *Journal) CloseBoard(idBoard string) error {
+	err := j.Repository.CloseBoard(idBoard)
+	if err == nil {
+		j.record(closeBoardOperation, j.boardSnapshot(idBoard), nil)
+	}
+	return err
+}
+
+func (j *Journal) CreateList(createList CreateList) (*List, error) {
+	list, err := j.Repository.CreateList(createList)
+	if err == nil && list != nil {
+		j.record(createListOperation, nil, &JournalSnapshot{List: list})
+	}
+	return list, err
+}
+
+func (j *Journal) UpdateList(updateList UpdateList) (*List, error) {
+	list, err := j.Repository.UpdateList(updateList)
+	if err == nil && list != nil {
+		j.record(updateListOperation, j.listSnapshot(updateList.ID), &JournalSnapshot{List: list})
+		j.lists[list.ID] = *list
+	}
+	return list, err
+}
+
+func (j *Journal) ArchiveList(idBoard, idList string) error {
+	err := j.Repository.ArchiveList(idBoard, idList)
+	if err == nil {
+		j.record(archiveListOperation, j.listSnapshot(idList), nil)
+	}
+	return err
+}
+
+func (j *Journal) CreateCard(createCard CreateCard) (*Card, error) {
+	card, err := j.Repository.CreateCard(createCard)
+	if err == nil && card != nil {
+		j.record(createCardOperation, nil, &JournalSnapshot{Card: card})
+	}
+	return card, err
+}
+
+func (j *Journal) UpdateCard(updateCard UpdateCard) (*Card, error) {
+	card, err := j.Repository.UpdateCard(updateCard)
+	if err == nil && card != nil {
+		before := j.cardSnapshot(updateCard.ID)
+		operation := updateCardOperation
+		if card.Closed && (before == nil || !before.Card.Closed) {
+			operation = archiveCardOperation
+		}
+		j.record(operation, before, &JournalSnapshot{Card: card})
+		j.cards[card.ID] = *card
+	}
+	return card, err
+}
+
+func (j *Journal) ArchiveAllCards(idList string) error {
+	// the cards are fetched before archiving them, to know which ones to restore
+	cards, findErr := j.Repository.FindCards(idList)
+	err := j.Repository.ArchiveAllCards(idList)
+	if err == nil {
+		if findErr != nil {
+			log.Warn().Err(findErr).Str("idList", idList).Msg("could not fetch the cards to archive, they will not be restorable")
+		} else {
+			j.record(archiveAllCardsOperation, &JournalSnapshot{Cards: cards}, nil)
+		}
+	}
+	return err
+}
+
+func (j *Journal) CreateLabel(createLabel CreateLabel) (*Label, error) {
+	label, err := j.Repository.CreateLabel(createLabel)
+	if err == nil && label != nil {
+		j.record(createLabelOperation, nil, &JournalSnapshot{Label: label})
+		j.labels[label.ID] = *label
+	}
+	return label, err
+}
+
+func (j *Journal) UpdateLabel(updateLabel UpdateLabel) (*Label, error) {
+	label, err := j.Repository.UpdateLabel(updateLabel)
+	if err == nil && label != nil {
+		j.record(updateLabelOperation, j.labelSnapshot(updateLabel.ID), &JournalSnapshot{Label: label})
+		j.labels[label.ID] = *label
+	}
+	return label, err
+}
+
+func (j *Journal) DeleteLabel(idBoard, idLabel string) error {
+	err := j.Repository.DeleteLabel(idBoard, idLabel)
+	if err == nil {
+		j.record(deleteLabelOperation, j.labelSnapshot(idLabel), nil)
+	}
+	return err
+}
+
+func (j *Journal) CreateChecklist(createChecklist CreateChecklist) (*Checklist, error) {
+	checklist, err := j.Repository.CreateChecklist(createChecklist)
+	if err == nil && checklist != nil {
+		after := *checklist
+		after.IDCard = createChecklist.IDCard
+		j.record(createChecklistOperation, nil, &JournalSnapshot{Checklist: &after})
+		j.checklists[after.ID] = after
+	}
+	return checklist, err
+}
+
+func (j *Journal) UpdateChecklist(updateChecklist UpdateChecklist) (*Checklist, error) {
+	checklist, err := j.Repository.UpdateChecklist(updateChecklist)
+	if err == nil {
+		before := j.checklistSnapshot(updateChecklist.ID)
+		after := Checklist{ID: updateChecklist.ID}
+		if before != nil {
+			after = *before.Checklist
+		}
+		after.IDCard = updateChecklist.IDCard
+		after.Name = updateChecklist.Name
+		j.record(updateChecklistOperation, before, &JournalSnapshot{Checklist: &after})
+		j.checklists[after.ID] = after
+	}
+	return checklist, err
+}
+
+func (j *Journal) DeleteChecklist(idCard, idChecklist string) error {
+	err := j.Repository.DeleteChecklist(idCard, idChecklist)
+	if err == nil {
+		j.record(deleteChecklistOperation, j.checklistSnapshot(idChecklist), nil)
+		delete(j.checklists, idChecklist)
+	}
+	return err
+}
+
+func (j *Journal) CreateCheckItem(createCheckItem CreateCheckItem) (*CheckItem, error) {
+	checkItem, err := j.Repository.CreateCheckItem(createCheckItem)
+	if err == nil && checkItem != nil {
+		j.record(createCheckItemOperation, nil, &JournalSnapshot{CheckItem: checkItem, IDCard: createCheckItem.IDCard})
+		j.trackCheckItem(*checkItem)
+	}
+	return checkItem, err
+}
+
+func (j *Journal) UpdateCheckItem(updateCheckItem UpdateCheckItem) (*CheckItem, error) {
+	checkItem, err := j.Repository.UpdateCheckItem(updateCheckItem)
+	if err == nil && checkItem != nil {
+		before := j.checkItemSnapshot(updateCheckItem.IDCard, updateCheckItem.ID)
+		j.record(updateCheckItemOperation, before, &JournalSnapshot{CheckItem: checkItem, IDCard: updateCheckItem.IDCard})
+		j.trackCheckItem(*checkItem)
+	}
+	return checkItem, err
+}
+
+func (j *Journal) DeleteCheckItem(idCard, idCheckItem string) error {
+	err := j.Repository.DeleteCheckItem(idCard, idCheckItem)
+	if err == nil {
+		j.record(deleteCheckItemOperation, j.checkItemSnapshot(idCard, idCheckItem), nil)
+		j.untrackCheckItem(idCheckItem)
+	}
+	return err
+}
+
+func (j *Journal) UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	customFieldItem, err := j.Repository.UpdateCustomFieldItem(updateCustomFieldItem)
+	if err == nil {
+		// the value is taken from the update, as Trello does not return the cleared values
+		after := CustomFieldItem{IDCustomField: updateCustomFieldItem.IDCustomField, IDModel: updateCustomFieldItem.IDCard}
+		if updateCustomFieldItem.IDValue != nil {
+			after.IDValue = *updateCustomFieldItem.IDValue
+		}
+		if value, ok := updateCustomFieldItem.Value.(CustomFieldItemValue); ok {
+			after.Value = &value
+		}
+		before := j.customFieldItemSnapshot(updateCustomFieldItem)
+		j.record(updateCustomFieldOperation, before, &JournalSnapshot{CustomFieldItem: &after, CustomFieldName: updateCustomFieldItem.Name})
+		if card, ok := j.cards[updateCustomFieldItem.IDCard]; ok {
+			card.CustomFieldItems = card.CustomFieldItems.with(after)
+			j.cards[card.ID] = card
+		}
+	}
+	return customFieldItem, err
+}
+
+func (j *Journal) CreateAttachment(createAttachment CreateAttachment) (*Attachment, error) {
+	attachment, err := j.Repository.CreateAttachment(createAttachment)
+	if err == nil && attachment != nil {
+		j.record(createAttachmentOperation, nil, &JournalSnapshot{Attachment: attachment, IDCard: createAttachment.IDCard})
+		j.attachments[attachment.ID] = *attachment
+	}
+	return attachment, err
+}
+
+func (j *Journal) DeleteAttachment(idCard, idAttachment string) error {
+	err := j.Repository.DeleteAttachment(idCard, idAttachment)
+	if err == nil {
+		j.record(deleteAttachmentOperation, j.attachmentSnapshot(idCard, idAttachment), nil)
+	}
+	return err
+}
+
+func (j *Journal) CreateComment(createComment CreateComment) (*Comment, error) {
+	comment, err := j.Repository.CreateComment(createComment)
+	if err == nil && comment != nil {
+		after := *comment
+		after.Data.Card.ID = createComment.IDCard
+		after.Data.Text = createComment.Text
+		j.record(createCommentOperation, nil, &JournalSnapshot{Comment: &after})
+	}
+	return comment, err
+}
+
+func (j *Journal) UpdateComment(updateComment UpdateComment) (*Comment, error) {
+	comment, err := j.Repository.UpdateComment(updateComment)
+	if err == nil {
+		after := Comment{ID: updateComment.ID}
+		if comment != nil {
+			after = *comment
+		}
+		after.Data.Card.ID = updateComment.IDCard
+		after.Data.Text = updateComment.Text
+		j.record(updateCommentOperation, j.commentSnapshot(updateComment.ID), &JournalSnapshot{Comment: &after})
+		j.comments[after.ID] = after
+	}
+	return comment, err
+}
+
+func (j *Journal) DeleteComment(idCard, idComment string) error {
+	err := j.Repository.DeleteComment(idCard, idComment)
+	if err == nil {
+		j.record(deleteCommentOperation, j.commentSnapshot(idComment), nil)
+	}
+	return err
+}
+
+// HISTORY -------------------------------------------------------------------
+
+func (j *Journal) Entries() (JournalEntries, error) {
+	return j.load()
+}
+
+// Undo performs the inverse operation of the last mutation that is not undone yet
+func (j *Journal) Undo() (*JournalEntry, error) {
+	entries, err := j.load()
+	if err != nil {
+		return nil, err
+	}
+	i := entries.lastDone()
+	if i == -1 {
+		return nil, nothingToUndoErr
+	}
+	j.renamedIDs = map[string]string{}
+	if err = j.undo(&entries[i]); err != nil {
+		return nil, fmt.Errorf("could not undo %s: %w", entries[i], err)
+	}
+	entries[i].Undone = true
+	if err = entries.rename(j.renamedIDs); err != nil {
+		return nil, err
+	}
+	return &entries[i], j.save(entries)
+}
+
+// Redo performs again the last undone mutation
+func (j *Journal) Redo() (*JournalEntry, error) {
+	entries, err := j.load()
+	if err != nil {
+		return nil, err
+	}
+	i := entries.firstUndone()
+	if i == -1 {
+		return nil, nothingToRedoErr
+	}
+	j.renamedIDs = map[string]string{}
+	if err = j.redo(&entries[i]); err != nil {
+		return nil, fmt.Errorf("could not redo %s: %w", entries[i], err)
+	}
+	entries[i].Undone = false
+	if err = entries.rename(j.renamedIDs); err != nil {
+		return nil, err
+	}
+	return &entries[i], j.save(entries)
+}
+
+func (j *Journal) undo(entry *JournalEntry) error {
+	before, after := entry.Before, entry.After
+	switch entry.Operation {
+	case createBoardOperation:
+		return j.Repository.CloseBoard(after.Board.ID)
+	case updateBoardOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateBoard(NewUpdateBoard(*before.Board))
+		return err
+	case closeBoardOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		updateBoard := NewUpdateBoard(*before.Board)
+		updateBoard.Closed = false
+		_, err := j.Repository.UpdateBoard(updateBoard)
+		return err
+	case createListOperation:
+		return j.Repository.ArchiveList(after.List.IDBoard, after.List.ID)
+	case updateListOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateList(NewUpdateList(*before.List))
+		return err
+	case archiveListOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		updateList := NewUpdateList(*before.List)
+		updateList.Closed = false
+		_, err := j.Repository.UpdateList(updateList)
+		return err
+	case createCardOperation:
+		// cards cannot be deleted from TCli, so they are archived instead
+		return j.setCardsClosed(Cards{*after.Card}, true)
+	case updateCardOperation, archiveCardOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateCard(NewUpdateCard(*before.Card))
+		return err
+	case archiveAllCardsOperation:
+		return j.setCardsClosed(before.Cards, false)
+	case createLabelOperation:
+		return j.Repository.DeleteLabel(after.Label.IDBoard, after.Label.ID)
+	case updateLabelOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateLabel(newUpdateLabel(*before.Label))
+		return err
+	case deleteLabelOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		// the label is created again, but not added back to its cards
+		return j.restoreLabel(*before.Label)
+	case createChecklistOperation:
+		return j.Repository.DeleteChecklist(after.Checklist.IDCard, after.Checklist.ID)
+	case updateChecklistOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateChecklist(UpdateChecklist{ID: before.Checklist.ID, IDCard: before.Checklist.IDCard, Name: before.Checklist.Name})
+		return err
+	case deleteChecklistOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		return j.restoreChecklist(*before.Checklist)
+	case createCheckItemOperation:
+		return j.Repository.DeleteCheckItem(after.IDCard, after.CheckItem.ID)
+	case updateCheckItemOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateCheckItem(NewUpdateCheckItem(before.IDCard, *before.CheckItem))
+		return err
+	case deleteCheckItemOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		return j.restoreCheckItem(before.IDCard, *before.CheckItem)
+	case updateCustomFieldOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateCustomFieldItem(newUpdateCustomFieldItem(before, after))
+		return err
+	case createAttachmentOperation:
+		return j.Repository.DeleteAttachment(after.IDCard, after.Attachment.ID)
+	case deleteAttachmentOperation:
+		return deletedAttachmentErr
+	case createCommentOperation:
+		return j.Repository.DeleteComment(after.Comment.Data.Card.ID, after.Comment.ID)
+	case updateCommentOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		_, err := j.Repository.UpdateComment(UpdateComment{
+			ID:     before.Comment.ID,
+			IDCard: after.Comment.Data.Card.ID,
+			Text:   before.Comment.Data.Text,
+		})
+		return err
+	case deleteCommentOperation:
+		if before == nil {
+			return unknownPreviousStateErr(entry)
+		}
+		comment, err := j.Repository.CreateComment(CreateComment{IDCard: before.Comment.Data.Card.ID, Text: before.Comment.Data.Text})
+		if err == nil && comment != nil {
+			// the comment is restored with a new ID, which is the one to delete when redoing
+			j.recreated(before.Comment.ID, comment.ID)
+		}
+		return err
+	}
+	return fmt.Errorf("unknown operation '%s'", entry.Operation)
+}
+
+func (j *Journal) redo(entry *JournalEntry) error {
+	before, after := entry.Before, entry.After
+	switch entry.Operation {
+	case createBoardOperation:
+		updateBoard := NewUpdateBoard(*after.Board)
+		updateBoard.Closed = false
+		_, err := j.Repository.UpdateBoard(updateBoard)
+		return err
+	case updateBoardOperation:
+		_, err := j.Repository.UpdateBoard(NewUpdateBoard(*after.Board))
+		return err
+	case closeBoardOperation:
+		return j.Repository.CloseBoard(before.Board.ID)
+	case createListOperation:
+		updateList := NewUpdateList(*after.List)
+		updateList.Closed = false
+		_, err := j.Repository.UpdateList(updateList)
+		return err
+	case updateListOperation:
+		_, err := j.Repository.UpdateList(NewUpdateList(*after.List))
+		return err
+	case archiveListOperation:
+		return j.Repository.ArchiveList(before.List.IDBoard, before.List.ID)
+	case createCardOperation:
+		return j.setCardsClosed(Cards{*after.Card}, false)
+	case updateCardOperation, archiveCardOperation:
+		_, err := j.Repository.UpdateCard(NewUpdateCard(*after.Card))
+		return err
+	case archiveAllCardsOperation:
+		// only archive the cards that were archived, not the ones created since then
+		return j.setCardsClosed(before.Cards, true)
+	case createLabelOperation:
+		return j.restoreLabel(*after.Label)
+	case updateLabelOperation:
+		_, err := j.Repository.UpdateLabel(newUpdateLabel(*after.Label))
+		return err
+	case deleteLabelOperation:
+		return j.Repository.DeleteLabel(before.Label.IDBoard, before.Label.ID)
+	case createChecklistOperation:
+		return j.restoreChecklist(*after.Checklist)
+	case updateChecklistOperation:
+		_, err := j.Repository.UpdateChecklist(UpdateChecklist{ID: after.Checklist.ID, IDCard: after.Checklist.IDCard, Name: after.Checklist.Name})
+		return err
+	case deleteChecklistOperation:
+		return j.Repository.DeleteChecklist(before.Checklist.IDCard, before.Checklist.ID)
+	case createCheckItemOperation:
+		return j.restoreCheckItem(after.IDCard, *after.CheckItem)
+	case updateCheckItemOperation:
+		_, err := j.Repository.UpdateCheckItem(NewUpdateCheckItem(after.IDCard, *after.CheckItem))
+		return err
+	case deleteCheckItemOperation:
+		return j.Repository.DeleteCheckItem(before.IDCard, before.CheckItem.ID)
+	case updateCustomFieldOperation:
+		_, err := j.Repository.UpdateCustomFieldItem(newUpdateCustomFieldItem(after, before))
+		return err
+	case createAttachmentOperation:
+		return deletedAttachmentErr
+	case deleteAttachmentOperation:
+		return j.Repository.DeleteAttachment(before.IDCard, before.Attachment.ID)
+	case createCommentOperation:
+		comment, err := j.Repository.CreateComment(CreateComment{IDCard: after.Comment.Data.Card.ID, Text: after.Comment.Data.Text})
+		if err == nil && comment != nil {
+			j.recreated(after.Comment.ID, comment.ID)
+		}
+		return err
+	case updateCommentOperation:
+		_, err := j.Repository.UpdateComment(UpdateComment{
+			ID:     after.Comment.ID,
+			IDCard: after.Comment.Data.Card.ID,
+			Text:   after.Comment.Data.Text,
+		})
+		return err
+	case deleteCommentOperation:
+		return j.Repository.DeleteComment(before.Comment.Data.Card.ID, before.Comment.ID)
+	}
+	return fmt.Errorf("unknown operation '%s'", entry.Operation)
+}
+
+func (j *Journal) setCardsClosed(cards Cards, closed bool) error {
+	for _, card := range cards {
+		updateCard := NewUpdateCard(card)
+		updateCard.Closed = closed
+		if _, err := j.Repository.UpdateCard(updateCard); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recreated keeps the new ID of the resource created again to undo or redo a mutation
+func (j *Journal) recreated(oldID, newID string) {
+	j.renamedIDs[oldID] = newID
+}
+
+func (j *Journal) restoreLabel(label Label) error {
+	created, err := j.Repository.CreateLabel(CreateLabel{IDBoard: label.IDBoard, Name: label.Name, Color: toNullableString(label.Color)})
+	if err != nil {
+		return err
+	}
+	j.recreated(label.ID, created.ID)
+	return nil
+}
+
+// restoreChecklist creates the checklist again, along with its check items
+func (j *Journal) restoreChecklist(checklist Checklist) error {
+	created, err := j.Repository.CreateChecklist(CreateChecklist{IDCard: checklist.IDCard, Name: checklist.Name, Pos: formatPos(checklist.Pos)})
+	if err != nil {
+		return err
+	}
+	j.recreated(checklist.ID, created.ID)
+	for _, checkItem := range checklist.CheckItems {
+		checkItem.IDChecklist = created.ID
+		if err = j.restoreCheckItem(checklist.IDCard, checkItem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *Journal) restoreCheckItem(idCard string, checkItem CheckItem) error {
+	created, err := j.Repository.CreateCheckItem(CreateCheckItem{
+		IDCard:      idCard,
+		IDChecklist: checkItem.IDChecklist,
+		Name:        checkItem.Name,
+		Checked:     checkItem.IsChecked(),
+		Pos:         formatPos(checkItem.Pos),
+	})
+	if err != nil {
+		return err
+	}
+	j.recreated(checkItem.ID, created.ID)
+	return nil
+}
+
+// formatPos returns the position to create a resource at, the bottom if it is unknown
+func formatPos(pos float64) string {
+	if pos <= 0 {
+		return "bottom"
+	}
+	return strconv.FormatFloat(pos, 'f', -1, 64)
+}
+
+func newUpdateLabel(label Label) UpdateLabel {
+	return UpdateLabel{ID: label.ID, IDBoard: label.IDBoard, Name: label.Name, Color: toNullableString(label.Color)}
+}
+
+// newUpdateCustomFieldItem sets back the value of the custom field item of the given snapshot;
+// the "list" custom fields are the ones with an option set before or after the mutation
+func newUpdateCustomFieldItem(snapshot, other *JournalSnapshot) UpdateCustomFieldItem {
+	item := snapshot.CustomFieldItem
+	u := UpdateCustomFieldItem{IDCard: item.IDModel, IDCustomField: item.IDCustomField, Name: snapshot.CustomFieldName}
+	if item.IDValue != "" || (other != nil && other.CustomFieldItem.IDValue != "") {
+		idValue := item.IDValue
+		u.IDValue = &idValue
+		return u
+	}
+	if item.Value == nil {
+		u.Value = ""
+		return u
+	}
+	u.Value = *item.Value
+	return u
+}
+
+func unknownPreviousStateErr(entry *JournalEntry) error {
+	return errors.New("the state of the resource before the mutation is unknown")
+}
+
+// SNAPSHOTS -------------------------------------------------------------------
+
+func (j *Journal) boardSnapshot(idBoard string) *JournalSnapshot {
+	if board, ok := j.boards[idBoard]; ok {
+		return &JournalSnapshot{Board: &board}
+	}
+	return nil
+}
+
+func (j *Journal) listSnapshot(idList string) *JournalSnapshot {
+	if list, ok := j.lists[idList]; ok {
+		return &JournalSnapshot{List: &list}
+	}
+	return nil
+}
+
+func (j *Journal) cardSnapshot(idCard string) *JournalSnapshot {
+	if card, ok := j.cards[idCard]; ok {
+		return &JournalSnapshot{Card: &card}
+	}
+	return nil
+}
+
+func (j *Journal) labelSnapshot(idLabel string) *JournalSnapshot {
+	if label, ok := j.labels[idLabel]; ok {
+		return &JournalSnapshot{Label: &label}
+	}
+	return nil
+}
+
+func (j *Journal) checklistSnapshot(idChecklist string) *JournalSnapshot {
+	if checklist, ok := j.checklists[idChecklist]; ok {
+		return &JournalSnapshot{Checklist: &checklist}
+	}
+	return nil
+}
+
+func (j *Journal) checkItemSnapshot(idCard, idCheckItem string) *JournalSnapshot {
+	if checkItem := j.findCheckItem(idCheckItem); checkItem != nil {
+		return &JournalSnapshot{CheckItem: checkItem, IDCard: idCard}
+	}
+	return nil
+}
+
+// customFieldItemSnapshot returns the value of the custom field of the card before its update, an item without
+// value if it was not set
+func (j *Journal) customFieldItemSnapshot(updateCustomFieldItem UpdateCustomFieldItem) *JournalSnapshot {
+	card, ok := j.cards[updateCustomFieldItem.IDCard]
+	if !ok {
+		return nil
+	}
+	item := card.CustomFieldItems.Find(updateCustomFieldItem.IDCustomField)
+	if item == nil {
+		item = &CustomFieldItem{IDCustomField: updateCustomFieldItem.IDCustomField, IDModel: card.ID}
+	}
+	return &JournalSnapshot{CustomFieldItem: item, CustomFieldName: updateCustomFieldItem.Name}
+}
+
+func (j *Journal) attachmentSnapshot(idCard, idAttachment string) *JournalSnapshot {
+	if attachment, ok := j.attachments[idAttachment]; ok {
+		return &JournalSnapshot{Attachment: &attachment, IDCard: idCard}
+	}
+	return nil
+}
+
+func (j *Journal) commentSnapshot(idComment string) *JournalSnapshot {
+	if comment, ok := j.comments[idComment]; ok {
+		return &JournalSnapshot{Comment: &comment}
+	}
+	return nil
+}
+
+// FILE -------------------------------------------------------------------
+
+// record adds the mutation in the journal; a failure to do so must not fail the mutation itself
+func (j *Journal) record(operation string, before, after *JournalSnapshot) {
+	entries, err := j.load()
+	if err != nil {
+		log.Warn().Err(err).Str("file", j.file).Msg("could not read the journal, the mutation will not be undoable")
+		return
+	}
+	// a new mutation makes the undone ones impossible to redo
+	lastID := 0
+	done := JournalEntries{}
+	for _, entry := range entries {
+		if !entry.Undone {
+			done = append(done, entry)
+		}
+		if entry.ID > lastID {
+			lastID = entry.ID
+		}
+	}
+	done = append(done, JournalEntry{
+		ID:        lastID + 1,
+		Date:      j.now(),
+		Operation: operation,
+		Before:    before,
+		After:     after,
+	})
+	if len(done) > j.size {
+		done = done[len(done)-j.size:]
+	}
+	if err = j.save(done); err != nil {
+		log.Warn().Err(err).Str("file", j.file).Msg("could not write the journal, the mutation will not be undoable")
+	}
+}
+
+func (j *Journal) load() (JournalEntries, error) {
+	if j.file == "" {
+		return append(JournalEntries{}, j.entries...), nil
+	}
+	b, err := ioutil.ReadFile(j.file)
+	if os.IsNotExist(err) {
+		return JournalEntries{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entries JournalEntries
+	if err = json.Unmarshal(b, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (j *Journal) save(entries JournalEntries) error {
+	if j.file == "" {
+		j.entries = entries
+		return nil
+	}
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	return writeFile(j.file, b)
+}
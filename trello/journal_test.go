@@ -0,0 +1,617 @@
+package trello
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/conf"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestJournal(t *testing.T, tr Repository, size int) *Journal {
+	j := NewJournal(tr, conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}, Journal: conf.Journal{Size: size}}).(*Journal)
+	j.now = func() time.Time {
+		return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	}
+	return j
+}
+
+func TestNewJournal(t *testing.T) {
+	var tests = map[string]struct {
+		given    int
+		expected int
+	}{
+		"default size": {given: 0, expected: conf.DefaultJournalSize},
+		"custom size":  {given: 10, expected: 10},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := NewJournal(nil, conf.Conf{Journal: conf.Journal{Size: tt.given}}).(*Journal)
+			if actual.size != tt.expected {
+				t.Errorf("expected size %v, actual size %v", tt.expected, actual.size)
+			}
+		})
+	}
+
+	t.Run("disabled journal", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		tr := NewMockRepository(ctrl)
+		if actual := NewJournal(tr, conf.Conf{Journal: conf.Journal{Size: -1}}); actual != tr {
+			t.Errorf("expected the repository not to be decorated, actual %v", actual)
+		}
+	})
+}
+
+func TestNewJournalInMemory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		CreateCard(gomock.Any()).
+		Return(&card, nil)
+	dir := t.TempDir()
+	j := NewJournalInMemory(tr, conf.Conf{Cache: conf.Cache{Dir: dir}}).(*Journal)
+
+	// create the card
+	if _, err := j.CreateCard(CreateCard{Name: "card", IDList: "list 1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the entry is kept in memory only
+	entries, err := j.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Operation != createCardOperation {
+		t.Errorf("expected the created card entry, actual %v", entries)
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("expected no file, actual %v", files)
+	}
+
+	// archive it
+	archived := card
+	archived.Closed = true
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(archived)).
+		Return(&archived, nil)
+	if entry, err := j.Undo(); err != nil || !entry.Undone {
+		t.Errorf("expected the entry to be undone, actual %v, %v", entry, err)
+	}
+}
+
+func TestJournal_UpdateCard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1"}
+	movedCard := Card{ID: "card 1", Name: "card", IDList: "list 2"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindCard("list 1", "card").
+		Return(&card, nil)
+	updateCard := NewUpdateCard(card)
+	updateCard.IDList = "list 2"
+	tr.EXPECT().
+		UpdateCard(updateCard).
+		Return(&movedCard, nil)
+	j := newTestJournal(t, tr, 0)
+
+	// move the card
+	if _, err := j.FindCard("list 1", "card"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.UpdateCard(updateCard); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := j.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := JournalEntries{{
+		ID:        1,
+		Date:      j.now(),
+		Operation: updateCardOperation,
+		Before:    &JournalSnapshot{Card: &card},
+		After:     &JournalSnapshot{Card: &movedCard},
+	}}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("expected %v, actual %v", expected, entries)
+	}
+
+	// move it back
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(card)).
+		Return(&card, nil)
+	entry, err := j.Undo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.String() != "update card 'card'" || !entry.Undone {
+		t.Errorf("unexpected undone entry %v", entry)
+	}
+	if _, err = j.Undo(); err != nothingToUndoErr {
+		t.Errorf("expected %v, actual %v", nothingToUndoErr, err)
+	}
+
+	// move it again
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(movedCard)).
+		Return(&movedCard, nil)
+	if entry, err = j.Redo(); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Undone {
+		t.Errorf("unexpected redone entry %v", entry)
+	}
+	if _, err = j.Redo(); err != nothingToRedoErr {
+		t.Errorf("expected %v, actual %v", nothingToRedoErr, err)
+	}
+}
+
+func TestJournal_ArchiveCard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1"}
+	archivedCard := Card{ID: "card 1", Name: "card", IDList: "list 1", Closed: true}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindCards("list 1").
+		Return(Cards{card}, nil)
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(archivedCard)).
+		Return(&archivedCard, nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.FindCards("list 1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.UpdateCard(NewUpdateCard(archivedCard)); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := j.Entries()
+	if len(entries) != 1 || entries[0].String() != "archive card 'card'" {
+		t.Errorf("unexpected entries %v", entries)
+	}
+}
+
+func TestJournal_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	archivedList := List{ID: "list 1", Name: "list", IDBoard: "board 1", Closed: true}
+	restoredList := List{ID: "list 1", Name: "list", IDBoard: "board 1"}
+	archivedCard := Card{ID: "card 1", Name: "card", IDList: "list 1", Closed: true}
+	restoredCard := Card{ID: "card 1", Name: "card", IDList: "list 1"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindArchivedLists("board 1").
+		Return(Lists{archivedList}, nil)
+	tr.EXPECT().
+		UpdateList(NewUpdateList(restoredList)).
+		Return(&restoredList, nil)
+	tr.EXPECT().
+		FindArchivedCards("list 1").
+		Return(Cards{archivedCard}, nil)
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(restoredCard)).
+		Return(&restoredCard, nil)
+	j := newTestJournal(t, tr, 0)
+
+	// restore the list and the card
+	if _, err := j.FindArchivedLists("board 1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.UpdateList(NewUpdateList(restoredList)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.FindArchivedCards("list 1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.UpdateCard(NewUpdateCard(restoredCard)); err != nil {
+		t.Fatal(err)
+	}
+
+	// archive them again
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(archivedCard)).
+		Return(&archivedCard, nil)
+	tr.EXPECT().
+		UpdateList(NewUpdateList(archivedList)).
+		Return(&archivedList, nil)
+	if entry, err := j.Undo(); err != nil || entry.String() != "update card 'card'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+	if entry, err := j.Undo(); err != nil || entry.String() != "update list 'list'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+}
+
+func TestJournal_UpdateCardWithoutPreviousState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	card := Card{ID: "card 1", Name: "card"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(card)).
+		Return(&card, nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.UpdateCard(NewUpdateCard(card)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.Undo(); err == nil {
+		t.Errorf("expected an error as the previous state of the card is unknown")
+	}
+	entries, _ := j.Entries()
+	if len(entries) != 1 || entries[0].Undone {
+		t.Errorf("expected the entry not to be undone, actual %v", entries)
+	}
+}
+
+func TestJournal_ArchiveAllCards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	card1 := Card{ID: "card 1", Name: "card 1", IDList: "list 1"}
+	card2 := Card{ID: "card 2", Name: "card 2", IDList: "list 1"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindCards("list 1").
+		Return(Cards{card1, card2}, nil)
+	tr.EXPECT().
+		ArchiveAllCards("list 1").
+		Return(nil)
+	j := newTestJournal(t, tr, 0)
+
+	if err := j.ArchiveAllCards("list 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the cards are restored one by one
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(card1)).
+		Return(&card1, nil)
+	tr.EXPECT().
+		UpdateCard(NewUpdateCard(card2)).
+		Return(&card2, nil)
+	entry, err := j.Undo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.String() != "archive all cards 'card 1', 'card 2'" {
+		t.Errorf("unexpected undone entry %v", entry)
+	}
+
+	// only the previously archived cards are archived again
+	for _, card := range []Card{card1, card2} {
+		updateCard := NewUpdateCard(card)
+		updateCard.Closed = true
+		tr.EXPECT().
+			UpdateCard(updateCard).
+			Return(&card, nil)
+	}
+	if _, err = j.Redo(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJournal_DeleteComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	comment := Comment{ID: "comment 1", Data: CommentData{Card: CommentDataCard{ID: "card 1"}, Text: "some text"}}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindComment("card 1", "comment 1").
+		Return(&comment, nil)
+	tr.EXPECT().
+		DeleteComment("card 1", "comment 1").
+		Return(nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.FindComment("card 1", "comment 1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.DeleteComment("card 1", "comment 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the comment is restored with a new ID...
+	tr.EXPECT().
+		CreateComment(CreateComment{IDCard: "card 1", Text: "some text"}).
+		Return(&Comment{ID: "comment 2"}, nil)
+	if _, err := j.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	// ...which is the one to delete again
+	tr.EXPECT().
+		DeleteComment("card 1", "comment 2").
+		Return(nil)
+	if _, err := j.Redo(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJournal_CreateCard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		CreateCard(CreateCard{Name: "card", IDList: "list 1"}).
+		Return(&card, nil)
+	tr.EXPECT().
+		UpdateCard(gomock.Any()).
+		Return(nil, errors.New("unexpected error"))
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.CreateCard(CreateCard{Name: "card", IDList: "list 1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.Undo(); err == nil || err.Error() != "could not undo create card 'card': unexpected error" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestJournal_record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tr := NewMockRepository(ctrl)
+	for _, id := range []string{"board 1", "board 2", "board 3", "board 4"} {
+		tr.EXPECT().
+			CreateBoard(CreateBoard{Name: id}).
+			Return(&Board{ID: id, Name: id}, nil)
+	}
+	tr.EXPECT().
+		CloseBoard("board 3").
+		Return(nil)
+	j := newTestJournal(t, tr, 2)
+
+	for _, id := range []string{"board 1", "board 2", "board 3"} {
+		if _, err := j.CreateBoard(CreateBoard{Name: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// only the last entries are kept
+	entries, _ := j.Entries()
+	if len(entries) != 2 || entries[0].ID != 2 || entries[1].ID != 3 {
+		t.Errorf("unexpected entries %v", entries)
+	}
+
+	// a new mutation drops the undone ones
+	if _, err := j.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.CreateBoard(CreateBoard{Name: "board 4"}); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ = j.Entries()
+	if len(entries) != 2 || entries[0].ID != 2 || entries[1].ID != 4 || entries[1].Resource() != "'board 4'" {
+		t.Errorf("unexpected entries %v", entries)
+	}
+	if _, err := j.Redo(); err != nothingToRedoErr {
+		t.Errorf("expected %v, actual %v", nothingToRedoErr, err)
+	}
+}
+
+func TestJournal_DeleteChecklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checklist := Checklist{ID: "checklist 1", Name: "checklist", IDCard: "card 1", Pos: 16384, CheckItems: CheckItems{
+		{ID: "item 1", Name: "item 1", IDChecklist: "checklist 1", State: "complete", Pos: 100},
+		{ID: "item 2", Name: "item 2", IDChecklist: "checklist 1", State: "incomplete", Pos: 200},
+	}}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindChecklists("card 1").
+		Return(Checklists{checklist}, nil)
+	tr.EXPECT().
+		DeleteChecklist("card 1", "checklist 1").
+		Return(nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.FindChecklists("card 1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.DeleteChecklist("card 1", "checklist 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the checklist is created again with its items
+	tr.EXPECT().
+		CreateChecklist(CreateChecklist{IDCard: "card 1", Name: "checklist", Pos: "16384"}).
+		Return(&Checklist{ID: "checklist 2", Name: "checklist", IDCard: "card 1"}, nil)
+	tr.EXPECT().
+		CreateCheckItem(CreateCheckItem{IDCard: "card 1", IDChecklist: "checklist 2", Name: "item 1", Checked: true, Pos: "100"}).
+		Return(&CheckItem{ID: "item 3"}, nil)
+	tr.EXPECT().
+		CreateCheckItem(CreateCheckItem{IDCard: "card 1", IDChecklist: "checklist 2", Name: "item 2", Checked: false, Pos: "200"}).
+		Return(&CheckItem{ID: "item 4"}, nil)
+	entry, err := j.Undo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.String() != "delete checklist 'checklist'" {
+		t.Errorf("unexpected undone entry %v", entry)
+	}
+
+	// the restored checklist is the one deleted again
+	tr.EXPECT().
+		DeleteChecklist("card 1", "checklist 2").
+		Return(nil)
+	if _, err = j.Redo(); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := j.Entries()
+	expected := CheckItems{
+		{ID: "item 3", Name: "item 1", IDChecklist: "checklist 2", State: "complete", Pos: 100},
+		{ID: "item 4", Name: "item 2", IDChecklist: "checklist 2", State: "incomplete", Pos: 200},
+	}
+	if !reflect.DeepEqual(expected, entries[0].Before.Checklist.CheckItems) {
+		t.Errorf("expected the check items to be renamed %v, actual %v", expected, entries[0].Before.Checklist.CheckItems)
+	}
+}
+
+func TestJournal_CreateCheckItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createCheckItem := CreateCheckItem{IDCard: "card 1", IDChecklist: "checklist 1", Name: "item", Pos: "bottom"}
+	checkItem := CheckItem{ID: "item 1", Name: "item", IDChecklist: "checklist 1", State: "incomplete", Pos: 100}
+	updatedCheckItem := CheckItem{ID: "item 1", Name: "item", IDChecklist: "checklist 1", State: "complete", Pos: 100}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindChecklists("card 1").
+		Return(Checklists{{ID: "checklist 1", IDCard: "card 1"}}, nil)
+	tr.EXPECT().
+		CreateCheckItem(createCheckItem).
+		Return(&checkItem, nil)
+	tr.EXPECT().
+		UpdateCheckItem(NewUpdateCheckItem("card 1", updatedCheckItem)).
+		Return(&updatedCheckItem, nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.FindChecklists("card 1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.CreateCheckItem(createCheckItem); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.UpdateCheckItem(NewUpdateCheckItem("card 1", updatedCheckItem)); err != nil {
+		t.Fatal(err)
+	}
+
+	// uncheck the item, then delete it
+	tr.EXPECT().
+		UpdateCheckItem(NewUpdateCheckItem("card 1", checkItem)).
+		Return(&checkItem, nil)
+	tr.EXPECT().
+		DeleteCheckItem("card 1", "item 1").
+		Return(nil)
+	if entry, err := j.Undo(); err != nil || entry.String() != "update check item 'item'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+	if entry, err := j.Undo(); err != nil || entry.String() != "create check item 'item'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+}
+
+func TestJournal_Labels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	red := "red"
+	label := Label{ID: "label 1", IDBoard: "board 1", Name: "bug", Color: "red"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		CreateLabel(CreateLabel{IDBoard: "board 1", Name: "bug", Color: &red}).
+		Return(&label, nil)
+	tr.EXPECT().
+		DeleteLabel("board 1", "label 1").
+		Return(nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.CreateLabel(CreateLabel{IDBoard: "board 1", Name: "bug", Color: &red}); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.DeleteLabel("board 1", "label 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the deleted label is created again, then deleted to undo its creation
+	tr.EXPECT().
+		CreateLabel(CreateLabel{IDBoard: "board 1", Name: "bug", Color: &red}).
+		Return(&Label{ID: "label 2", IDBoard: "board 1", Name: "bug", Color: "red"}, nil)
+	tr.EXPECT().
+		DeleteLabel("board 1", "label 2").
+		Return(nil)
+	if entry, err := j.Undo(); err != nil || entry.String() != "delete label 'bug'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+	if entry, err := j.Undo(); err != nil || entry.String() != "create label 'bug'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+}
+
+func TestJournal_UpdateCustomFieldItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	high, empty := "option high", ""
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1", CustomFieldItems: CustomFieldItems{
+		{ID: "item 1", IDCustomField: "priority", IDModel: "card 1", IDValue: high},
+	}}
+	clearPriority := UpdateCustomFieldItem{IDCard: "card 1", IDCustomField: "priority", Name: "Priority", IDValue: &empty}
+	setEstimate := UpdateCustomFieldItem{IDCard: "card 1", IDCustomField: "estimate", Name: "Estimate", Value: CustomFieldItemValue{Number: "3"}}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		FindCards("list 1").
+		Return(Cards{card}, nil)
+	tr.EXPECT().
+		UpdateCustomFieldItem(clearPriority).
+		Return(&CustomFieldItem{}, nil)
+	tr.EXPECT().
+		UpdateCustomFieldItem(setEstimate).
+		Return(&CustomFieldItem{}, nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.FindCards("list 1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.UpdateCustomFieldItem(clearPriority); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := j.UpdateCustomFieldItem(setEstimate); err != nil {
+		t.Fatal(err)
+	}
+
+	// the estimate is cleared and the priority set back
+	tr.EXPECT().
+		UpdateCustomFieldItem(UpdateCustomFieldItem{IDCard: "card 1", IDCustomField: "estimate", Name: "Estimate", Value: ""}).
+		Return(&CustomFieldItem{}, nil)
+	tr.EXPECT().
+		UpdateCustomFieldItem(UpdateCustomFieldItem{IDCard: "card 1", IDCustomField: "priority", Name: "Priority", IDValue: &high}).
+		Return(&CustomFieldItem{}, nil)
+	if entry, err := j.Undo(); err != nil || entry.String() != "update custom field 'Estimate'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+	if entry, err := j.Undo(); err != nil || entry.String() != "update custom field 'Priority'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+}
+
+func TestJournal_Attachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachment := Attachment{ID: "attachment 1", Name: "notes.md"}
+	tr := NewMockRepository(ctrl)
+	tr.EXPECT().
+		CreateAttachment(gomock.Any()).
+		Return(&attachment, nil)
+	tr.EXPECT().
+		DeleteAttachment("card 1", "attachment 1").
+		Return(nil)
+	j := newTestJournal(t, tr, 0)
+
+	if _, err := j.CreateAttachment(CreateAttachment{IDCard: "card 1", Name: "notes.md"}); err != nil {
+		t.Fatal(err)
+	}
+	if entry, err := j.Undo(); err != nil || entry.String() != "create attachment 'notes.md'" {
+		t.Errorf("unexpected undone entry %v, err %v", entry, err)
+	}
+	if _, err := j.Redo(); !errors.Is(err, deletedAttachmentErr) {
+		t.Errorf("expected %v, actual %v", deletedAttachmentErr, err)
+	}
+}
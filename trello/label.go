@@ -32,6 +32,9 @@ var LabelFilterByTCliColor = func(s string, l Label) bool {
 var LabelFilterByColor = func(s string, l Label) bool {
 	return s == l.Color
 }
+var LabelFilterByName = func(s string, l Label) bool {
+	return l.Name != "" && s == l.Name
+}
 var LabelFilterOr = func(filters ...LabelFilter) LabelFilter {
 	return func(s string, l Label) bool {
 		for _, filter := range filters {
@@ -56,6 +59,24 @@ func (l Labels) FilterBy(labels []string, filter LabelFilter) Labels {
 	return filtered
 }
 
+// Missing returns the given labels that do not match any label
+func (l Labels) Missing(labels []string, filter LabelFilter) []string {
+	var missing []string
+	for _, s := range labels {
+		found := false
+		for _, label := range l {
+			if filter(s, label) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
 func (l Labels) IDLabelsInString() string {
 	var idLabels []string
 	for _, label := range l {
@@ -79,9 +100,80 @@ type Label struct {
 	Color   string `json:"color"`
 }
 
+// LabelColors are the colors supported by Trello for the labels
+var LabelColors = []string{"green", "yellow", "orange", "red", "purple", "blue", "sky", "lime", "pink", "black"}
+
+// IsLabelColor checks if the given color is supported by Trello
+func IsLabelColor(color string) bool {
+	for _, c := range LabelColors {
+		if c == color {
+			return true
+		}
+	}
+	return false
+}
+
 func (l Label) ToTCliColor() string {
 	if l.Name == "" {
 		return l.Color
 	}
 	return fmt.Sprintf("%s [%s]", l.Color, l.Name)
 }
+
+// CreateLabel represents the resource used to create a new label in a board
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-labels/#api-labels-post for more info
+type CreateLabel struct {
+	IDBoard string  `json:"idBoard"`
+	Name    string  `json:"name"`
+	Color   *string `json:"color"` // nil to create a label without color
+}
+
+// NewCreateLabel creates the label to create from its TCli representation,
+// i.e. "color", "color [name]" or simply "name" for a label without color
+func NewCreateLabel(idBoard, tcliColor string) CreateLabel {
+	color, name, _ := parseTCliColor(tcliColor)
+	return CreateLabel{
+		IDBoard: idBoard,
+		Name:    name,
+		Color:   toNullableString(color),
+	}
+}
+
+// UpdateLabel represents the resource used to update a label
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-labels/#api-labels-id-put for more info
+type UpdateLabel struct {
+	ID      string  `json:"id"`
+	IDBoard string  `json:"idBoard"`
+	Name    string  `json:"name"`
+	Color   *string `json:"color"` // nil to remove the color of the label
+}
+
+// NewUpdateLabel creates the label to update from its new TCli representation:
+// "color" only changes its color, "name" only renames it and "color [name]" changes both
+func NewUpdateLabel(label Label, tcliColor string) UpdateLabel {
+	updateLabel := UpdateLabel{
+		ID:      label.ID,
+		IDBoard: label.IDBoard,
+		Name:    label.Name,
+		Color:   toNullableString(label.Color),
+	}
+	color, name, hasName := parseTCliColor(tcliColor)
+	if color != "" {
+		updateLabel.Color = &color
+	}
+	if hasName {
+		updateLabel.Name = name
+	}
+	return updateLabel
+}
+
+func parseTCliColor(tcliColor string) (color, name string, hasName bool) {
+	tcliColor = strings.TrimSpace(tcliColor)
+	if IsLabelColor(tcliColor) {
+		return tcliColor, "", false
+	}
+	if i := strings.Index(tcliColor, " ["); i != -1 && strings.HasSuffix(tcliColor, "]") && IsLabelColor(tcliColor[:i]) {
+		return tcliColor[:i], tcliColor[i+2 : len(tcliColor)-1], true
+	}
+	return "", tcliColor, true
+}
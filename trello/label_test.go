@@ -207,3 +207,108 @@ func TestLabels_FilterBy_Then_IDLabelsInString(t *testing.T) {
 		})
 	}
 }
+
+func TestLabels_Missing(t *testing.T) {
+	labels := Labels{
+		{ID: "id red", Color: "red", Name: "bug"},
+		{ID: "id green", Color: "green"},
+	}
+	var tests = map[string]struct {
+		given    []string
+		expected []string
+	}{
+		"all labels exist": {
+			given:    []string{"id red", "red [bug]", "bug", "green"},
+			expected: nil,
+		},
+		"some labels are missing": {
+			given:    []string{"bug", "feature", "sky [idea]", "green [name]"},
+			expected: []string{"feature", "sky [idea]", "green [name]"},
+		},
+		"no label": {
+			given:    []string{},
+			expected: nil,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := labels.Missing(tt.given, LabelFilterOr(
+				LabelFilterByID,
+				LabelFilterByTCliColor,
+				LabelFilterByColor,
+				LabelFilterByName,
+			))
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewCreateLabel(t *testing.T) {
+	red := "red"
+	var tests = map[string]struct {
+		given    string
+		expected CreateLabel
+	}{
+		"color only": {
+			given:    "red",
+			expected: CreateLabel{IDBoard: "board", Color: &red},
+		},
+		"color and name": {
+			given:    "red [urgent bug]",
+			expected: CreateLabel{IDBoard: "board", Name: "urgent bug", Color: &red},
+		},
+		"name only": {
+			given:    "urgent bug",
+			expected: CreateLabel{IDBoard: "board", Name: "urgent bug"},
+		},
+		"unknown color": {
+			given:    "magenta [bug]",
+			expected: CreateLabel{IDBoard: "board", Name: "magenta [bug]"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := NewCreateLabel("board", tt.given)
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewUpdateLabel(t *testing.T) {
+	red := "red"
+	green := "green"
+	label := Label{ID: "label 1", IDBoard: "board", Color: "red", Name: "bug"}
+	var tests = map[string]struct {
+		given    string
+		expected UpdateLabel
+	}{
+		"recolor": {
+			given:    "green",
+			expected: UpdateLabel{ID: "label 1", IDBoard: "board", Name: "bug", Color: &green},
+		},
+		"rename": {
+			given:    "defect",
+			expected: UpdateLabel{ID: "label 1", IDBoard: "board", Name: "defect", Color: &red},
+		},
+		"recolor and rename": {
+			given:    "green [feature]",
+			expected: UpdateLabel{ID: "label 1", IDBoard: "board", Name: "feature", Color: &green},
+		},
+		"remove name": {
+			given:    "red []",
+			expected: UpdateLabel{ID: "label 1", IDBoard: "board", Name: "", Color: &red},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := NewUpdateLabel(label, tt.given)
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
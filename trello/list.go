@@ -1,5 +1,7 @@
 package trello
 
+import "strconv"
+
 func FindList(lists Lists, query string) *List {
 	sanitizedQuery := sanitize(query)
 	for _, list := range lists {
@@ -14,10 +16,24 @@ func FindList(lists Lists, query string) *List {
 }
 
 type Lists []List
+
+// without returns the lists except the one with the given ID
+func (l Lists) without(idList string) Lists {
+	filtered := Lists{}
+	for _, list := range l {
+		if list.ID != idList {
+			filtered = append(filtered, list)
+		}
+	}
+	return filtered
+}
+
 type List struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	IDBoard string `json:"idBoard"`
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	IDBoard string  `json:"idBoard"`
+	Closed  bool    `json:"closed"`
+	Pos     float64 `json:"pos"`
 }
 
 func (l List) TCliID() string {
@@ -27,3 +43,56 @@ func (l List) TCliID() string {
 func (l List) SanitizedName() string {
 	return sanitize(l.Name)
 }
+
+// LIST CREATION ---------------------------------------------------------------------------------------
+
+// CreateList represents the resources used to create a new list
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-lists/#api-lists-post for more info
+type CreateList struct {
+	Name    string      `json:"name"`
+	IDBoard string      `json:"idBoard"`
+	Pos     interface{} `json:"pos,omitempty"` // "top", "bottom" or a positive float
+}
+
+// LIST UPDATE ---------------------------------------------------------------------------------------
+
+// UpdateList represents the resources used to update a list
+// See https://developer.atlassian.com/cloud/trello/rest/api-group-lists/#api-lists-id-put for more info
+type UpdateList struct {
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	IDBoard string      `json:"idBoard"`
+	Closed  bool        `json:"closed"`
+	Pos     interface{} `json:"pos,omitempty"` // "top", "bottom" or a positive float
+}
+
+func NewUpdateList(list List) UpdateList {
+	return UpdateList{
+		ID:      list.ID,
+		Name:    list.Name,
+		IDBoard: list.IDBoard,
+		Closed:  list.Closed,
+		Pos:     list.Pos,
+	}
+}
+
+func NewListToEdit(list List) ListToEdit {
+	return ListToEdit{
+		Name:   list.Name,
+		Closed: list.Closed,
+		Pos:    strconv.FormatFloat(list.Pos, 'f', -1, 64),
+	}
+}
+
+// ListToEdit is the representation used in the list edition
+// it's different from the other list representation because we do not want to expose everything to the user
+// like for instance, the list ID
+type ListToEdit struct {
+	Name   string `yaml:"name"          toml:"name"`
+	Closed bool   `yaml:"closed"        toml:"closed"`
+	Pos    string `yaml:"pos,omitempty" toml:"pos,omitempty"` // "top", "bottom" or a positive float
+}
+
+func (lte ListToEdit) GetPos() interface{} {
+	return getPos(lte.Pos)
+}
@@ -85,3 +85,27 @@ func TestList_SanitizedName(t *testing.T) {
 		})
 	}
 }
+
+func TestNewListToEdit(t *testing.T) {
+	var tests = map[string]struct {
+		given    List
+		expected float64
+	}{
+		"integer position": {
+			given:    List{Name: "list", Pos: 65536},
+			expected: 65536,
+		},
+		"position with more than two decimals": {
+			given:    List{Name: "list", Pos: 12288.0078125},
+			expected: 12288.0078125,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := NewListToEdit(tt.given).GetPos()
+			if actual != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package trello
+
+import "strings"
+
+type Members []Member
+
+// FilterBy returns the members matching the given usernames or IDs
+func (m Members) FilterBy(usernamesOrIDs []string) Members {
+	filtered := Members{}
+	for _, s := range usernamesOrIDs {
+		for _, member := range m {
+			if s == member.Username || s == member.ID {
+				filtered = append(filtered, member)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func (m Members) IDMembersInString() string {
+	var idMembers []string
+	for _, member := range m {
+		idMembers = append(idMembers, member.ID)
+	}
+	return strings.Join(idMembers, ",")
+}
+
+// ToUsernames returns the usernames of the given member IDs
+// the ID is kept if no member is found
+func (m Members) ToUsernames(idMembers []string) []string {
+	usernames := make([]string, len(idMembers))
+	for i, idMember := range idMembers {
+		usernames[i] = idMember
+		for _, member := range m {
+			if member.ID == idMember {
+				usernames[i] = member.Username
+				break
+			}
+		}
+	}
+	return usernames
+}
+
+type Member struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
@@ -0,0 +1,51 @@
+package trello
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMembers_FilterBy(t *testing.T) {
+	members := Members{
+		{ID: "member 1", Username: "member1"},
+		{ID: "member 2", Username: "member2"},
+		{ID: "member 3", Username: "member3"},
+	}
+	var tests = map[string]struct {
+		given    []string
+		expected Members
+	}{
+		"by username": {
+			given:    []string{"member1", "member3"},
+			expected: Members{members[0], members[2]},
+		},
+		"by ID": {
+			given:    []string{"member 2"},
+			expected: Members{members[1]},
+		},
+		"unknown member": {
+			given:    []string{"unknown"},
+			expected: Members{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := members.FilterBy(tt.given)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMembers_ToUsernames(t *testing.T) {
+	members := Members{
+		{ID: "member 1", Username: "member1"},
+		{ID: "member 2", Username: "member2"},
+	}
+	actual := members.ToUsernames([]string{"member 2", "unknown"})
+	expected := []string{"member2", "unknown"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
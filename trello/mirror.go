@@ -0,0 +1,432 @@
+package trello
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/l-lin/tcli/conf"
+	"github.com/rs/zerolog/log"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// mirrorStore keeps on disk the last known state of the Trello resources, without expiration,
+// so they can be browsed offline
+type mirrorStore struct {
+	dir string
+}
+
+// cardListMirrorKind is the kind of the index of the list of each mirrored card, stored in a file per card
+// so the lists fetched concurrently, e.g. when prefetching a board, never write the same file
+const cardListMirrorKind = "cardList"
+
+// newMirrorStore creates a mirrorStore stored next to the disk cache, but not purged with it
+func newMirrorStore(c conf.Conf) mirrorStore {
+	return mirrorStore{dir: filepath.Join(cacheDir(c), "mirror", account(c))}
+}
+
+// path of the mirror file of the given kind, the boards being stored in a single file
+// whereas the other resources are stored in a file per parent ID
+func (s mirrorStore) path(kind, id string) string {
+	if id == "" {
+		return filepath.Join(s.dir, kind+".json")
+	}
+	return filepath.Join(s.dir, kind, url.PathEscape(id)+".json")
+}
+
+// read the mirrored resources in v, returning false if they were never mirrored
+func (s mirrorStore) read(kind, id string, v interface{}) bool {
+	b, err := ioutil.ReadFile(s.path(kind, id))
+	if err != nil {
+		return false
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		log.Debug().Err(err).Str("kind", kind).Str("id", id).Msg("could not read mirror entry")
+		return false
+	}
+	return true
+}
+
+func (s mirrorStore) write(kind, id string, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Debug().Err(err).Str("kind", kind).Str("id", id).Msg("could not marshal mirror entry")
+		return
+	}
+	p := s.path(kind, id)
+	if err = writeFile(p, b); err != nil {
+		log.Debug().Err(err).Str("path", p).Msg("could not write mirror entry")
+	}
+}
+
+func (s mirrorStore) remove(kind, id string) {
+	if err := os.Remove(s.path(kind, id)); err != nil && !os.IsNotExist(err) {
+		log.Debug().Err(err).Str("kind", kind).Str("id", id).Msg("could not remove mirror entry")
+	}
+}
+
+// upsertList replaces the list in the mirrored lists of its board, removing it if it is archived
+func (s mirrorStore) upsertList(list List) {
+	var lists Lists
+	if !s.read(listsCacheKind, list.IDBoard, &lists) {
+		// the lists of the board are mirrored when they are fetched
+		return
+	}
+	lists = lists.without(list.ID)
+	if !list.Closed {
+		lists = append(lists, list)
+	}
+	s.write(listsCacheKind, list.IDBoard, lists)
+}
+
+func (s mirrorStore) removeList(idBoard, idList string) {
+	var lists Lists
+	if s.read(listsCacheKind, idBoard, &lists) {
+		s.write(listsCacheKind, idBoard, lists.without(idList))
+	}
+	s.writeCards(idList, Cards{})
+	s.remove(cardsCacheKind, idList)
+}
+
+// writeCards mirrors the cards of the list, and indexes the list of each card,
+// removing from the index the cards that are no longer in the list
+func (s mirrorStore) writeCards(idList string, cards Cards) {
+	var previous Cards
+	s.read(cardsCacheKind, idList, &previous)
+	s.write(cardsCacheKind, idList, cards)
+	kept := map[string]bool{}
+	for _, card := range cards {
+		kept[card.ID] = true
+		if id, ok := s.cardList(card.ID); !ok || id != idList {
+			s.write(cardListMirrorKind, card.ID, idList)
+		}
+	}
+	for _, card := range previous {
+		if kept[card.ID] {
+			continue
+		}
+		// the card may have been moved to a list fetched in the meantime
+		if id, ok := s.cardList(card.ID); ok && id == idList {
+			s.remove(cardListMirrorKind, card.ID)
+		}
+	}
+}
+
+// cardList returns the ID of the list of the mirrored card, so it is looked for in the mirrored cards of its list only
+func (s mirrorStore) cardList(idCard string) (string, bool) {
+	var idList string
+	return idList, s.read(cardListMirrorKind, idCard, &idList)
+}
+
+// upsertCard replaces the card in the mirrored cards of its list, removing it from its previous list
+// as it may have been moved, and removing it altogether if it is archived
+func (s mirrorStore) upsertCard(card Card) {
+	if idList, ok := s.cardList(card.ID); ok && idList != card.IDList {
+		s.removeCard(card.ID)
+	}
+	var cards Cards
+	if !s.read(cardsCacheKind, card.IDList, &cards) {
+		// the cards of the list are mirrored when they are fetched
+		return
+	}
+	cards = cards.without(card.ID)
+	if !card.Closed {
+		cards = append(cards, card)
+	}
+	s.writeCards(card.IDList, cards)
+}
+
+// removeCard removes the card from the mirrored cards of its list
+func (s mirrorStore) removeCard(idCard string) {
+	idList, ok := s.cardList(idCard)
+	if !ok {
+		return
+	}
+	var cards Cards
+	if s.read(cardsCacheKind, idList, &cards) {
+		s.writeCards(idList, cards.without(idCard))
+	}
+}
+
+// findList finds the mirrored list with the given ID among the mirrored boards
+func (s mirrorStore) findList(idList string) *List {
+	files, _ := filepath.Glob(filepath.Join(s.dir, listsCacheKind, "*.json"))
+	for _, file := range files {
+		idBoard, err := url.PathUnescape(strings.TrimSuffix(filepath.Base(file), ".json"))
+		if err != nil {
+			continue
+		}
+		var lists Lists
+		if s.read(listsCacheKind, idBoard, &lists) {
+			for _, list := range lists {
+				if list.ID == idList {
+					return &list
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findCard finds the mirrored card with the given ID in the mirrored cards of its list
+func (s mirrorStore) findCard(idCard string) *Card {
+	idList, ok := s.cardList(idCard)
+	if !ok {
+		return nil
+	}
+	var cards Cards
+	if !s.read(cardsCacheKind, idList, &cards) {
+		return nil
+	}
+	return findCardByID(cards, idCard)
+}
+
+// upsertComment replaces the comment in the mirrored comments of its card, the most recent first like Trello
+func (s mirrorStore) upsertComment(comment Comment) {
+	var comments Comments
+	if !s.read(commentsCacheKind, comment.Data.Card.ID, &comments) {
+		return
+	}
+	s.write(commentsCacheKind, comment.Data.Card.ID, append(Comments{comment}, comments.without(comment.ID)...))
+}
+
+func (s mirrorStore) removeComment(idCard, idComment string) {
+	var comments Comments
+	if s.read(commentsCacheKind, idCard, &comments) {
+		s.write(commentsCacheKind, idCard, comments.without(idComment))
+	}
+}
+
+// Mirror is a decorator that keeps on disk the resources fetched from the proxified Repository,
+// without expiration, so they can be browsed with Offline when Trello cannot be reached.
+// The lists, cards and comments mutated through the Mirror are updated in it,
+// the other resources being updated the next time they are fetched.
+type Mirror struct {
+	Repository
+	store mirrorStore
+}
+
+func NewMirror(r Repository, c conf.Conf) Repository {
+	return &Mirror{Repository: r, store: newMirrorStore(c)}
+}
+
+func (m *Mirror) Prefetch(idBoard string) error {
+	_, err := fetchBoardContent(m, idBoard)
+	return err
+}
+
+func (m *Mirror) FindBoards() (Boards, error) {
+	boards, err := m.Repository.FindBoards()
+	if err == nil {
+		m.store.write(boardsCacheKind, "", boards)
+	}
+	return boards, err
+}
+
+func (m *Mirror) FindBoard(query string) (*Board, error) {
+	boards, err := m.FindBoards()
+	if err != nil {
+		return nil, err
+	}
+	if board := FindBoard(boards, query); board != nil {
+		return board, nil
+	}
+	return nil, fmt.Errorf("no board found with query %s", query)
+}
+
+func (m *Mirror) FindLabels(idBoard string) (Labels, error) {
+	labels, err := m.Repository.FindLabels(idBoard)
+	if err == nil {
+		m.store.write(labelsCacheKind, idBoard, labels)
+	}
+	return labels, err
+}
+
+func (m *Mirror) FindMembers(idBoard string) (Members, error) {
+	members, err := m.Repository.FindMembers(idBoard)
+	if err == nil {
+		m.store.write(membersCacheKind, idBoard, members)
+	}
+	return members, err
+}
+
+func (m *Mirror) FindCurrentMember() (*Member, error) {
+	member, err := m.Repository.FindCurrentMember()
+	if err == nil {
+		m.store.write(membersCacheKind, currentMemberCacheID, member)
+	}
+	return member, err
+}
+
+func (m *Mirror) FindLists(idBoard string) (Lists, error) {
+	lists, err := m.Repository.FindLists(idBoard)
+	if err == nil {
+		m.store.write(listsCacheKind, idBoard, lists)
+	}
+	return lists, err
+}
+
+func (m *Mirror) FindList(idBoard string, query string) (*List, error) {
+	lists, err := m.FindLists(idBoard)
+	if err != nil {
+		return nil, err
+	}
+	if list := FindList(lists, query); list != nil {
+		return list, nil
+	}
+	return nil, fmt.Errorf("no list found with query %s", query)
+}
+
+func (m *Mirror) CreateList(createList CreateList) (*List, error) {
+	list, err := m.Repository.CreateList(createList)
+	if err == nil && list != nil {
+		m.store.upsertList(*list)
+	}
+	return list, err
+}
+
+func (m *Mirror) UpdateList(updateList UpdateList) (*List, error) {
+	list, err := m.Repository.UpdateList(updateList)
+	if err == nil && list != nil {
+		m.store.upsertList(*list)
+	}
+	return list, err
+}
+
+func (m *Mirror) ArchiveList(idBoard, idList string) error {
+	err := m.Repository.ArchiveList(idBoard, idList)
+	if err == nil {
+		m.store.removeList(idBoard, idList)
+	}
+	return err
+}
+
+func (m *Mirror) FindCards(idList string) (Cards, error) {
+	cards, err := m.Repository.FindCards(idList)
+	if err == nil {
+		m.store.writeCards(idList, cards)
+	}
+	return cards, err
+}
+
+func (m *Mirror) FindCard(idList string, query string) (*Card, error) {
+	cards, err := m.FindCards(idList)
+	if err != nil {
+		return nil, err
+	}
+	if card := FindCard(cards, query); card != nil {
+		return card, nil
+	}
+	return nil, fmt.Errorf("no card found with query %s", query)
+}
+
+func (m *Mirror) ArchiveAllCards(idList string) error {
+	err := m.Repository.ArchiveAllCards(idList)
+	if err == nil {
+		m.store.writeCards(idList, Cards{})
+	}
+	return err
+}
+
+func (m *Mirror) CreateCard(createCard CreateCard) (*Card, error) {
+	card, err := m.Repository.CreateCard(createCard)
+	if err == nil && card != nil {
+		m.store.upsertCard(*card)
+	}
+	return card, err
+}
+
+func (m *Mirror) UpdateCard(updateCard UpdateCard) (*Card, error) {
+	card, err := m.Repository.UpdateCard(updateCard)
+	if err == nil && card != nil {
+		mirroredCard := *card
+		if before := m.store.findCard(card.ID); before != nil && mirroredCard.CustomFieldItems == nil {
+			// the values of the custom fields are not returned by Trello when updating the card
+			mirroredCard.CustomFieldItems = before.CustomFieldItems
+		}
+		m.store.upsertCard(mirroredCard)
+	}
+	return card, err
+}
+
+func (m *Mirror) FindChecklists(idCard string) (Checklists, error) {
+	checklists, err := m.Repository.FindChecklists(idCard)
+	if err == nil {
+		m.store.write(checklistsCacheKind, idCard, checklists)
+	}
+	return checklists, err
+}
+
+func (m *Mirror) FindAttachments(idCard string) (Attachments, error) {
+	attachments, err := m.Repository.FindAttachments(idCard)
+	if err == nil {
+		m.store.write(attachmentsCacheKind, idCard, attachments)
+	}
+	return attachments, err
+}
+
+func (m *Mirror) FindCustomFields(idBoard string) (CustomFields, error) {
+	customFields, err := m.Repository.FindCustomFields(idBoard)
+	if err == nil {
+		m.store.write(customFieldsCacheKind, idBoard, customFields)
+	}
+	return customFields, err
+}
+
+func (m *Mirror) UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	customFieldItem, err := m.Repository.UpdateCustomFieldItem(updateCustomFieldItem)
+	if err == nil && customFieldItem != nil {
+		if card := m.store.findCard(updateCustomFieldItem.IDCard); card != nil {
+			item := *customFieldItem
+			item.IDCustomField = updateCustomFieldItem.IDCustomField
+			card.CustomFieldItems = card.CustomFieldItems.with(item)
+			m.store.upsertCard(*card)
+		}
+	}
+	return customFieldItem, err
+}
+
+func (m *Mirror) FindComments(idCard string) (Comments, error) {
+	comments, err := m.Repository.FindComments(idCard)
+	if err == nil {
+		m.store.write(commentsCacheKind, idCard, comments)
+	}
+	return comments, err
+}
+
+func (m *Mirror) FindComment(idCard string, idComment string) (*Comment, error) {
+	comments, err := m.FindComments(idCard)
+	if err != nil {
+		return nil, err
+	}
+	if comment := FindComment(comments, idComment); comment != nil {
+		return comment, nil
+	}
+	return nil, fmt.Errorf("no comment found with id %s", idComment)
+}
+
+func (m *Mirror) CreateComment(createComment CreateComment) (*Comment, error) {
+	comment, err := m.Repository.CreateComment(createComment)
+	if err == nil && comment != nil {
+		m.store.upsertComment(*comment)
+	}
+	return comment, err
+}
+
+func (m *Mirror) UpdateComment(updateComment UpdateComment) (*Comment, error) {
+	comment, err := m.Repository.UpdateComment(updateComment)
+	if err == nil && comment != nil {
+		m.store.upsertComment(*comment)
+	}
+	return comment, err
+}
+
+func (m *Mirror) DeleteComment(idCard, idComment string) error {
+	err := m.Repository.DeleteComment(idCard, idComment)
+	if err == nil {
+		m.store.removeComment(idCard, idComment)
+	}
+	return err
+}
@@ -0,0 +1,185 @@
+package trello
+
+import (
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/l-lin/tcli/conf"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMirror(t *testing.T) {
+	boards := Boards{{ID: "board 1", Name: "board"}}
+	lists := Lists{{ID: "list 1", Name: "list", IDBoard: "board 1"}}
+	cards := Cards{
+		{ID: "card 1", Name: "card", IDList: "list 1", IDBoard: "board 1", Labels: Labels{}},
+		{ID: "card 2", Name: "another card", IDList: "list 1", IDBoard: "board 1", Labels: Labels{}},
+	}
+
+	t.Run("fetched resources can be read offline", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().FindBoards().Return(boards, nil)
+		r.EXPECT().FindLists("board 1").Return(lists, nil)
+		r.EXPECT().FindCards("list 1").Return(cards, nil)
+		c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+		m := NewMirror(r, c)
+		_, _ = m.FindBoards()
+		_, _ = m.FindLists("board 1")
+		_, _ = m.FindCards("list 1")
+
+		// WHEN
+		offline := NewOffline(c)
+		actualBoards, err1 := offline.FindBoards()
+		actualLists, err2 := offline.FindLists("board 1")
+		actualCard, err3 := offline.FindCard("list 1", "another card")
+
+		// THEN
+		if err1 != nil || err2 != nil || err3 != nil {
+			t.Errorf("expected no error, actual %v, %v, %v", err1, err2, err3)
+		}
+		if !reflect.DeepEqual(boards, actualBoards) {
+			t.Errorf("expected %v, actual %v", boards, actualBoards)
+		}
+		if !reflect.DeepEqual(lists, actualLists) {
+			t.Errorf("expected %v, actual %v", lists, actualLists)
+		}
+		if actualCard == nil || !reflect.DeepEqual(cards[1], *actualCard) {
+			t.Errorf("expected %v, actual %v", cards[1], actualCard)
+		}
+	})
+	t.Run("moved card", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		moved := cards[0]
+		moved.IDList = "list 2"
+		r.EXPECT().FindCards("list 1").Return(cards, nil)
+		r.EXPECT().FindCards("list 2").Return(Cards{}, nil)
+		r.EXPECT().UpdateCard(gomock.Any()).Return(&moved, nil)
+		c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+		m := NewMirror(r, c)
+		_, _ = m.FindCards("list 1")
+		_, _ = m.FindCards("list 2")
+
+		// WHEN
+		_, err := m.UpdateCard(NewUpdateCard(moved))
+
+		// THEN
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+		offline := NewOffline(c)
+		actual1, _ := offline.FindCards("list 1")
+		actual2, _ := offline.FindCards("list 2")
+		if !reflect.DeepEqual(Cards{cards[1]}, actual1) {
+			t.Errorf("expected %v, actual %v", Cards{cards[1]}, actual1)
+		}
+		if !reflect.DeepEqual(Cards{moved}, actual2) {
+			t.Errorf("expected %v, actual %v", Cards{moved}, actual2)
+		}
+	})
+	t.Run("updated card does not touch the cards of the other lists", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		updated := cards[0]
+		updated.Name = "updated card"
+		otherCards := Cards{{ID: "card 3", Name: "card", IDList: "list 3", IDBoard: "board 1", Labels: Labels{}}}
+		r.EXPECT().FindCards("list 1").Return(cards, nil)
+		r.EXPECT().FindCards("list 3").Return(otherCards, nil)
+		r.EXPECT().UpdateCard(gomock.Any()).Return(&updated, nil)
+		c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+		m := NewMirror(r, c)
+		_, _ = m.FindCards("list 1")
+		_, _ = m.FindCards("list 3")
+		// an unreadable file would be rewritten if the cards of the list were looked for the updated card
+		otherPath := newMirrorStore(c).path(cardsCacheKind, "list 3")
+		if err := os.WriteFile(otherPath, []byte("not json"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		// WHEN
+		_, err := m.UpdateCard(NewUpdateCard(updated))
+
+		// THEN
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+		offline := NewOffline(c)
+		actual, _ := offline.FindCards("list 1")
+		expected := Cards{cards[1], updated}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("expected %v, actual %v", expected, actual)
+		}
+		if b, _ := os.ReadFile(otherPath); string(b) != "not json" {
+			t.Errorf("expected the cards of the other list to be untouched, actual %s", b)
+		}
+	})
+	t.Run("prefetched board indexes the cards of all its lists", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		var manyLists Lists
+		for i := 0; i < 20; i++ {
+			idList := fmt.Sprintf("list %d", i)
+			manyLists = append(manyLists, List{ID: idList, Name: idList, IDBoard: "board 1"})
+			r.EXPECT().FindCards(idList).Return(Cards{{ID: fmt.Sprintf("card %d", i), IDList: idList, Labels: Labels{}}}, nil)
+		}
+		r.EXPECT().FindLabels("board 1").Return(Labels{}, nil)
+		r.EXPECT().FindLists("board 1").Return(manyLists, nil)
+		c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+		m := NewMirror(r, c)
+
+		// WHEN
+		err := m.Prefetch("board 1")
+
+		// THEN
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+		store := newMirrorStore(c)
+		for _, list := range manyLists {
+			idCard := strings.Replace(list.ID, "list", "card", 1)
+			if card := store.findCard(idCard); card == nil || card.IDList != list.ID {
+				t.Errorf("expected %s to be found in %s, actual %v", idCard, list.ID, card)
+			}
+		}
+	})
+	t.Run("archived list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().FindLists("board 1").Return(lists, nil)
+		r.EXPECT().FindCards("list 1").Return(cards, nil)
+		r.EXPECT().ArchiveList("board 1", "list 1").Return(nil)
+		c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+		m := NewMirror(r, c)
+		_, _ = m.FindLists("board 1")
+		_, _ = m.FindCards("list 1")
+
+		// WHEN
+		err := m.ArchiveList("board 1", "list 1")
+
+		// THEN
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+		offline := NewOffline(c)
+		actual, _ := offline.FindLists("board 1")
+		if len(actual) != 0 {
+			t.Errorf("expected no list, actual %v", actual)
+		}
+		if _, err = offline.FindCards("list 1"); err == nil {
+			t.Error("expected the cards of the archived list to be removed from the mirror")
+		}
+	})
+}
@@ -0,0 +1,507 @@
+package trello
+
+import (
+	"errors"
+	"fmt"
+	"github.com/l-lin/tcli/conf"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrOffline is matched by the errors of the operations that cannot be performed offline
+	ErrOffline = errors.New("not available offline")
+	// ErrNotMirrored is matched by the errors of the resources that were never fetched online, so they are not in the mirror
+	ErrNotMirrored = errors.New("not mirrored")
+)
+
+// space between the positions of two consecutive resources, like Trello
+const posStep = 65536
+
+// Offline is a Repository working without Trello: the resources are read from the mirror kept by Mirror,
+// and the mutations of the lists, cards and comments are applied to the mirror, then queued in the Outbox
+// until they are sent to Trello with Outbox.Sync
+type Offline struct {
+	store  mirrorStore
+	outbox *Outbox
+}
+
+func NewOffline(c conf.Conf) Repository {
+	return &Offline{store: newMirrorStore(c), outbox: NewOutbox(c)}
+}
+
+func notAvailableOffline(operation string) error {
+	return fmt.Errorf("%s is %w, run it online", operation, ErrOffline)
+}
+
+func notMirrored(resource string) error {
+	return fmt.Errorf("%s %w, browse them or run 'warm' while online to have them offline", resource, ErrNotMirrored)
+}
+
+func (o *Offline) Refresh() {
+	// do nothing, as the mirror is the only source of the resources offline
+}
+
+func (o *Offline) Prefetch(_ string) error {
+	// do nothing, as there is nothing to fetch offline
+	return nil
+}
+
+func (o *Offline) Forget(_ Actions) {
+	// do nothing, as the mirror is only updated online
+}
+
+// BOARDS -------------------------------------------------------------------
+
+func (o *Offline) FindBoards() (Boards, error) {
+	var boards Boards
+	if !o.store.read(boardsCacheKind, "", &boards) {
+		return nil, notMirrored("boards")
+	}
+	return boards, nil
+}
+
+func (o *Offline) FindBoard(query string) (*Board, error) {
+	boards, err := o.FindBoards()
+	if err != nil {
+		return nil, err
+	}
+	if board := FindBoard(boards, query); board != nil {
+		return board, nil
+	}
+	return nil, fmt.Errorf("no board found with query %s", query)
+}
+
+func (o *Offline) CreateBoard(_ CreateBoard) (*Board, error) {
+	return nil, notAvailableOffline("creating a board")
+}
+
+func (o *Offline) UpdateBoard(_ UpdateBoard) (*Board, error) {
+	return nil, notAvailableOffline("updating a board")
+}
+
+func (o *Offline) CloseBoard(_ string) error {
+	return notAvailableOffline("closing a board")
+}
+
+// LABELS -------------------------------------------------------------------
+
+func (o *Offline) FindLabels(idBoard string) (Labels, error) {
+	var labels Labels
+	if !o.store.read(labelsCacheKind, idBoard, &labels) {
+		return nil, notMirrored("labels of the board")
+	}
+	return labels, nil
+}
+
+func (o *Offline) CreateLabel(_ CreateLabel) (*Label, error) {
+	return nil, notAvailableOffline("creating a label")
+}
+
+func (o *Offline) UpdateLabel(_ UpdateLabel) (*Label, error) {
+	return nil, notAvailableOffline("updating a label")
+}
+
+func (o *Offline) DeleteLabel(_, _ string) error {
+	return notAvailableOffline("deleting a label")
+}
+
+// MEMBERS -------------------------------------------------------------------
+
+func (o *Offline) FindMembers(idBoard string) (Members, error) {
+	var members Members
+	if !o.store.read(membersCacheKind, idBoard, &members) {
+		return nil, notMirrored("members of the board")
+	}
+	return members, nil
+}
+
+func (o *Offline) FindCurrentMember() (*Member, error) {
+	var member Member
+	if !o.store.read(membersCacheKind, currentMemberCacheID, &member) {
+		return nil, notMirrored("current member")
+	}
+	return &member, nil
+}
+
+// LISTS -------------------------------------------------------------------
+
+func (o *Offline) FindLists(idBoard string) (Lists, error) {
+	var lists Lists
+	if !o.store.read(listsCacheKind, idBoard, &lists) {
+		return nil, notMirrored("lists of the board")
+	}
+	return lists, nil
+}
+
+func (o *Offline) FindList(idBoard string, query string) (*List, error) {
+	lists, err := o.FindLists(idBoard)
+	if err != nil {
+		return nil, err
+	}
+	if list := FindList(lists, query); list != nil {
+		return list, nil
+	}
+	return nil, fmt.Errorf("no list found with query %s", query)
+}
+
+func (o *Offline) CreateList(createList CreateList) (*List, error) {
+	lists, err := o.FindLists(createList.IDBoard)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := o.outbox.push(OutboxEntry{Operation: createListOperation, CreateList: &createList})
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]float64, len(lists))
+	for i, list := range lists {
+		positions[i] = list.Pos
+	}
+	list := List{ID: entry.TempID, Name: createList.Name, IDBoard: createList.IDBoard, Pos: offlinePos(createList.Pos, positions)}
+	o.store.upsertList(list)
+	// the list is empty, and not "not mirrored"
+	o.store.writeCards(list.ID, Cards{})
+	return &list, nil
+}
+
+func (o *Offline) UpdateList(updateList UpdateList) (*List, error) {
+	operation := updateListOperation
+	list := List{}
+	var base *JournalSnapshot
+	if before := o.store.findList(updateList.ID); before != nil {
+		list = *before
+		base = &JournalSnapshot{List: before}
+		if updateList.Closed && !before.Closed {
+			operation = archiveListOperation
+		}
+	}
+	if _, err := o.outbox.push(OutboxEntry{Operation: operation, UpdateList: &updateList, Base: base}); err != nil {
+		return nil, err
+	}
+	list.ID, list.Name, list.IDBoard, list.Closed = updateList.ID, updateList.Name, updateList.IDBoard, updateList.Closed
+	if updateList.Pos != nil {
+		list.Pos = offlinePos(updateList.Pos, []float64{list.Pos})
+	}
+	if list.Closed {
+		o.store.removeList(list.IDBoard, list.ID)
+	} else {
+		o.store.upsertList(list)
+	}
+	return &list, nil
+}
+
+func (o *Offline) ArchiveList(_, idList string) error {
+	list := o.store.findList(idList)
+	if list == nil {
+		return notMirrored("list")
+	}
+	updateList := NewUpdateList(*list)
+	updateList.Closed = true
+	_, err := o.UpdateList(updateList)
+	return err
+}
+
+func (o *Offline) FindArchivedLists(_ string) (Lists, error) {
+	return nil, notAvailableOffline("listing the archived lists")
+}
+
+// CARDS -------------------------------------------------------------------
+
+func (o *Offline) FindCards(idList string) (Cards, error) {
+	var cards Cards
+	if !o.store.read(cardsCacheKind, idList, &cards) {
+		return nil, notMirrored("cards of the list")
+	}
+	return cards, nil
+}
+
+func (o *Offline) FindCard(idList string, query string) (*Card, error) {
+	cards, err := o.FindCards(idList)
+	if err != nil {
+		return nil, err
+	}
+	if card := FindCard(cards, query); card != nil {
+		return card, nil
+	}
+	return nil, fmt.Errorf("no card found with query %s", query)
+}
+
+func (o *Offline) FindArchivedCards(_ string) (Cards, error) {
+	return nil, notAvailableOffline("listing the archived cards")
+}
+
+func (o *Offline) ArchiveAllCards(_ string) error {
+	return notAvailableOffline("archiving all the cards of a list")
+}
+
+func (o *Offline) CreateCard(createCard CreateCard) (*Card, error) {
+	cards, err := o.FindCards(createCard.IDList)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := o.outbox.push(OutboxEntry{Operation: createCardOperation, CreateCard: &createCard})
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]float64, len(cards))
+	for i, card := range cards {
+		positions[i] = card.Pos
+	}
+	card := Card{
+		ID:        entry.TempID,
+		Name:      createCard.Name,
+		Desc:      createCard.Desc,
+		IDList:    createCard.IDList,
+		Closed:    createCard.Closed,
+		Pos:       offlinePos(createCard.Pos, positions),
+		IDMembers: splitIDs(createCard.IDMembers),
+		Labels:    Labels{},
+	}
+	if list := o.store.findList(createCard.IDList); list != nil {
+		card.IDBoard = list.IDBoard
+	}
+	if createCard.Due != nil {
+		card.Due = *createCard.Due
+	}
+	if createCard.Start != nil {
+		card.Start = *createCard.Start
+	}
+	card.Labels = o.labels(card.IDBoard, createCard.IDLabels)
+	o.store.upsertCard(card)
+	// the card has no comment, and not "not mirrored" ones
+	o.store.write(commentsCacheKind, card.ID, Comments{})
+	return &card, nil
+}
+
+func (o *Offline) UpdateCard(updateCard UpdateCard) (*Card, error) {
+	operation := updateCardOperation
+	card := Card{Labels: Labels{}}
+	var base *JournalSnapshot
+	if before := o.store.findCard(updateCard.ID); before != nil {
+		card = *before
+		base = &JournalSnapshot{Card: before}
+	}
+	if updateCard.Closed && (base == nil || !base.Card.Closed) {
+		operation = archiveCardOperation
+	}
+	if _, err := o.outbox.push(OutboxEntry{Operation: operation, UpdateCard: &updateCard, Base: base}); err != nil {
+		return nil, err
+	}
+	card.ID, card.Name, card.Desc = updateCard.ID, updateCard.Name, updateCard.Desc
+	card.IDBoard, card.IDList, card.Closed = updateCard.IDBoard, updateCard.IDList, updateCard.Closed
+	card.DueComplete, card.IDMembers = updateCard.DueComplete, splitIDs(updateCard.IDMembers)
+	card.Due, card.Start = "", ""
+	if updateCard.Due != nil {
+		card.Due = *updateCard.Due
+	}
+	if updateCard.Start != nil {
+		card.Start = *updateCard.Start
+	}
+	if updateCard.Pos != nil {
+		card.Pos = offlinePos(updateCard.Pos, []float64{card.Pos})
+	}
+	// like Trello, the labels are kept when no label is given
+	if updateCard.IDLabels != "" {
+		card.Labels = o.labels(card.IDBoard, updateCard.IDLabels)
+	}
+	o.store.upsertCard(card)
+	return &card, nil
+}
+
+func (o *Offline) SearchCards(_ string, _ ...string) (Cards, error) {
+	return nil, notAvailableOffline("searching cards")
+}
+
+// labels returns the mirrored labels of the board with the given comma separated IDs
+func (o *Offline) labels(idBoard, idLabels string) Labels {
+	labels := Labels{}
+	boardLabels, _ := o.FindLabels(idBoard)
+	for _, idLabel := range splitIDs(idLabels) {
+		label := Label{ID: idLabel, IDBoard: idBoard}
+		for _, boardLabel := range boardLabels {
+			if boardLabel.ID == idLabel {
+				label = boardLabel
+			}
+		}
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// CHECKLISTS -------------------------------------------------------------------
+
+func (o *Offline) FindChecklists(idCard string) (Checklists, error) {
+	var checklists Checklists
+	if !o.store.read(checklistsCacheKind, idCard, &checklists) {
+		if strings.HasPrefix(idCard, offlineIDPrefix) {
+			return Checklists{}, nil
+		}
+		return nil, notMirrored("checklists of the card")
+	}
+	return checklists, nil
+}
+
+func (o *Offline) CreateChecklist(_ CreateChecklist) (*Checklist, error) {
+	return nil, notAvailableOffline("creating a checklist")
+}
+
+func (o *Offline) UpdateChecklist(_ UpdateChecklist) (*Checklist, error) {
+	return nil, notAvailableOffline("updating a checklist")
+}
+
+func (o *Offline) DeleteChecklist(_, _ string) error {
+	return notAvailableOffline("deleting a checklist")
+}
+
+func (o *Offline) CreateCheckItem(_ CreateCheckItem) (*CheckItem, error) {
+	return nil, notAvailableOffline("creating a checklist item")
+}
+
+func (o *Offline) UpdateCheckItem(_ UpdateCheckItem) (*CheckItem, error) {
+	return nil, notAvailableOffline("updating a checklist item")
+}
+
+func (o *Offline) DeleteCheckItem(_, _ string) error {
+	return notAvailableOffline("deleting a checklist item")
+}
+
+// CUSTOM FIELDS -------------------------------------------------------------------
+
+func (o *Offline) FindCustomFields(idBoard string) (CustomFields, error) {
+	var customFields CustomFields
+	if !o.store.read(customFieldsCacheKind, idBoard, &customFields) {
+		return nil, notMirrored("custom fields of the board")
+	}
+	return customFields, nil
+}
+
+func (o *Offline) UpdateCustomFieldItem(_ UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	return nil, notAvailableOffline("editing the custom fields")
+}
+
+// ATTACHMENTS -------------------------------------------------------------------
+
+func (o *Offline) FindAttachments(idCard string) (Attachments, error) {
+	var attachments Attachments
+	if !o.store.read(attachmentsCacheKind, idCard, &attachments) {
+		if strings.HasPrefix(idCard, offlineIDPrefix) {
+			return Attachments{}, nil
+		}
+		return nil, notMirrored("attachments of the card")
+	}
+	return attachments, nil
+}
+
+func (o *Offline) CreateAttachment(_ CreateAttachment) (*Attachment, error) {
+	return nil, notAvailableOffline("uploading an attachment")
+}
+
+func (o *Offline) DownloadAttachment(_ Attachment, _ io.Writer) error {
+	return notAvailableOffline("downloading an attachment")
+}
+
+func (o *Offline) DeleteAttachment(_, _ string) error {
+	return notAvailableOffline("deleting an attachment")
+}
+
+// COMMENTS -------------------------------------------------------------------
+
+func (o *Offline) FindComments(idCard string) (Comments, error) {
+	var comments Comments
+	if !o.store.read(commentsCacheKind, idCard, &comments) {
+		return nil, notMirrored("comments of the card")
+	}
+	return comments, nil
+}
+
+func (o *Offline) FindComment(idCard string, idComment string) (*Comment, error) {
+	comments, err := o.FindComments(idCard)
+	if err != nil {
+		return nil, err
+	}
+	if comment := FindComment(comments, idComment); comment != nil {
+		return comment, nil
+	}
+	return nil, fmt.Errorf("no comment found with id %s", idComment)
+}
+
+func (o *Offline) CreateComment(createComment CreateComment) (*Comment, error) {
+	entry, err := o.outbox.push(OutboxEntry{Operation: createCommentOperation, CreateComment: &createComment})
+	if err != nil {
+		return nil, err
+	}
+	comment := Comment{
+		ID:   entry.TempID,
+		Date: entry.Date.UTC().Format(trelloDateLayout),
+		Data: CommentData{Card: CommentDataCard{ID: createComment.IDCard}, Text: createComment.Text},
+	}
+	if card := o.store.findCard(createComment.IDCard); card != nil {
+		comment.Data.Card.Name, comment.Data.Card.ShortLink = card.Name, card.ShortLink
+	}
+	if member, err := o.FindCurrentMember(); err == nil {
+		comment.MemberCreator = CommentMemberCreator{ID: member.ID, FullName: member.FullName, Username: member.Username}
+	}
+	o.store.upsertComment(comment)
+	return &comment, nil
+}
+
+func (o *Offline) UpdateComment(_ UpdateComment) (*Comment, error) {
+	return nil, notAvailableOffline("updating a comment")
+}
+
+func (o *Offline) DeleteComment(_, _ string) error {
+	return notAvailableOffline("deleting a comment")
+}
+
+// ACTIONS -------------------------------------------------------------------
+
+func (o *Offline) FindCardActions(_ string, _ time.Time) (Actions, error) {
+	return nil, notAvailableOffline("showing the activity")
+}
+
+func (o *Offline) FindBoardActions(_ string, _ time.Time) (Actions, error) {
+	return nil, notAvailableOffline("showing the activity")
+}
+
+// WEBHOOKS -------------------------------------------------------------------
+
+func (o *Offline) FindWebhooks() (Webhooks, error) {
+	return nil, notAvailableOffline("managing the webhooks")
+}
+
+func (o *Offline) CreateWebhook(_ CreateWebhook) (*Webhook, error) {
+	return nil, notAvailableOffline("managing the webhooks")
+}
+
+func (o *Offline) DeleteWebhook(_ string) error {
+	return notAvailableOffline("managing the webhooks")
+}
+
+// offlinePos computes the position of a resource, given as "top", "bottom" or a number, among the given positions
+func offlinePos(pos interface{}, positions []float64) float64 {
+	if p, ok := pos.(float64); ok {
+		return p
+	}
+	min, max := 0.0, 0.0
+	for i, p := range positions {
+		if i == 0 || p < min {
+			min = p
+		}
+		if i == 0 || p > max {
+			max = p
+		}
+	}
+	if pos == "top" {
+		return min / 2
+	}
+	return max + posStep
+}
+
+func splitIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
@@ -0,0 +1,169 @@
+package trello
+
+import (
+	"errors"
+	"github.com/l-lin/tcli/conf"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newMirroredOffline creates an Offline whose mirror contains the given lists and cards
+func newMirroredOffline(t *testing.T, lists Lists, cards Cards) (*Offline, conf.Conf) {
+	c := conf.Conf{Cache: conf.Cache{Dir: t.TempDir()}}
+	o := NewOffline(c).(*Offline)
+	now := time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+	o.outbox.now = func() time.Time { return now }
+	o.store.write(boardsCacheKind, "", Boards{{ID: "board 1", Name: "board"}})
+	o.store.write(labelsCacheKind, "board 1", Labels{{ID: "label 1", Name: "bug", Color: "red"}})
+	o.store.write(listsCacheKind, "board 1", lists)
+	for _, list := range lists {
+		var listCards Cards
+		for _, card := range cards {
+			if card.IDList == list.ID {
+				listCards = append(listCards, card)
+			}
+		}
+		o.store.writeCards(list.ID, listCards)
+	}
+	return o, c
+}
+
+func TestOffline_CreateCard(t *testing.T) {
+	// GIVEN
+	lists := Lists{{ID: "list 1", Name: "list", IDBoard: "board 1"}}
+	cards := Cards{{ID: "card 1", Name: "card", IDList: "list 1", IDBoard: "board 1", Pos: 65536, Labels: Labels{}}}
+	o, _ := newMirroredOffline(t, lists, cards)
+
+	// WHEN
+	actual, err := o.CreateCard(CreateCard{Name: "new card", IDList: "list 1", Pos: "bottom", IDLabels: "label 1"})
+
+	// THEN
+	if err != nil {
+		t.Fatalf("expected no error, actual %v", err)
+	}
+	expected := Card{
+		ID:      "offline-1",
+		Name:    "new card",
+		IDList:  "list 1",
+		IDBoard: "board 1",
+		Pos:     131072,
+		Labels:  Labels{{ID: "label 1", Name: "bug", Color: "red"}},
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Errorf("expected %v, actual %v", expected, *actual)
+	}
+	mirrored, _ := o.FindCards("list 1")
+	if len(mirrored) != 2 || mirrored[1].ID != "offline-1" {
+		t.Errorf("expected the new card to be mirrored, actual %v", mirrored)
+	}
+	if comments, err := o.FindComments("offline-1"); err != nil || len(comments) != 0 {
+		t.Errorf("expected no comment, actual %v, %v", comments, err)
+	}
+	entries, _ := o.outbox.Entries()
+	if len(entries) != 1 || entries[0].Operation != createCardOperation || entries[0].TempID != "offline-1" {
+		t.Errorf("expected the creation to be queued, actual %v", entries)
+	}
+}
+
+func TestOffline_UpdateCard(t *testing.T) {
+	lists := Lists{
+		{ID: "list 1", Name: "list", IDBoard: "board 1"},
+		{ID: "list 2", Name: "another list", IDBoard: "board 1"},
+	}
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1", IDBoard: "board 1", Labels: Labels{}}
+	var tests = map[string]struct {
+		given             func(UpdateCard) UpdateCard
+		expectedOperation string
+		expectedList1     int
+		expectedList2     int
+	}{
+		"rename": {
+			given: func(u UpdateCard) UpdateCard {
+				u.Name = "renamed card"
+				return u
+			},
+			expectedOperation: updateCardOperation,
+			expectedList1:     1,
+		},
+		"move": {
+			given: func(u UpdateCard) UpdateCard {
+				u.IDList = "list 2"
+				return u
+			},
+			expectedOperation: updateCardOperation,
+			expectedList2:     1,
+		},
+		"archive": {
+			given: func(u UpdateCard) UpdateCard {
+				u.Closed = true
+				return u
+			},
+			expectedOperation: archiveCardOperation,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			o, _ := newMirroredOffline(t, lists, Cards{card})
+
+			// WHEN
+			_, err := o.UpdateCard(tt.given(NewUpdateCard(card)))
+
+			// THEN
+			if err != nil {
+				t.Fatalf("expected no error, actual %v", err)
+			}
+			cards1, _ := o.FindCards("list 1")
+			cards2, _ := o.FindCards("list 2")
+			if len(cards1) != tt.expectedList1 || len(cards2) != tt.expectedList2 {
+				t.Errorf("expected %d and %d cards, actual %v and %v", tt.expectedList1, tt.expectedList2, cards1, cards2)
+			}
+			entries, _ := o.outbox.Entries()
+			if len(entries) != 1 || entries[0].Operation != tt.expectedOperation {
+				t.Errorf("expected a queued %s, actual %v", tt.expectedOperation, entries)
+			}
+			if entries[0].Base == nil || !reflect.DeepEqual(card, *entries[0].Base.Card) {
+				t.Errorf("expected the base %v, actual %v", card, entries[0].Base)
+			}
+		})
+	}
+}
+
+func TestOffline_Errors(t *testing.T) {
+	o, _ := newMirroredOffline(t, Lists{{ID: "list 1", Name: "list", IDBoard: "board 1"}}, Cards{})
+	var tests = map[string]struct {
+		given    func() error
+		expected error
+	}{
+		"not mirrored": {
+			given: func() error {
+				_, err := o.FindLists("board 2")
+				return err
+			},
+			expected: ErrNotMirrored,
+		},
+		"search": {
+			given: func() error {
+				_, err := o.SearchCards("card")
+				return err
+			},
+			expected: ErrOffline,
+		},
+		"delete comment": {
+			given: func() error {
+				return o.DeleteComment("card 1", "comment 1")
+			},
+			expected: ErrOffline,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := tt.given(); !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, actual %v", tt.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package trello
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/l-lin/tcli/conf"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// offlineIDPrefix prefixes the IDs given to the resources created offline, until they are synchronized
+const offlineIDPrefix = "offline-"
+
+// OutboxEntries are sorted from the oldest to the most recent mutation
+type OutboxEntries []OutboxEntry
+
+// OutboxEntry is a mutation performed offline, waiting to be sent to Trello
+type OutboxEntry struct {
+	ID            int            `json:"id"`
+	Date          time.Time      `json:"date"`
+	Operation     string         `json:"operation"`
+	CreateList    *CreateList    `json:"createList,omitempty"`
+	UpdateList    *UpdateList    `json:"updateList,omitempty"`
+	CreateCard    *CreateCard    `json:"createCard,omitempty"`
+	UpdateCard    *UpdateCard    `json:"updateCard,omitempty"`
+	CreateComment *CreateComment `json:"createComment,omitempty"`
+	// Base is the state of the mutated resource when it was mutated offline,
+	// to detect if it was modified in Trello in the meantime
+	Base *JournalSnapshot `json:"base,omitempty"`
+	// TempID is the ID given to the resource created offline, replaced by its Trello ID once synchronized
+	TempID string `json:"tempId,omitempty"`
+}
+
+// Resource returns the name of the resource targeted by the mutation
+func (e OutboxEntry) Resource() string {
+	switch {
+	case e.CreateList != nil:
+		return fmt.Sprintf("'%s'", e.CreateList.Name)
+	case e.UpdateList != nil:
+		return fmt.Sprintf("'%s'", e.UpdateList.Name)
+	case e.CreateCard != nil:
+		return fmt.Sprintf("'%s'", e.CreateCard.Name)
+	case e.UpdateCard != nil:
+		return fmt.Sprintf("'%s'", e.UpdateCard.Name)
+	case e.CreateComment != nil:
+		return fmt.Sprintf("on card '%s'", e.CreateComment.IDCard)
+	}
+	return ""
+}
+
+func (e OutboxEntry) String() string {
+	resource := e.Resource()
+	if resource == "" {
+		return e.Operation
+	}
+	return fmt.Sprintf("%s %s", e.Operation, resource)
+}
+
+// remap replaces the IDs of the resources created offline by their Trello IDs
+func (e *OutboxEntry) remap(ids map[string]string) {
+	replace := func(id *string) {
+		if real, ok := ids[*id]; ok {
+			*id = real
+		}
+	}
+	if e.CreateCard != nil {
+		replace(&e.CreateCard.IDList)
+	}
+	if e.UpdateList != nil {
+		replace(&e.UpdateList.ID)
+	}
+	if e.UpdateCard != nil {
+		replace(&e.UpdateCard.ID)
+		replace(&e.UpdateCard.IDList)
+	}
+	if e.CreateComment != nil {
+		replace(&e.CreateComment.IDCard)
+	}
+	if e.Base != nil && e.Base.List != nil {
+		replace(&e.Base.List.ID)
+	}
+	if e.Base != nil && e.Base.Card != nil {
+		replace(&e.Base.Card.ID)
+		replace(&e.Base.Card.IDList)
+	}
+}
+
+// ConflictError is returned when synchronizing a mutation on a resource that was modified in Trello
+// since it was mutated offline
+type ConflictError struct {
+	Reason string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Reason
+}
+
+// SyncOptions configures how the outbox is synchronized
+type SyncOptions struct {
+	// Force sends the mutations even if their resources were modified in Trello since they were mutated offline
+	Force bool
+	// Discard removes the mutations in conflict from the outbox instead of keeping them for the next synchronization
+	Discard bool
+	// DryRun keeps the outbox and the mirror untouched, as the mutations are not really sent
+	DryRun bool
+}
+
+// SyncResult is the result of the synchronization of a mutation
+type SyncResult struct {
+	Entry OutboxEntry
+	// Err is the reason why the mutation was not sent, nil if it was
+	Err error
+}
+
+// Outbox stores in a file the mutations performed offline, to send them to Trello with Sync
+type Outbox struct {
+	file  string
+	store mirrorStore
+	now   func() time.Time
+}
+
+// NewOutbox creates an Outbox stored next to the disk cache, but not purged with it
+func NewOutbox(c conf.Conf) *Outbox {
+	return &Outbox{
+		file:  filepath.Join(cacheDir(c), "outbox", account(c)+".json"),
+		store: newMirrorStore(c),
+		now:   time.Now,
+	}
+}
+
+// Entries returns the mutations waiting to be sent, from the oldest to the most recent
+func (o *Outbox) Entries() (OutboxEntries, error) {
+	b, err := ioutil.ReadFile(o.file)
+	if os.IsNotExist(err) {
+		return OutboxEntries{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entries OutboxEntries
+	if err = json.Unmarshal(b, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// push adds the mutation to the outbox, giving a temporary ID to the resource it creates
+func (o *Outbox) push(entry OutboxEntry) (OutboxEntry, error) {
+	entries, err := o.Entries()
+	if err != nil {
+		return entry, fmt.Errorf("could not read the outbox: %w", err)
+	}
+	entry.ID = 1
+	for _, e := range entries {
+		if e.ID >= entry.ID {
+			entry.ID = e.ID + 1
+		}
+	}
+	entry.Date = o.now()
+	switch entry.Operation {
+	case createListOperation, createCardOperation, createCommentOperation:
+		entry.TempID = fmt.Sprintf("%s%d", offlineIDPrefix, entry.ID)
+	}
+	if err = o.save(append(entries, entry)); err != nil {
+		return entry, fmt.Errorf("could not write the outbox: %w", err)
+	}
+	return entry, nil
+}
+
+func (o *Outbox) save(entries OutboxEntries) error {
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	return writeFile(o.file, b)
+}
+
+// Sync sends the mutations of the outbox to Trello through the given Repository, from the oldest to the most recent.
+// A mutation is not sent if its resource was modified in Trello since it was mutated offline, unless it is forced.
+// The mutations that could not be sent are kept in the outbox.
+func (o *Outbox) Sync(tr Repository, opts SyncOptions) ([]SyncResult, error) {
+	entries, err := o.Entries()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the outbox: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	// the conflicts must be detected on the current state of Trello
+	tr.Refresh()
+
+	s := synchronization{Outbox: o, tr: tr, opts: opts, ids: map[string]string{}, synced: map[string]bool{}}
+	var results []SyncResult
+	kept := OutboxEntries{}
+	for _, entry := range entries {
+		entry.remap(s.ids)
+		err := s.send(entry)
+		var conflictErr *ConflictError
+		if err != nil && !(opts.Discard && errors.As(err, &conflictErr)) {
+			kept = append(kept, entry)
+		}
+		results = append(results, SyncResult{Entry: entry, Err: err})
+	}
+	if !opts.DryRun {
+		if err = o.save(kept); err != nil {
+			return results, fmt.Errorf("could not write the outbox: %w", err)
+		}
+	}
+	return results, nil
+}
+
+// synchronization is the state of a single Outbox.Sync
+type synchronization struct {
+	*Outbox
+	tr   Repository
+	opts SyncOptions
+	// <temporary ID, Trello ID> of the resources created during the synchronization
+	ids map[string]string
+	// <ID, true> of the resources mutated during the synchronization,
+	// which are not checked for conflicts again as they were modified by the synchronization itself
+	synced map[string]bool
+}
+
+func (s synchronization) send(entry OutboxEntry) error {
+	switch entry.Operation {
+	case createListOperation:
+		list, err := s.tr.CreateList(*entry.CreateList)
+		if err != nil {
+			return err
+		}
+		s.created(entry.TempID, list.ID)
+		if !s.opts.DryRun {
+			s.store.removeList(entry.CreateList.IDBoard, entry.TempID)
+		}
+		return nil
+	case updateListOperation, archiveListOperation:
+		if err := s.checkList(entry); err != nil {
+			return err
+		}
+		if _, err := s.tr.UpdateList(*entry.UpdateList); err != nil {
+			return err
+		}
+		s.synced[entry.UpdateList.ID] = true
+		return nil
+	case createCardOperation:
+		card, err := s.tr.CreateCard(*entry.CreateCard)
+		if err != nil {
+			return err
+		}
+		s.created(entry.TempID, card.ID)
+		if !s.opts.DryRun {
+			s.store.removeCard(entry.TempID)
+			s.store.remove(commentsCacheKind, entry.TempID)
+		}
+		return nil
+	case updateCardOperation, archiveCardOperation:
+		if err := s.checkCard(entry); err != nil {
+			return err
+		}
+		if _, err := s.tr.UpdateCard(*entry.UpdateCard); err != nil {
+			return err
+		}
+		s.synced[entry.UpdateCard.ID] = true
+		return nil
+	case createCommentOperation:
+		if _, err := s.tr.CreateComment(*entry.CreateComment); err != nil {
+			return err
+		}
+		if !s.opts.DryRun {
+			s.store.removeComment(entry.CreateComment.IDCard, entry.TempID)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown operation '%s'", entry.Operation)
+}
+
+func (s synchronization) created(tempID, id string) {
+	// the resources are not really created when running in dry-run
+	if id != "" {
+		s.ids[tempID] = id
+		s.synced[id] = true
+	}
+}
+
+func (s synchronization) checkList(entry OutboxEntry) error {
+	if s.opts.Force || entry.Base == nil || entry.Base.List == nil || s.isSkipped(entry.Base.List.ID) {
+		return nil
+	}
+	base := entry.Base.List
+	lists, err := s.tr.FindLists(base.IDBoard)
+	if err != nil {
+		return err
+	}
+	for _, remote := range lists {
+		if remote.ID == base.ID {
+			if remote.Name != base.Name || remote.Closed != base.Closed {
+				return &ConflictError{Reason: fmt.Sprintf("list '%s' was modified in Trello since it was modified offline", base.Name)}
+			}
+			return nil
+		}
+	}
+	return &ConflictError{Reason: fmt.Sprintf("list '%s' was moved or archived in Trello since it was modified offline", base.Name)}
+}
+
+func (s synchronization) checkCard(entry OutboxEntry) error {
+	if s.opts.Force || entry.Base == nil || entry.Base.Card == nil || s.isSkipped(entry.Base.Card.ID) {
+		return nil
+	}
+	base := entry.Base.Card
+	cards, err := s.tr.FindCards(base.IDList)
+	if err != nil {
+		return err
+	}
+	if base.Closed {
+		if cards, err = s.tr.FindArchivedCards(base.IDList); err != nil {
+			return err
+		}
+	}
+	if remote := findCardByID(cards, base.ID); remote != nil {
+		if !sameCard(*remote, *base) {
+			return &ConflictError{Reason: fmt.Sprintf("card '%s' was modified in Trello since it was modified offline", base.Name)}
+		}
+		return nil
+	}
+	return &ConflictError{Reason: fmt.Sprintf("card '%s' was moved or archived in Trello since it was modified offline", base.Name)}
+}
+
+// isSkipped returns true if the resource must not be checked for conflicts,
+// because it was created offline or already mutated by the synchronization
+func (s synchronization) isSkipped(id string) bool {
+	return strings.HasPrefix(id, offlineIDPrefix) || s.synced[id]
+}
+
+func findCardByID(cards Cards, idCard string) *Card {
+	for _, card := range cards {
+		if card.ID == idCard {
+			return &card
+		}
+	}
+	return nil
+}
+
+// sameCard returns true if the fields that can be mutated by TCli are the same, except the position
+// which changes when the other cards are moved
+func sameCard(c1, c2 Card) bool {
+	return c1.Name == c2.Name &&
+		c1.Desc == c2.Desc &&
+		c1.IDList == c2.IDList &&
+		c1.Closed == c2.Closed &&
+		c1.Due == c2.Due &&
+		c1.DueComplete == c2.DueComplete &&
+		c1.Start == c2.Start &&
+		sameIDs(c1.IDMembers, c2.IDMembers) &&
+		sameIDs(strings.Split(c1.Labels.String(), ","), strings.Split(c2.Labels.String(), ","))
+}
+
+func sameIDs(ids1, ids2 []string) bool {
+	if len(ids1) != len(ids2) {
+		return false
+	}
+	sorted1 := append([]string{}, ids1...)
+	sorted2 := append([]string{}, ids2...)
+	sort.Strings(sorted1)
+	sort.Strings(sorted2)
+	for i := range sorted1 {
+		if sorted1[i] != sorted2[i] {
+			return false
+		}
+	}
+	return true
+}
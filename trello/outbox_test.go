@@ -0,0 +1,166 @@
+package trello
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestOutbox_Sync(t *testing.T) {
+	lists := Lists{{ID: "list 1", Name: "list", IDBoard: "board 1"}}
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1", IDBoard: "board 1", Labels: Labels{}}
+	modified := card
+	modified.Desc = "modified in Trello"
+
+	t.Run("created card then commented", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		o, _ := newMirroredOffline(t, lists, Cards{})
+		created, _ := o.CreateCard(CreateCard{Name: "new card", IDList: "list 1"})
+		_, _ = o.CreateComment(CreateComment{IDCard: created.ID, Text: "comment"})
+		r := NewMockRepository(ctrl)
+		r.EXPECT().Refresh()
+		r.EXPECT().
+			CreateCard(CreateCard{Name: "new card", IDList: "list 1"}).
+			Return(&Card{ID: "card 2", Name: "new card", IDList: "list 1"}, nil)
+		r.EXPECT().
+			CreateComment(CreateComment{IDCard: "card 2", Text: "comment"}).
+			Return(&Comment{ID: "comment 1"}, nil)
+
+		// WHEN
+		results, err := o.outbox.Sync(r, SyncOptions{})
+
+		// THEN
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+		if len(results) != 2 || results[0].Err != nil || results[1].Err != nil {
+			t.Errorf("expected 2 synced mutations, actual %v", results)
+		}
+		if entries, _ := o.outbox.Entries(); len(entries) != 0 {
+			t.Errorf("expected an empty outbox, actual %v", entries)
+		}
+		if mirrored, _ := o.FindCards("list 1"); len(mirrored) != 0 {
+			t.Errorf("expected the offline card to be removed from the mirror, actual %v", mirrored)
+		}
+	})
+	t.Run("conflict", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		o, _ := newMirroredOffline(t, lists, Cards{card})
+		updateCard := NewUpdateCard(card)
+		updateCard.Name = "renamed card"
+		_, _ = o.UpdateCard(updateCard)
+		r := NewMockRepository(ctrl)
+		r.EXPECT().Refresh()
+		r.EXPECT().FindCards("list 1").Return(Cards{modified}, nil)
+
+		// WHEN
+		results, err := o.outbox.Sync(r, SyncOptions{})
+
+		// THEN
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+		var conflictErr *ConflictError
+		if len(results) != 1 || !errors.As(results[0].Err, &conflictErr) {
+			t.Errorf("expected a conflict, actual %v", results)
+		}
+		if entries, _ := o.outbox.Entries(); len(entries) != 1 {
+			t.Errorf("expected the mutation in conflict to be kept, actual %v", entries)
+		}
+	})
+	t.Run("conflict discarded", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		o, _ := newMirroredOffline(t, lists, Cards{card})
+		_, _ = o.UpdateCard(NewUpdateCard(card))
+		r := NewMockRepository(ctrl)
+		r.EXPECT().Refresh()
+		r.EXPECT().FindCards("list 1").Return(Cards{modified}, nil)
+
+		// WHEN
+		_, err := o.outbox.Sync(r, SyncOptions{Discard: true})
+
+		// THEN
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+		if entries, _ := o.outbox.Entries(); len(entries) != 0 {
+			t.Errorf("expected the mutation in conflict to be discarded, actual %v", entries)
+		}
+	})
+	t.Run("conflict forced", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		o, _ := newMirroredOffline(t, lists, Cards{card})
+		updateCard := NewUpdateCard(card)
+		updateCard.Name = "renamed card"
+		_, _ = o.UpdateCard(updateCard)
+		r := NewMockRepository(ctrl)
+		r.EXPECT().Refresh()
+		r.EXPECT().UpdateCard(updateCard).Return(&Card{ID: "card 1", Name: "renamed card"}, nil)
+
+		// WHEN
+		results, err := o.outbox.Sync(r, SyncOptions{Force: true})
+
+		// THEN
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+		if len(results) != 1 || results[0].Err != nil {
+			t.Errorf("expected the mutation to be synced, actual %v", results)
+		}
+	})
+	t.Run("failure kept", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		o, _ := newMirroredOffline(t, lists, Cards{card})
+		_, _ = o.CreateComment(CreateComment{IDCard: "card 1", Text: "comment"})
+		r := NewMockRepository(ctrl)
+		r.EXPECT().Refresh()
+		r.EXPECT().CreateComment(gomock.Any()).Return(nil, errors.New("unexpected error"))
+
+		// WHEN
+		results, err := o.outbox.Sync(r, SyncOptions{Discard: true})
+
+		// THEN
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+		if len(results) != 1 || results[0].Err == nil {
+			t.Errorf("expected a failure, actual %v", results)
+		}
+		if entries, _ := o.outbox.Entries(); len(entries) != 1 {
+			t.Errorf("expected the failed mutation to be kept, actual %v", entries)
+		}
+	})
+	t.Run("dry-run", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		o, _ := newMirroredOffline(t, lists, Cards{card})
+		_, _ = o.CreateComment(CreateComment{IDCard: "card 1", Text: "comment"})
+		before, _ := o.outbox.Entries()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().Refresh()
+		r.EXPECT().CreateComment(gomock.Any()).Return(nil, nil)
+
+		// WHEN
+		_, err := o.outbox.Sync(r, SyncOptions{DryRun: true})
+
+		// THEN
+		if err != nil {
+			t.Fatalf("expected no error, actual %v", err)
+		}
+		if after, _ := o.outbox.Entries(); !reflect.DeepEqual(before, after) {
+			t.Errorf("expected the outbox to be untouched, expected %v, actual %v", before, after)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -18,6 +19,10 @@ type Path struct {
 }
 
 func NewPathResolver(session *Session) PathResolver {
+	if session == nil {
+		// the commands executed outside the interactive prompt have no session
+		session = &Session{}
+	}
 	boardName := ""
 	if session.Board != nil {
 		boardName = session.Board.Name
@@ -50,13 +55,7 @@ func (pr *PathResolver) Resolve(relativePath string) (p Path, err error) {
 		return
 	}
 	var resolvedPath string
-	if path.IsAbs(relativePath) {
-		resolvedPath = strings.Trim(relativePath, "/")
-	} else {
-		resolvedPath = strings.Trim(filepath.Join(pr.BoardName, pr.ListName, pr.CardName, relativePath), "/")
-	}
-	if isInvalid(resolvedPath) {
-		err = invalidPathErr
+	if resolvedPath, err = pr.absolute(relativePath); err != nil {
 		return
 	}
 	if isTopLevel(resolvedPath) {
@@ -79,6 +78,49 @@ func (pr *PathResolver) Resolve(relativePath string) (p Path, err error) {
 	return
 }
 
+// Expand expands the glob patterns (`*`, `?`, `[abc]` and `**` to match any number of levels) of the given path
+// into the absolute paths of the existing resources matching them.
+// The path is returned as is if it has no pattern or if no resource matches it, so that names containing
+// special characters can still be used, and the TCliIDs ending with "[shortLink]" are resolved literally.
+func (pr *PathResolver) Expand(tr Repository, relativePath string) ([]string, error) {
+	if !HasGlob(relativePath) {
+		return []string{relativePath}, nil
+	}
+	resolvedPath, err := pr.absolute(relativePath)
+	if err != nil {
+		return nil, err
+	}
+	if isTopLevel(resolvedPath) {
+		return []string{relativePath}, nil
+	}
+	e := pathExpander{tr: tr, found: map[string]bool{}}
+	if err = e.expand(strings.Split(resolvedPath, "/"), nil, pathParent{}); err != nil {
+		return nil, err
+	}
+	if len(e.paths) == 0 {
+		return []string{relativePath}, nil
+	}
+	log.Debug().
+		Str("path", relativePath).
+		Strs("expandedPaths", e.paths).
+		Msg("expanded path")
+	return e.paths, nil
+}
+
+// absolute returns the path without leading and trailing slashes once joined to the current path
+func (pr *PathResolver) absolute(relativePath string) (string, error) {
+	var resolvedPath string
+	if path.IsAbs(relativePath) {
+		resolvedPath = strings.Trim(relativePath, "/")
+	} else {
+		resolvedPath = strings.Trim(filepath.Join(pr.BoardName, pr.ListName, pr.CardName, relativePath), "/")
+	}
+	if isInvalid(resolvedPath) {
+		return "", invalidPathErr
+	}
+	return resolvedPath, nil
+}
+
 func (pr *PathResolver) logResolved(p Path) {
 	log.Debug().
 		Interface("currentPath", pr).
@@ -93,3 +135,172 @@ func isInvalid(resolvedPath string) bool {
 func isTopLevel(resolvedPath string) bool {
 	return resolvedPath == "."
 }
+
+// tcliIDSuffixRegexp matches the unique ID ending the TCliIDs, like "[shortLink]" in "card name[shortLink]"
+var tcliIDSuffixRegexp = regexp.MustCompile(`\[[0-9A-Za-z]+\]$`)
+
+// HasGlob returns true if the given path contains glob patterns;
+// the "[shortLink]" ending the TCliIDs printed by the completion are not character classes
+func HasGlob(p string) bool {
+	for _, segment := range strings.Split(p, "/") {
+		if strings.ContainsAny(tcliIDSuffixRegexp.ReplaceAllString(segment, ""), "*?[") {
+			return true
+		}
+	}
+	return false
+}
+
+// pathParent contains the resources found for the already expanded levels
+type pathParent struct {
+	board     *Board
+	list      *List
+	card      *Card
+	commentID string
+	// recursive is true if the resources were found with "**"
+	recursive bool
+}
+
+// level of the next resource to expand: boards > lists > cards > comments
+func (pp pathParent) level() int {
+	if pp.commentID != "" {
+		return 4
+	}
+	if pp.card != nil {
+		return 3
+	}
+	if pp.list != nil {
+		return 2
+	}
+	if pp.board != nil {
+		return 1
+	}
+	return 0
+}
+
+type pathExpander struct {
+	tr    Repository
+	paths []string
+	found map[string]bool
+}
+
+func (e *pathExpander) expand(patterns []string, names []string, parent pathParent) error {
+	if len(patterns) == 0 {
+		p := "/" + strings.Join(names, "/")
+		// "**" can match the same resource several times
+		if !e.found[p] {
+			e.found[p] = true
+			e.paths = append(e.paths, p)
+		}
+		return nil
+	}
+	pattern := patterns[0]
+	if pattern == "**" {
+		// "**" matches zero level...
+		if err := e.expand(patterns[1:], names, parent); err != nil {
+			return err
+		}
+		// ...or any number of boards, lists and cards, but not the comments as it would fetch the comments of every card
+		if parent.level() > 2 {
+			return nil
+		}
+		return e.forEachChild(parent, func(_, segment string, child pathParent) error {
+			child.recursive = true
+			return e.expand(patterns, appendName(names, segment), child)
+		})
+	}
+	if parent.level() > 3 {
+		return nil
+	}
+	if parent.level() == 3 && parent.recursive && HasGlob(pattern) {
+		// do not fetch the comments of all the cards found with "**"
+		return nil
+	}
+	if !HasGlob(pattern) {
+		child, found := e.findChild(parent, pattern)
+		if !found {
+			return nil
+		}
+		return e.expand(patterns[1:], appendName(names, pattern), child)
+	}
+	return e.forEachChild(parent, func(name, segment string, child pathParent) error {
+		if matched, _ := path.Match(pattern, name); matched {
+			return e.expand(patterns[1:], appendName(names, segment), child)
+		}
+		return nil
+	})
+}
+
+// findChild finds the resource with the given name without fetching all the resources of the level
+func (e *pathExpander) findChild(parent pathParent, name string) (child pathParent, found bool) {
+	child = parent
+	var err error
+	switch parent.level() {
+	case 0:
+		child.board, err = e.tr.FindBoard(name)
+		return child, err == nil && child.board != nil
+	case 1:
+		child.list, err = e.tr.FindList(parent.board.ID, name)
+		return child, err == nil && child.list != nil
+	case 2:
+		child.card, err = e.tr.FindCard(parent.list.ID, name)
+		return child, err == nil && child.card != nil
+	}
+	// comments are the last level, there is no need to fetch them
+	child.commentID = name
+	return child, true
+}
+
+// forEachChild calls the action with the name of each resource of the next level, to match the patterns,
+// and with its path segment, which is its TCliID so the resources with the same name are not mixed up
+func (e *pathExpander) forEachChild(parent pathParent, action func(name, segment string, child pathParent) error) error {
+	switch parent.level() {
+	case 0:
+		boards, err := e.tr.FindBoards()
+		if err != nil {
+			return err
+		}
+		for i := range boards {
+			if err = action(boards[i].Name, toTCliID(boards[i].Name, boards[i].ShortLink), pathParent{board: &boards[i]}); err != nil {
+				return err
+			}
+		}
+	case 1:
+		lists, err := e.tr.FindLists(parent.board.ID)
+		if err != nil {
+			return err
+		}
+		for i := range lists {
+			if err = action(lists[i].Name, toTCliID(lists[i].Name, lists[i].ID), pathParent{board: parent.board, list: &lists[i], recursive: parent.recursive}); err != nil {
+				return err
+			}
+		}
+	case 2:
+		cards, err := e.tr.FindCards(parent.list.ID)
+		if err != nil {
+			return err
+		}
+		for i := range cards {
+			if err = action(cards[i].Name, toTCliID(cards[i].Name, cards[i].ShortLink), pathParent{board: parent.board, list: parent.list, card: &cards[i], recursive: parent.recursive}); err != nil {
+				return err
+			}
+		}
+	case 3:
+		comments, err := e.tr.FindComments(parent.card.ID)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			child := parent
+			child.commentID = comment.ID
+			if err = action(comment.ID, comment.ID, child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// appendName appends the name in a new slice, as the names are shared by all the resources of the same level
+func appendName(names []string, name string) []string {
+	return append(names[:len(names):len(names)], name)
+}
@@ -1,6 +1,8 @@
 package trello
 
 import (
+	"errors"
+	"github.com/golang/mock/gomock"
 	"reflect"
 	"testing"
 )
@@ -24,6 +26,10 @@ func TestNewPathResolver(t *testing.T) {
 				},
 			},
 		},
+		"no session": {
+			given:    nil,
+			expected: PathResolver{},
+		},
 		"existing board and list": {
 			given: &Session{
 				Board: &Board{Name: "board"},
@@ -347,3 +353,248 @@ func TestPathResolver_Resolve(t *testing.T) {
 		})
 	}
 }
+
+func TestPathResolver_Expand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	board := Board{ID: "board 1", Name: "board"}
+	todo := List{ID: "list1", Name: "todo", IDBoard: board.ID}
+	doing := List{ID: "list2", Name: "doing", IDBoard: board.ID}
+	bug1 := Card{ID: "card 1", Name: "bug-1", IDList: todo.ID, ShortLink: "fk000015"}
+	bug2 := Card{ID: "card 2", Name: "bug-2", IDList: todo.ID, ShortLink: "fk000016"}
+	feature := Card{ID: "card 3", Name: "feature", IDList: todo.ID, ShortLink: "fk000017"}
+	release := Card{ID: "card 4", Name: "release-1.0", IDList: doing.ID, ShortLink: "fk000018"}
+
+	type given struct {
+		currentPath           Path
+		relativePath          string
+		buildTrelloRepository func() Repository
+	}
+	type expected struct {
+		paths []string
+		err   error
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"no glob": {
+			given: given{
+				relativePath:          "/board/todo/bug-1",
+				buildTrelloRepository: func() Repository { return nil },
+			},
+			expected: expected{
+				paths: []string{"/board/todo/bug-1"},
+			},
+		},
+		"TCliID": {
+			given: given{
+				relativePath:          "/board/todo/bug-1[fk000015]",
+				buildTrelloRepository: func() Repository { return nil },
+			},
+			expected: expected{
+				paths: []string{"/board/todo/bug-1[fk000015]"},
+			},
+		},
+		"glob on lists with a TCliID card": {
+			given: given{
+				relativePath: "/board/*/bug-1[fk000015]",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindLists(board.ID).Return(Lists{todo, doing}, nil)
+					tr.EXPECT().FindCard(todo.ID, "bug-1[fk000015]").Return(&bug1, nil)
+					tr.EXPECT().FindCard(doing.ID, "bug-1[fk000015]").Return(nil, errors.New("not found"))
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/todo[list1]/bug-1[fk000015]"},
+			},
+		},
+		"glob on cards": {
+			given: given{
+				relativePath: "/board/todo/bug-*",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, "todo").Return(&todo, nil)
+					tr.EXPECT().FindCards(todo.ID).Return(Cards{bug1, bug2, feature}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/todo/bug-1[fk000015]", "/board/todo/bug-2[fk000016]"},
+			},
+		},
+		"cards with the same name": {
+			given: given{
+				relativePath: "/board/todo/d*",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, "todo").Return(&todo, nil)
+					tr.EXPECT().FindCards(todo.ID).Return(Cards{{Name: "dup", ShortLink: "a"}, {Name: "dup", ShortLink: "b"}}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/todo/dup[a]", "/board/todo/dup[b]"},
+			},
+		},
+		"glob on lists and cards from the current path": {
+			given: given{
+				currentPath:  Path{BoardName: "board"},
+				relativePath: "*/release*",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindLists(board.ID).Return(Lists{todo, doing}, nil)
+					tr.EXPECT().FindCards(todo.ID).Return(Cards{bug1, bug2, feature}, nil)
+					tr.EXPECT().FindCards(doing.ID).Return(Cards{release}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/doing[list2]/release-1.0[fk000018]"},
+			},
+		},
+		"? and character class": {
+			given: given{
+				relativePath: "/board/todo/bug-[2-9]?",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, "todo").Return(&todo, nil)
+					tr.EXPECT().FindCards(todo.ID).Return(Cards{{Name: "bug-1", ShortLink: "a"}, {Name: "bug-10", ShortLink: "b"}, {Name: "bug-20", ShortLink: "c"}}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/todo/bug-20[c]"},
+			},
+		},
+		"** to match any level": {
+			given: given{
+				relativePath: "/board/**/*e*",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil).AnyTimes()
+					tr.EXPECT().FindLists(board.ID).Return(Lists{todo, doing}, nil).AnyTimes()
+					tr.EXPECT().FindCards(todo.ID).Return(Cards{bug1, bug2, feature}, nil).AnyTimes()
+					tr.EXPECT().FindCards(doing.ID).Return(Cards{release}, nil).AnyTimes()
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/todo[list1]/feature[fk000017]", "/board/doing[list2]/release-1.0[fk000018]"},
+			},
+		},
+		"glob on comments": {
+			given: given{
+				relativePath: "/board/todo/feature/*",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, "todo").Return(&todo, nil)
+					tr.EXPECT().FindCard(todo.ID, "feature").Return(&feature, nil)
+					tr.EXPECT().FindComments(feature.ID).Return(Comments{{ID: "comment 1"}, {ID: "comment 2"}}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/todo/feature/comment 1", "/board/todo/feature/comment 2"},
+			},
+		},
+		"no match": {
+			given: given{
+				relativePath: "/board/todo/[urgent] fix",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, "todo").Return(&todo, nil)
+					tr.EXPECT().FindCards(todo.ID).Return(Cards{bug1}, nil)
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/board/todo/[urgent] fix"},
+			},
+		},
+		"unknown board": {
+			given: given{
+				relativePath: "/unknown/*",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("unknown").Return(nil, errors.New("not found"))
+					return tr
+				},
+			},
+			expected: expected{
+				paths: []string{"/unknown/*"},
+			},
+		},
+		"error when fetching the cards": {
+			given: given{
+				relativePath: "/board/todo/*",
+				buildTrelloRepository: func() Repository {
+					tr := NewMockRepository(ctrl)
+					tr.EXPECT().FindBoard("board").Return(&board, nil)
+					tr.EXPECT().FindList(board.ID, "todo").Return(&todo, nil)
+					tr.EXPECT().FindCards(todo.ID).Return(nil, errors.New("unexpected error"))
+					return tr
+				},
+			},
+			expected: expected{
+				err: errors.New("unexpected error"),
+			},
+		},
+		"invalid path": {
+			given: given{
+				relativePath:          "../*",
+				buildTrelloRepository: func() Repository { return nil },
+			},
+			expected: expected{
+				err: invalidPathErr,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			pr := PathResolver{Path: tt.given.currentPath}
+			actual, err := pr.Expand(tt.given.buildTrelloRepository(), tt.given.relativePath)
+			if !reflect.DeepEqual(err, tt.expected.err) {
+				t.Errorf("expected err %v, actual err %v", tt.expected.err, err)
+			}
+			if !reflect.DeepEqual(actual, tt.expected.paths) {
+				t.Errorf("expected %v, actual %v", tt.expected.paths, actual)
+			}
+		})
+	}
+}
+
+func TestHasGlob(t *testing.T) {
+	var tests = map[string]struct {
+		given    string
+		expected bool
+	}{
+		"no glob":                      {given: "/board/list/card", expected: false},
+		"star":                         {given: "/board/list/*", expected: true},
+		"question mark":                {given: "/board/list/card-?", expected: true},
+		"character class":              {given: "/board/list/card-[0-9]", expected: true},
+		"TCliID":                       {given: "/board/list/card[fk000015]", expected: false},
+		"TCliIDs with spaces":          {given: "/my board[abc]/my list[123]/my card[def]", expected: false},
+		"character class in a TCliID":  {given: "/board/list/card-[0-9][fk000015]", expected: true},
+		"star in a TCliID":             {given: "/board/list/card*[fk000015]", expected: true},
+		"TCliID followed by a segment": {given: "/board/list[123]/*", expected: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := HasGlob(tt.given)
+			if actual != tt.expected {
+				t.Errorf("expected %v, actual %v", tt.expected, actual)
+			}
+		})
+	}
+}
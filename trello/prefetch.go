@@ -0,0 +1,66 @@
+package trello
+
+import "sync"
+
+// maximum number of lists whose cards are fetched concurrently when prefetching a board
+const prefetchParallelism = 4
+
+// boardContent is the content of a board fetched in advance
+type boardContent struct {
+	labels        Labels
+	lists         Lists
+	cardsByIDList map[string]Cards // <idList, Cards>
+}
+
+// fetchBoardContent fetches the labels, the lists and the cards of the given board,
+// the labels and the cards of each list being fetched concurrently
+func fetchBoardContent(r Repository, idBoard string) (*boardContent, error) {
+	content := &boardContent{cardsByIDList: map[string]Cards{}}
+	var wg sync.WaitGroup
+	var labelsErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		content.labels, labelsErr = r.FindLabels(idBoard)
+	}()
+
+	lists, err := r.FindLists(idBoard)
+	if err != nil {
+		wg.Wait()
+		return nil, err
+	}
+	content.lists = lists
+
+	var mu sync.Mutex
+	var cardsErr error
+	semaphore := make(chan struct{}, prefetchParallelism)
+	for _, list := range lists {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(idList string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			cards, err := r.FindCards(idList)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if cardsErr == nil {
+					cardsErr = err
+				}
+				return
+			}
+			content.cardsByIDList[idList] = cards
+		}(list.ID)
+	}
+	wg.Wait()
+
+	if labelsErr != nil {
+		return nil, labelsErr
+	}
+	if cardsErr != nil {
+		return nil, cardsErr
+	}
+	return content, nil
+}
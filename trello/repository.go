@@ -1,23 +1,72 @@
 //go:generate mockgen -source repository.go -package trello -destination repository_mock.go
 package trello
 
+import (
+	"io"
+	"time"
+)
+
 // Repository to call perform CRUD operation on Trello resources
 // We may want to update this interface to accept channels to support async
 type Repository interface {
 	Refresh()
+	// Forget removes from the caches the resources changed by the given actions, performed outside tcli,
+	// e.g. received by a webhook
+	Forget(actions Actions)
+	// Prefetch fetches in advance the lists, cards and labels of the board, so browsing it does not wait for Trello
+	Prefetch(idBoard string) error
 	FindBoards() (Boards, error)
 	FindBoard(query string) (*Board, error)
+	CreateBoard(createBoard CreateBoard) (*Board, error)
+	UpdateBoard(updateBoard UpdateBoard) (*Board, error)
+	CloseBoard(idBoard string) error
 	FindLabels(idBoard string) (Labels, error)
+	CreateLabel(createLabel CreateLabel) (*Label, error)
+	UpdateLabel(updateLabel UpdateLabel) (*Label, error)
+	DeleteLabel(idBoard, idLabel string) error
+	FindMembers(idBoard string) (Members, error)
+	FindCurrentMember() (*Member, error)
 	FindLists(idBoard string) (Lists, error)
 	FindList(idBoard string, query string) (*List, error)
+	CreateList(createList CreateList) (*List, error)
+	UpdateList(updateList UpdateList) (*List, error)
+	ArchiveList(idBoard, idList string) error
+	// FindArchivedLists returns the lists of the board that were archived, which are not returned by FindLists
+	FindArchivedLists(idBoard string) (Lists, error)
 	FindCards(idList string) (Cards, error)
 	FindCard(idList string, query string) (*Card, error)
+	// FindArchivedCards returns the cards of the list that were archived, which are not returned by FindCards
+	FindArchivedCards(idList string) (Cards, error)
 	ArchiveAllCards(idList string) error
 	CreateCard(createCard CreateCard) (*Card, error)
 	UpdateCard(updateCard UpdateCard) (*Card, error)
+	SearchCards(query string, idBoards ...string) (Cards, error)
+	FindChecklists(idCard string) (Checklists, error)
+	CreateChecklist(createChecklist CreateChecklist) (*Checklist, error)
+	UpdateChecklist(updateChecklist UpdateChecklist) (*Checklist, error)
+	DeleteChecklist(idCard, idChecklist string) error
+	CreateCheckItem(createCheckItem CreateCheckItem) (*CheckItem, error)
+	UpdateCheckItem(updateCheckItem UpdateCheckItem) (*CheckItem, error)
+	DeleteCheckItem(idCard, idCheckItem string) error
+	// FindCustomFields returns the custom fields of the board, none if the Custom Fields power-up is not enabled
+	FindCustomFields(idBoard string) (CustomFields, error)
+	// UpdateCustomFieldItem sets or clears the value of a custom field of a card
+	UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error)
+	FindAttachments(idCard string) (Attachments, error)
+	CreateAttachment(createAttachment CreateAttachment) (*Attachment, error)
+	DownloadAttachment(attachment Attachment, w io.Writer) error
+	DeleteAttachment(idCard, idAttachment string) error
 	FindComments(idCard string) (Comments, error)
 	FindComment(idCard string, idComment string) (*Comment, error)
 	CreateComment(createComment CreateComment) (*Comment, error)
 	UpdateComment(updateComment UpdateComment) (*Comment, error)
 	DeleteComment(idCard, idComment string) error
+	// FindCardActions returns the activity of the card since the given date, or all of it if the date is zero
+	FindCardActions(idCard string, since time.Time) (Actions, error)
+	// FindBoardActions returns the activity of the cards of the board since the given date, or all of it if the date is zero
+	FindBoardActions(idBoard string, since time.Time) (Actions, error)
+	// FindWebhooks returns the webhooks registered with the token of the user
+	FindWebhooks() (Webhooks, error)
+	CreateWebhook(createWebhook CreateWebhook) (*Webhook, error)
+	DeleteWebhook(idWebhook string) error
 }
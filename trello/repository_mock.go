@@ -5,7 +5,9 @@
 package trello
 
 import (
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -47,6 +49,64 @@ func (mr *MockRepositoryMockRecorder) ArchiveAllCards(idList interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAllCards", reflect.TypeOf((*MockRepository)(nil).ArchiveAllCards), idList)
 }
 
+// ArchiveList mocks base method.
+func (m *MockRepository) ArchiveList(idBoard, idList string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveList", idBoard, idList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveList indicates an expected call of ArchiveList.
+func (mr *MockRepositoryMockRecorder) ArchiveList(idBoard, idList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveList", reflect.TypeOf((*MockRepository)(nil).ArchiveList), idBoard, idList)
+}
+
+// CloseBoard mocks base method.
+func (m *MockRepository) CloseBoard(idBoard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseBoard", idBoard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseBoard indicates an expected call of CloseBoard.
+func (mr *MockRepositoryMockRecorder) CloseBoard(idBoard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseBoard", reflect.TypeOf((*MockRepository)(nil).CloseBoard), idBoard)
+}
+
+// CreateAttachment mocks base method.
+func (m *MockRepository) CreateAttachment(createAttachment CreateAttachment) (*Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", createAttachment)
+	ret0, _ := ret[0].(*Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockRepositoryMockRecorder) CreateAttachment(createAttachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockRepository)(nil).CreateAttachment), createAttachment)
+}
+
+// CreateBoard mocks base method.
+func (m *MockRepository) CreateBoard(createBoard CreateBoard) (*Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBoard", createBoard)
+	ret0, _ := ret[0].(*Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBoard indicates an expected call of CreateBoard.
+func (mr *MockRepositoryMockRecorder) CreateBoard(createBoard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoard", reflect.TypeOf((*MockRepository)(nil).CreateBoard), createBoard)
+}
+
 // CreateCard mocks base method.
 func (m *MockRepository) CreateCard(createCard CreateCard) (*Card, error) {
 	m.ctrl.T.Helper()
@@ -62,6 +122,36 @@ func (mr *MockRepositoryMockRecorder) CreateCard(createCard interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCard", reflect.TypeOf((*MockRepository)(nil).CreateCard), createCard)
 }
 
+// CreateCheckItem mocks base method.
+func (m *MockRepository) CreateCheckItem(createCheckItem CreateCheckItem) (*CheckItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckItem", createCheckItem)
+	ret0, _ := ret[0].(*CheckItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckItem indicates an expected call of CreateCheckItem.
+func (mr *MockRepositoryMockRecorder) CreateCheckItem(createCheckItem interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckItem", reflect.TypeOf((*MockRepository)(nil).CreateCheckItem), createCheckItem)
+}
+
+// CreateChecklist mocks base method.
+func (m *MockRepository) CreateChecklist(createChecklist CreateChecklist) (*Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChecklist", createChecklist)
+	ret0, _ := ret[0].(*Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChecklist indicates an expected call of CreateChecklist.
+func (mr *MockRepositoryMockRecorder) CreateChecklist(createChecklist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChecklist", reflect.TypeOf((*MockRepository)(nil).CreateChecklist), createChecklist)
+}
+
 // CreateComment mocks base method.
 func (m *MockRepository) CreateComment(createComment CreateComment) (*Comment, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +167,93 @@ func (mr *MockRepositoryMockRecorder) CreateComment(createComment interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockRepository)(nil).CreateComment), createComment)
 }
 
+// CreateLabel mocks base method.
+func (m *MockRepository) CreateLabel(createLabel CreateLabel) (*Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", createLabel)
+	ret0, _ := ret[0].(*Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockRepositoryMockRecorder) CreateLabel(createLabel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockRepository)(nil).CreateLabel), createLabel)
+}
+
+// CreateList mocks base method.
+func (m *MockRepository) CreateList(createList CreateList) (*List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", createList)
+	ret0, _ := ret[0].(*List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockRepositoryMockRecorder) CreateList(createList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockRepository)(nil).CreateList), createList)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockRepository) CreateWebhook(createWebhook CreateWebhook) (*Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", createWebhook)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockRepositoryMockRecorder) CreateWebhook(createWebhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockRepository)(nil).CreateWebhook), createWebhook)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockRepository) DeleteAttachment(idCard, idAttachment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", idCard, idAttachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockRepositoryMockRecorder) DeleteAttachment(idCard, idAttachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockRepository)(nil).DeleteAttachment), idCard, idAttachment)
+}
+
+// DeleteCheckItem mocks base method.
+func (m *MockRepository) DeleteCheckItem(idCard, idCheckItem string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckItem", idCard, idCheckItem)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckItem indicates an expected call of DeleteCheckItem.
+func (mr *MockRepositoryMockRecorder) DeleteCheckItem(idCard, idCheckItem interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckItem", reflect.TypeOf((*MockRepository)(nil).DeleteCheckItem), idCard, idCheckItem)
+}
+
+// DeleteChecklist mocks base method.
+func (m *MockRepository) DeleteChecklist(idCard, idChecklist string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklist", idCard, idChecklist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklist indicates an expected call of DeleteChecklist.
+func (mr *MockRepositoryMockRecorder) DeleteChecklist(idCard, idChecklist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklist", reflect.TypeOf((*MockRepository)(nil).DeleteChecklist), idCard, idChecklist)
+}
+
 // DeleteComment mocks base method.
 func (m *MockRepository) DeleteComment(idCard, idComment string) error {
 	m.ctrl.T.Helper()
@@ -91,6 +268,93 @@ func (mr *MockRepositoryMockRecorder) DeleteComment(idCard, idComment interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockRepository)(nil).DeleteComment), idCard, idComment)
 }
 
+// DeleteLabel mocks base method.
+func (m *MockRepository) DeleteLabel(idBoard, idLabel string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", idBoard, idLabel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockRepositoryMockRecorder) DeleteLabel(idBoard, idLabel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockRepository)(nil).DeleteLabel), idBoard, idLabel)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockRepository) DeleteWebhook(idWebhook string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", idWebhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockRepositoryMockRecorder) DeleteWebhook(idWebhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockRepository)(nil).DeleteWebhook), idWebhook)
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockRepository) DownloadAttachment(attachment Attachment, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", attachment, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockRepositoryMockRecorder) DownloadAttachment(attachment, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockRepository)(nil).DownloadAttachment), attachment, w)
+}
+
+// FindArchivedCards mocks base method.
+func (m *MockRepository) FindArchivedCards(idList string) (Cards, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindArchivedCards", idList)
+	ret0, _ := ret[0].(Cards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindArchivedCards indicates an expected call of FindArchivedCards.
+func (mr *MockRepositoryMockRecorder) FindArchivedCards(idList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindArchivedCards", reflect.TypeOf((*MockRepository)(nil).FindArchivedCards), idList)
+}
+
+// FindArchivedLists mocks base method.
+func (m *MockRepository) FindArchivedLists(idBoard string) (Lists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindArchivedLists", idBoard)
+	ret0, _ := ret[0].(Lists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindArchivedLists indicates an expected call of FindArchivedLists.
+func (mr *MockRepositoryMockRecorder) FindArchivedLists(idBoard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindArchivedLists", reflect.TypeOf((*MockRepository)(nil).FindArchivedLists), idBoard)
+}
+
+// FindAttachments mocks base method.
+func (m *MockRepository) FindAttachments(idCard string) (Attachments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAttachments", idCard)
+	ret0, _ := ret[0].(Attachments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttachments indicates an expected call of FindAttachments.
+func (mr *MockRepositoryMockRecorder) FindAttachments(idCard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttachments", reflect.TypeOf((*MockRepository)(nil).FindAttachments), idCard)
+}
+
 // FindBoard mocks base method.
 func (m *MockRepository) FindBoard(query string) (*Board, error) {
 	m.ctrl.T.Helper()
@@ -106,6 +370,21 @@ func (mr *MockRepositoryMockRecorder) FindBoard(query interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBoard", reflect.TypeOf((*MockRepository)(nil).FindBoard), query)
 }
 
+// FindBoardActions mocks base method.
+func (m *MockRepository) FindBoardActions(idBoard string, since time.Time) (Actions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBoardActions", idBoard, since)
+	ret0, _ := ret[0].(Actions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBoardActions indicates an expected call of FindBoardActions.
+func (mr *MockRepositoryMockRecorder) FindBoardActions(idBoard, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBoardActions", reflect.TypeOf((*MockRepository)(nil).FindBoardActions), idBoard, since)
+}
+
 // FindBoards mocks base method.
 func (m *MockRepository) FindBoards() (Boards, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +415,21 @@ func (mr *MockRepositoryMockRecorder) FindCard(idList, query interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCard", reflect.TypeOf((*MockRepository)(nil).FindCard), idList, query)
 }
 
+// FindCardActions mocks base method.
+func (m *MockRepository) FindCardActions(idCard string, since time.Time) (Actions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCardActions", idCard, since)
+	ret0, _ := ret[0].(Actions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCardActions indicates an expected call of FindCardActions.
+func (mr *MockRepositoryMockRecorder) FindCardActions(idCard, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCardActions", reflect.TypeOf((*MockRepository)(nil).FindCardActions), idCard, since)
+}
+
 // FindCards mocks base method.
 func (m *MockRepository) FindCards(idList string) (Cards, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +445,21 @@ func (mr *MockRepositoryMockRecorder) FindCards(idList interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCards", reflect.TypeOf((*MockRepository)(nil).FindCards), idList)
 }
 
+// FindChecklists mocks base method.
+func (m *MockRepository) FindChecklists(idCard string) (Checklists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChecklists", idCard)
+	ret0, _ := ret[0].(Checklists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChecklists indicates an expected call of FindChecklists.
+func (mr *MockRepositoryMockRecorder) FindChecklists(idCard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChecklists", reflect.TypeOf((*MockRepository)(nil).FindChecklists), idCard)
+}
+
 // FindComment mocks base method.
 func (m *MockRepository) FindComment(idCard, idComment string) (*Comment, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +490,36 @@ func (mr *MockRepositoryMockRecorder) FindComments(idCard interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindComments", reflect.TypeOf((*MockRepository)(nil).FindComments), idCard)
 }
 
+// FindCurrentMember mocks base method.
+func (m *MockRepository) FindCurrentMember() (*Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCurrentMember")
+	ret0, _ := ret[0].(*Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCurrentMember indicates an expected call of FindCurrentMember.
+func (mr *MockRepositoryMockRecorder) FindCurrentMember() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCurrentMember", reflect.TypeOf((*MockRepository)(nil).FindCurrentMember))
+}
+
+// FindCustomFields mocks base method.
+func (m *MockRepository) FindCustomFields(idBoard string) (CustomFields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCustomFields", idBoard)
+	ret0, _ := ret[0].(CustomFields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCustomFields indicates an expected call of FindCustomFields.
+func (mr *MockRepositoryMockRecorder) FindCustomFields(idBoard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCustomFields", reflect.TypeOf((*MockRepository)(nil).FindCustomFields), idBoard)
+}
+
 // FindLabels mocks base method.
 func (m *MockRepository) FindLabels(idBoard string) (Labels, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +565,62 @@ func (mr *MockRepositoryMockRecorder) FindLists(idBoard interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLists", reflect.TypeOf((*MockRepository)(nil).FindLists), idBoard)
 }
 
+// FindMembers mocks base method.
+func (m *MockRepository) FindMembers(idBoard string) (Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembers", idBoard)
+	ret0, _ := ret[0].(Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembers indicates an expected call of FindMembers.
+func (mr *MockRepositoryMockRecorder) FindMembers(idBoard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembers", reflect.TypeOf((*MockRepository)(nil).FindMembers), idBoard)
+}
+
+// FindWebhooks mocks base method.
+func (m *MockRepository) FindWebhooks() (Webhooks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWebhooks")
+	ret0, _ := ret[0].(Webhooks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWebhooks indicates an expected call of FindWebhooks.
+func (mr *MockRepositoryMockRecorder) FindWebhooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWebhooks", reflect.TypeOf((*MockRepository)(nil).FindWebhooks))
+}
+
+// Forget mocks base method.
+func (m *MockRepository) Forget(actions Actions) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Forget", actions)
+}
+
+// Forget indicates an expected call of Forget.
+func (mr *MockRepositoryMockRecorder) Forget(actions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Forget", reflect.TypeOf((*MockRepository)(nil).Forget), actions)
+}
+
+// Prefetch mocks base method.
+func (m *MockRepository) Prefetch(idBoard string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prefetch", idBoard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prefetch indicates an expected call of Prefetch.
+func (mr *MockRepositoryMockRecorder) Prefetch(idBoard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prefetch", reflect.TypeOf((*MockRepository)(nil).Prefetch), idBoard)
+}
+
 // Refresh mocks base method.
 func (m *MockRepository) Refresh() {
 	m.ctrl.T.Helper()
@@ -238,6 +633,41 @@ func (mr *MockRepositoryMockRecorder) Refresh() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockRepository)(nil).Refresh))
 }
 
+// SearchCards mocks base method.
+func (m *MockRepository) SearchCards(query string, idBoards ...string) (Cards, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{query}
+	for _, a := range idBoards {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchCards", varargs...)
+	ret0, _ := ret[0].(Cards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCards indicates an expected call of SearchCards.
+func (mr *MockRepositoryMockRecorder) SearchCards(query interface{}, idBoards ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{query}, idBoards...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCards", reflect.TypeOf((*MockRepository)(nil).SearchCards), varargs...)
+}
+
+// UpdateBoard mocks base method.
+func (m *MockRepository) UpdateBoard(updateBoard UpdateBoard) (*Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoard", updateBoard)
+	ret0, _ := ret[0].(*Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoard indicates an expected call of UpdateBoard.
+func (mr *MockRepositoryMockRecorder) UpdateBoard(updateBoard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoard", reflect.TypeOf((*MockRepository)(nil).UpdateBoard), updateBoard)
+}
+
 // UpdateCard mocks base method.
 func (m *MockRepository) UpdateCard(updateCard UpdateCard) (*Card, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +683,36 @@ func (mr *MockRepositoryMockRecorder) UpdateCard(updateCard interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCard", reflect.TypeOf((*MockRepository)(nil).UpdateCard), updateCard)
 }
 
+// UpdateCheckItem mocks base method.
+func (m *MockRepository) UpdateCheckItem(updateCheckItem UpdateCheckItem) (*CheckItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCheckItem", updateCheckItem)
+	ret0, _ := ret[0].(*CheckItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCheckItem indicates an expected call of UpdateCheckItem.
+func (mr *MockRepositoryMockRecorder) UpdateCheckItem(updateCheckItem interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckItem", reflect.TypeOf((*MockRepository)(nil).UpdateCheckItem), updateCheckItem)
+}
+
+// UpdateChecklist mocks base method.
+func (m *MockRepository) UpdateChecklist(updateChecklist UpdateChecklist) (*Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChecklist", updateChecklist)
+	ret0, _ := ret[0].(*Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChecklist indicates an expected call of UpdateChecklist.
+func (mr *MockRepositoryMockRecorder) UpdateChecklist(updateChecklist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecklist", reflect.TypeOf((*MockRepository)(nil).UpdateChecklist), updateChecklist)
+}
+
 // UpdateComment mocks base method.
 func (m *MockRepository) UpdateComment(updateComment UpdateComment) (*Comment, error) {
 	m.ctrl.T.Helper()
@@ -267,3 +727,48 @@ func (mr *MockRepositoryMockRecorder) UpdateComment(updateComment interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockRepository)(nil).UpdateComment), updateComment)
 }
+
+// UpdateCustomFieldItem mocks base method.
+func (m *MockRepository) UpdateCustomFieldItem(updateCustomFieldItem UpdateCustomFieldItem) (*CustomFieldItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomFieldItem", updateCustomFieldItem)
+	ret0, _ := ret[0].(*CustomFieldItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomFieldItem indicates an expected call of UpdateCustomFieldItem.
+func (mr *MockRepositoryMockRecorder) UpdateCustomFieldItem(updateCustomFieldItem interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomFieldItem", reflect.TypeOf((*MockRepository)(nil).UpdateCustomFieldItem), updateCustomFieldItem)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockRepository) UpdateLabel(updateLabel UpdateLabel) (*Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", updateLabel)
+	ret0, _ := ret[0].(*Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockRepositoryMockRecorder) UpdateLabel(updateLabel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockRepository)(nil).UpdateLabel), updateLabel)
+}
+
+// UpdateList mocks base method.
+func (m *MockRepository) UpdateList(updateList UpdateList) (*List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", updateList)
+	ret0, _ := ret[0].(*List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockRepositoryMockRecorder) UpdateList(updateList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockRepository)(nil).UpdateList), updateList)
+}
@@ -0,0 +1,173 @@
+package trello
+
+// tracker is a decorator that keeps the last fetched state of the resources, so the decorators embedding it
+// know the state of the resources before mutating them, as the commands always find them first
+type tracker struct {
+	Repository
+	boards      map[string]Board      // <idBoard, Board>
+	labels      map[string]Label      // <idLabel, Label>
+	lists       map[string]List       // <idList, List>
+	cards       map[string]Card       // <idCard, Card>
+	checklists  map[string]Checklist  // <idChecklist, Checklist>
+	attachments map[string]Attachment // <idAttachment, Attachment>
+	comments    map[string]Comment    // <idComment, Comment>
+}
+
+func newTracker(r Repository) *tracker {
+	return &tracker{
+		Repository:  r,
+		boards:      map[string]Board{},
+		labels:      map[string]Label{},
+		lists:       map[string]List{},
+		cards:       map[string]Card{},
+		checklists:  map[string]Checklist{},
+		attachments: map[string]Attachment{},
+		comments:    map[string]Comment{},
+	}
+}
+
+func (t *tracker) FindBoards() (Boards, error) {
+	boards, err := t.Repository.FindBoards()
+	for _, board := range boards {
+		t.boards[board.ID] = board
+	}
+	return boards, err
+}
+
+func (t *tracker) FindBoard(query string) (*Board, error) {
+	board, err := t.Repository.FindBoard(query)
+	if board != nil {
+		t.boards[board.ID] = *board
+	}
+	return board, err
+}
+
+func (t *tracker) FindLabels(idBoard string) (Labels, error) {
+	labels, err := t.Repository.FindLabels(idBoard)
+	for _, label := range labels {
+		t.labels[label.ID] = label
+	}
+	return labels, err
+}
+
+func (t *tracker) FindLists(idBoard string) (Lists, error) {
+	lists, err := t.Repository.FindLists(idBoard)
+	for _, list := range lists {
+		t.lists[list.ID] = list
+	}
+	return lists, err
+}
+
+func (t *tracker) FindList(idBoard string, query string) (*List, error) {
+	list, err := t.Repository.FindList(idBoard, query)
+	if list != nil {
+		t.lists[list.ID] = *list
+	}
+	return list, err
+}
+
+func (t *tracker) FindArchivedLists(idBoard string) (Lists, error) {
+	lists, err := t.Repository.FindArchivedLists(idBoard)
+	for _, list := range lists {
+		t.lists[list.ID] = list
+	}
+	return lists, err
+}
+
+func (t *tracker) FindCards(idList string) (Cards, error) {
+	cards, err := t.Repository.FindCards(idList)
+	for _, card := range cards {
+		t.cards[card.ID] = card
+	}
+	return cards, err
+}
+
+func (t *tracker) FindCard(idList string, query string) (*Card, error) {
+	card, err := t.Repository.FindCard(idList, query)
+	if card != nil {
+		t.cards[card.ID] = *card
+	}
+	return card, err
+}
+
+func (t *tracker) FindArchivedCards(idList string) (Cards, error) {
+	cards, err := t.Repository.FindArchivedCards(idList)
+	for _, card := range cards {
+		t.cards[card.ID] = card
+	}
+	return cards, err
+}
+
+func (t *tracker) SearchCards(query string, idBoards ...string) (Cards, error) {
+	cards, err := t.Repository.SearchCards(query, idBoards...)
+	for _, card := range cards {
+		t.cards[card.ID] = card
+	}
+	return cards, err
+}
+
+func (t *tracker) FindChecklists(idCard string) (Checklists, error) {
+	checklists, err := t.Repository.FindChecklists(idCard)
+	for _, checklist := range checklists {
+		t.checklists[checklist.ID] = checklist
+	}
+	return checklists, err
+}
+
+// findCheckItem returns the check item from the tracked checklists
+func (t *tracker) findCheckItem(idCheckItem string) *CheckItem {
+	for _, checklist := range t.checklists {
+		for _, checkItem := range checklist.CheckItems {
+			if checkItem.ID == idCheckItem {
+				return &checkItem
+			}
+		}
+	}
+	return nil
+}
+
+// trackCheckItem adds or replaces the check item in its tracked checklist, which may have changed
+func (t *tracker) trackCheckItem(checkItem CheckItem) {
+	t.untrackCheckItem(checkItem.ID)
+	if checklist, ok := t.checklists[checkItem.IDChecklist]; ok {
+		checklist.CheckItems = append(checklist.CheckItems, checkItem)
+		t.checklists[checklist.ID] = checklist
+	}
+}
+
+func (t *tracker) untrackCheckItem(idCheckItem string) {
+	for id, checklist := range t.checklists {
+		checkItems := CheckItems{}
+		for _, c := range checklist.CheckItems {
+			if c.ID != idCheckItem {
+				checkItems = append(checkItems, c)
+			}
+		}
+		checklist.CheckItems = checkItems
+		t.checklists[id] = checklist
+	}
+}
+
+func (t *tracker) FindAttachments(idCard string) (Attachments, error) {
+	attachments, err := t.Repository.FindAttachments(idCard)
+	for _, attachment := range attachments {
+		t.attachments[attachment.ID] = attachment
+	}
+	return attachments, err
+}
+
+func (t *tracker) FindComments(idCard string) (Comments, error) {
+	comments, err := t.Repository.FindComments(idCard)
+	for _, comment := range comments {
+		t.comments[comment.ID] = comment
+	}
+	return comments, err
+}
+
+func (t *tracker) FindComment(idCard string, idComment string) (*Comment, error) {
+	comment, err := t.Repository.FindComment(idCard, idComment)
+	if comment != nil {
+		t.comments[comment.ID] = *comment
+	}
+	return comment, err
+}
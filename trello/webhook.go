@@ -0,0 +1,174 @@
+package trello
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"io"
+	"net/http"
+	"sync"
+)
+
+const (
+	// webhookSignatureHeader is the header containing the signature of the payloads sent by Trello
+	webhookSignatureHeader = "X-Trello-Webhook"
+	// maxWebhookPayloadSize protects the receiver against huge payloads, the actions sent by Trello being a few KB
+	maxWebhookPayloadSize = 1 << 20
+)
+
+type Webhooks []Webhook
+
+// Webhook makes Trello send the actions performed on a model, e.g. a board, to a callback URL
+// See https://developer.atlassian.com/cloud/trello/guides/rest-api/webhooks/ for more info
+type Webhook struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	IDModel     string `json:"idModel"`
+	CallbackURL string `json:"callbackURL"`
+	Active      bool   `json:"active"`
+}
+
+type CreateWebhook struct {
+	Description string `json:"description"`
+	IDModel     string `json:"idModel"`
+	CallbackURL string `json:"callbackURL"`
+}
+
+// RegisterWebhooks registers a webhook sending the actions of each given board to the callback URL,
+// the webhooks already registered for the board and the callback URL being kept
+func RegisterWebhooks(tr Repository, callbackURL string, boards Boards) (Webhooks, error) {
+	registered, err := tr.FindWebhooks()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the registered webhooks: %w", err)
+	}
+	webhooks := Webhooks{}
+	for _, board := range boards {
+		if webhook := registered.find(board.ID, callbackURL); webhook != nil {
+			webhooks = append(webhooks, *webhook)
+			continue
+		}
+		webhook, err := tr.CreateWebhook(CreateWebhook{
+			Description: fmt.Sprintf("tcli: board '%s'", board.Name),
+			IDModel:     board.ID,
+			CallbackURL: callbackURL,
+		})
+		if err != nil {
+			return webhooks, fmt.Errorf("could not register webhook for board '%s': %w", board.Name, err)
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, nil
+}
+
+// UnregisterWebhooks deletes all the webhooks sending actions to the callback URL
+func UnregisterWebhooks(tr Repository, callbackURL string) (Webhooks, error) {
+	registered, err := tr.FindWebhooks()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the registered webhooks: %w", err)
+	}
+	deleted := Webhooks{}
+	for _, webhook := range registered {
+		if webhook.CallbackURL != callbackURL {
+			continue
+		}
+		if err := tr.DeleteWebhook(webhook.ID); err != nil {
+			return deleted, fmt.Errorf("could not delete webhook '%s': %w", webhook.Description, err)
+		}
+		deleted = append(deleted, webhook)
+	}
+	return deleted, nil
+}
+
+func (w Webhooks) find(idModel, callbackURL string) *Webhook {
+	for _, webhook := range w {
+		if webhook.IDModel == idModel && webhook.CallbackURL == callbackURL {
+			return &webhook
+		}
+	}
+	return nil
+}
+
+// webhookPayload is the content sent by Trello to the callback URL of the webhooks
+type webhookPayload struct {
+	Action Action `json:"action"`
+}
+
+// WebhookReceiver receives the actions sent by Trello to the callback URL of the webhooks,
+// and keeps them until they are applied to the Repository
+type WebhookReceiver struct {
+	// secret of the Trello application, used to sign the payloads
+	secret string
+	// callbackURL of the webhooks, as registered in Trello, which is part of the signature
+	callbackURL string
+	mu          sync.Mutex
+	actions     Actions
+}
+
+func NewWebhookReceiver(secret, callbackURL string) *WebhookReceiver {
+	return &WebhookReceiver{secret: secret, callbackURL: callbackURL}
+}
+
+func (w *WebhookReceiver) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodHead:
+		// Trello checks that the callback URL answers before creating the webhook
+		rw.WriteHeader(http.StatusOK)
+		return
+	case http.MethodPost:
+	default:
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if !w.verify(body, r.Header.Get(webhookSignatureHeader)) {
+		log.Debug().Str("remoteAddr", r.RemoteAddr).Msg("invalid webhook signature")
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		log.Debug().Err(err).Msg("invalid webhook payload")
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	log.Debug().
+		Str("id", payload.Action.ID).
+		Str("type", payload.Action.Type).
+		Msg("received action from webhook")
+	w.mu.Lock()
+	// the actions are kept from the most recent to the oldest, like the activity fetched from Trello
+	w.actions = append(Actions{payload.Action}, w.actions...)
+	w.mu.Unlock()
+	rw.WriteHeader(http.StatusOK)
+}
+
+// verify checks that the payload was sent by Trello, i.e. that the signature is the base64 of the HMAC-SHA1
+// of the payload followed by the callback URL, with the secret of the application as key
+func (w *WebhookReceiver) verify(body []byte, signature string) bool {
+	mac := hmac.New(sha1.New, []byte(w.secret))
+	mac.Write(body)
+	mac.Write([]byte(w.callbackURL))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// Apply makes the Repository forget the resources changed by the actions received since the last call,
+// returning these actions; it must be called by the goroutine using the Repository, as the caches are not
+// safe for concurrent use
+func (w *WebhookReceiver) Apply(tr Repository) Actions {
+	w.mu.Lock()
+	actions := w.actions
+	w.actions = nil
+	w.mu.Unlock()
+	if len(actions) > 0 {
+		tr.Forget(actions)
+	}
+	return actions
+}
@@ -0,0 +1,266 @@
+package trello
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	webhookSecret      = "app secret"
+	webhookCallbackURL = "https://example.ngrok.io/"
+	// payloads recorded from the webhook of a board, trimmed of the model and the fields tcli does not use
+	cardMovedPayload = `{
+  "model": {"id": "board 1", "name": "board"},
+  "action": {
+    "id": "action 1",
+    "idMemberCreator": "member 1",
+    "type": "updateCard",
+    "date": "2026-10-18T09:12:41.228Z",
+    "data": {
+      "card": {"id": "card 1", "name": "card", "idShort": 12, "idList": "list 2"},
+      "old": {"idList": "list 1"},
+      "board": {"id": "board 1", "name": "board"},
+      "listBefore": {"id": "list 1", "name": "todo"},
+      "listAfter": {"id": "list 2", "name": "doing"}
+    },
+    "memberCreator": {"id": "member 1", "fullName": "Member 1", "username": "member1"}
+  }
+}`
+	listRenamedPayload = `{
+  "model": {"id": "board 1", "name": "board"},
+  "action": {
+    "id": "action 2",
+    "idMemberCreator": "member 1",
+    "type": "updateList",
+    "date": "2026-10-18T09:13:02.114Z",
+    "data": {
+      "list": {"id": "list 2", "name": "in progress"},
+      "old": {"name": "doing"},
+      "board": {"id": "board 1", "name": "board"}
+    },
+    "memberCreator": {"id": "member 1", "fullName": "Member 1", "username": "member1"}
+  }
+}`
+)
+
+// sign signs the payload like Trello does for the webhooks of the application
+func sign(payload string) string {
+	mac := hmac.New(sha1.New, []byte(webhookSecret))
+	mac.Write([]byte(payload + webhookCallbackURL))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookReceiver_ServeHTTP(t *testing.T) {
+	type given struct {
+		method    string
+		payload   string
+		signature string
+	}
+	type expected struct {
+		status    int
+		idActions []string
+	}
+	var tests = map[string]struct {
+		given    given
+		expected expected
+	}{
+		"callback URL check": {
+			given:    given{method: http.MethodHead},
+			expected: expected{status: http.StatusOK},
+		},
+		"signed action": {
+			given:    given{method: http.MethodPost, payload: cardMovedPayload, signature: sign(cardMovedPayload)},
+			expected: expected{status: http.StatusOK, idActions: []string{"action 1"}},
+		},
+		"invalid signature": {
+			given:    given{method: http.MethodPost, payload: cardMovedPayload, signature: sign(listRenamedPayload)},
+			expected: expected{status: http.StatusUnauthorized},
+		},
+		"missing signature": {
+			given:    given{method: http.MethodPost, payload: cardMovedPayload},
+			expected: expected{status: http.StatusUnauthorized},
+		},
+		"invalid payload": {
+			given:    given{method: http.MethodPost, payload: "not json", signature: sign("not json")},
+			expected: expected{status: http.StatusBadRequest},
+		},
+		"unsupported method": {
+			given:    given{method: http.MethodGet},
+			expected: expected{status: http.StatusMethodNotAllowed},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			receiver := NewWebhookReceiver(webhookSecret, webhookCallbackURL)
+			ts := httptest.NewServer(receiver)
+			defer ts.Close()
+
+			request, _ := http.NewRequest(tt.given.method, ts.URL, strings.NewReader(tt.given.payload))
+			if tt.given.signature != "" {
+				request.Header.Set("X-Trello-Webhook", tt.given.signature)
+			}
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatalf("expected no error, actual %v", err)
+			}
+			response.Body.Close()
+
+			if response.StatusCode != tt.expected.status {
+				t.Errorf("expected status %d, actual %d", tt.expected.status, response.StatusCode)
+			}
+			r := NewMockRepository(ctrl)
+			if len(tt.expected.idActions) > 0 {
+				r.EXPECT().Forget(gomock.Any())
+			}
+			var actualIDActions []string
+			for _, action := range receiver.Apply(r) {
+				actualIDActions = append(actualIDActions, action.ID)
+			}
+			if !reflect.DeepEqual(tt.expected.idActions, actualIDActions) {
+				t.Errorf("expected actions %v, actual %v", tt.expected.idActions, actualIDActions)
+			}
+		})
+	}
+}
+
+func TestWebhookReceiver_Apply(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	idBoard := "board 1"
+	card := Card{ID: "card 1", Name: "card", IDList: "list 1"}
+	lists := Lists{{ID: "list 1", Name: "todo"}, {ID: "list 2", Name: "doing"}}
+	renamedLists := Lists{{ID: "list 1", Name: "todo"}, {ID: "list 2", Name: "in progress"}}
+	movedCard := Card{ID: "card 1", Name: "card", IDList: "list 2"}
+	gomock.InOrder(
+		r.EXPECT().FindLists(idBoard).Return(lists, nil),
+		r.EXPECT().FindCards("list 1").Return(Cards{card}, nil),
+		r.EXPECT().FindCards("list 2").Return(Cards{}, nil),
+		r.EXPECT().Forget(gomock.Any()),
+		r.EXPECT().FindLists(idBoard).Return(renamedLists, nil),
+		r.EXPECT().FindCards("list 1").Return(Cards{}, nil),
+		r.EXPECT().FindCards("list 2").Return(Cards{movedCard}, nil),
+	)
+	cr := NewCacheInMemory(r)
+	receiver := NewWebhookReceiver(webhookSecret, webhookCallbackURL)
+	ts := httptest.NewServer(receiver)
+	defer ts.Close()
+	post := func(payload string) {
+		request, _ := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(payload))
+		request.Header.Set("X-Trello-Webhook", sign(payload))
+		response, err := http.DefaultClient.Do(request)
+		if err != nil || response.StatusCode != http.StatusOK {
+			t.Fatalf("could not post payload: %v %v", err, response)
+		}
+		response.Body.Close()
+	}
+
+	// WHEN
+	_, _ = cr.FindLists(idBoard)
+	_, _ = cr.FindCards("list 1")
+	_, _ = cr.FindCards("list 2")
+	post(cardMovedPayload)
+	post(listRenamedPayload)
+	applied := receiver.Apply(cr)
+	actualLists, err1 := cr.FindLists(idBoard)
+	actualCards1, err2 := cr.FindCards("list 1")
+	actualCards2, err3 := cr.FindCards("list 2")
+
+	// THEN
+	if err1 != nil || err2 != nil || err3 != nil {
+		t.Error("expected no error")
+	}
+	if len(applied) != 2 || applied[0].ID != "action 2" || applied[1].ID != "action 1" {
+		t.Errorf("expected the actions from the most recent to the oldest, actual %v", applied)
+	}
+	if len(receiver.Apply(cr)) != 0 {
+		t.Error("expected the actions to be applied only once")
+	}
+	if !reflect.DeepEqual(renamedLists, actualLists) {
+		t.Errorf("expected %v, actual %v", renamedLists, actualLists)
+	}
+	if len(actualCards1) != 0 || !reflect.DeepEqual(Cards{movedCard}, actualCards2) {
+		t.Errorf("expected the card to be moved, actual %v and %v", actualCards1, actualCards2)
+	}
+}
+
+func TestRegisterWebhooks(t *testing.T) {
+	boards := Boards{{ID: "board 1", Name: "board"}, {ID: "board 2", Name: "another board"}}
+	registered := Webhook{ID: "webhook 1", IDModel: "board 1", CallbackURL: webhookCallbackURL, Active: true}
+	created := Webhook{ID: "webhook 2", Description: "tcli: board 'another board'", IDModel: "board 2", CallbackURL: webhookCallbackURL}
+
+	t.Run("happy path", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().
+			FindWebhooks().
+			Return(Webhooks{registered, {ID: "webhook 3", IDModel: "board 2", CallbackURL: "https://another.url/"}}, nil)
+		r.EXPECT().
+			CreateWebhook(CreateWebhook{Description: "tcli: board 'another board'", IDModel: "board 2", CallbackURL: webhookCallbackURL}).
+			Return(&created, nil)
+
+		// WHEN
+		actual, err := RegisterWebhooks(r, webhookCallbackURL, boards)
+
+		// THEN
+		if err != nil {
+			t.Errorf("expected no error, actual %v", err)
+		}
+		expected := Webhooks{registered, created}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("expected %v, actual %v", expected, actual)
+		}
+	})
+	t.Run("error when creating a webhook", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := NewMockRepository(ctrl)
+		r.EXPECT().FindWebhooks().Return(Webhooks{registered}, nil)
+		r.EXPECT().CreateWebhook(gomock.Any()).Return(nil, errors.New("URL did not return 200 status code"))
+
+		// WHEN
+		_, err := RegisterWebhooks(r, webhookCallbackURL, boards)
+
+		// THEN
+		expected := "could not register webhook for board 'another board': URL did not return 200 status code"
+		if err == nil || err.Error() != expected {
+			t.Errorf("expected error %s, actual %v", expected, err)
+		}
+	})
+}
+
+func TestUnregisterWebhooks(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := NewMockRepository(ctrl)
+	webhook1 := Webhook{ID: "webhook 1", IDModel: "board 1", CallbackURL: webhookCallbackURL}
+	webhook2 := Webhook{ID: "webhook 2", IDModel: "board 2", CallbackURL: "https://another.url/"}
+	r.EXPECT().FindWebhooks().Return(Webhooks{webhook1, webhook2}, nil)
+	r.EXPECT().DeleteWebhook(webhook1.ID).Return(nil)
+
+	// WHEN
+	actual, err := UnregisterWebhooks(r, webhookCallbackURL)
+
+	// THEN
+	if err != nil {
+		t.Errorf("expected no error, actual %v", err)
+	}
+	if !reflect.DeepEqual(Webhooks{webhook1}, actual) {
+		t.Errorf("expected %v, actual %v", Webhooks{webhook1}, actual)
+	}
+}